@@ -11,4 +11,14 @@ const (
 	flagB rune = 0x1F1FF // REGIONAL INDICATOR SYMBOL LETTER Z
 
 	keycap rune = '\u20E3' // COMBINING ENCLOSING KEYCAP
+
+	zwj rune = '\u200D' // ZERO WIDTH JOINER
+
+	black_flag rune = 0x1F3F4 // WAVING BLACK FLAG
+	tag_space  rune = 0xE0020 // TAG SPACE
+	tag_tilde  rune = 0xE007E // TAG TILDE
+	cancel_tag rune = 0xE007F // CANCEL TAG
+
+	red_hair   rune = 0x1F9B0 // EMOJI COMPONENT RED HAIR
+	white_hair rune = 0x1F9B3 // EMOJI COMPONENT WHITE HAIR
 )
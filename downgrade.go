@@ -0,0 +1,84 @@
+package emojitoolkit
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// How [Downgrade] replaces emojis that are not [Supported] by the target version.
+// The steps are tried in the order of the fields and the first one that applies is used.
+// The zero value removes these emojis.
+type DowngradePolicy struct {
+	// Remove the skin tones if the emoji is supported without them.
+	// Many skin tone variants are newer than the emoji itself like 🤝🏽 -> 🤝.
+	DropSkinTone bool
+
+	// Split emoji zwj sequences into their parts and downgrade each part on its own
+	// like ❤️‍🔥 -> ❤️🔥. This is what platforms without a glyph for the sequence show.
+	SplitZWJ bool
+
+	// Replace the emoji with its shortcode in this dialect like :melting_face:
+	// if the dialect has one. nil skips this step.
+	Shortcodes Dialect
+
+	// Returns the replacement text for all remaining emojis like "□" or the name
+	// from [Lookup]. nil removes them.
+	Fallback func(e Emoji) string
+}
+
+// Replaces all emojis found by [All] that are not [Supported] by the target
+// Emoji version so that they do not render as tofu boxes on older platforms.
+// Emojis not listed in [emoji-test.txt] like flags of unknown regions have no
+// glyph on any platform and are replaced as well. Text presentation sequences
+// with U+FE0E VARIATION SELECTOR-15 like ⏳︎ render as text and are left unchanged
+// like keycap bases with U+FE0F like 1️ which emoji-test.txt does not list.
+//
+// Examples:
+//
+//	Downgrade("Hi 🫠😀", E13_0, DowngradePolicy{}) -> "Hi 😀"
+//	Downgrade("🤝🏽", E13_0, DowngradePolicy{DropSkinTone: true}) -> "🤝"
+//	Downgrade("❤️‍🔥", E13_0, DowngradePolicy{SplitZWJ: true}) -> "❤️🔥"
+//	Downgrade("🫠", E13_0, DowngradePolicy{Shortcodes: CLDR}) -> ":melting_face:"
+//	Downgrade("🫠", E13_0, DowngradePolicy{Fallback: func(e Emoji) string { return "□" }}) -> "□"
+//
+// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+func Downgrade(s string, target EmojiVersion, policy DowngradePolicy) string {
+	return ReplaceFunc(s, func(e Emoji) string {
+		return policy.downgrade(string(e), target)
+	})
+}
+
+// Returns the replacement for a single emoji
+func (p DowngradePolicy) downgrade(e string, target EmojiVersion) string {
+	if Supported(e, target) || strings.ContainsRune(e, vs15) {
+		return e
+	}
+	if r, n := utf8.DecodeRuneInString(e); isKeycapBase(r) && e[n:] == string(vs16) {
+		return e
+	}
+
+	if p.DropSkinTone {
+		if stripped := StripSkinTones(e); stripped != e && Supported(stripped, target) {
+			return stripped
+		}
+	}
+
+	if p.SplitZWJ && strings.ContainsRune(e, zwj) {
+		var b strings.Builder
+		for part := range strings.SplitSeq(e, string(zwj)) {
+			b.WriteString(p.downgrade(part, target))
+		}
+		return b.String()
+	}
+
+	if p.Shortcodes != nil {
+		if shortcode, ok := p.Shortcodes.Shortcode(e); ok {
+			return ":" + shortcode + ":"
+		}
+	}
+
+	if p.Fallback != nil {
+		return p.Fallback(Emoji(e))
+	}
+	return ""
+}
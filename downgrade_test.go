@@ -0,0 +1,80 @@
+package emojitoolkit
+
+import (
+	"testing"
+)
+
+func TestDowngrade(t *testing.T) {
+	box := func(e Emoji) string { return "□" }
+	all := DowngradePolicy{DropSkinTone: true, SplitZWJ: true, Shortcodes: CLDR, Fallback: box}
+
+	testCases := []struct {
+		s        string
+		target   EmojiVersion
+		policy   DowngradePolicy
+		expected string
+	}{
+		{"", E13_0, DowngradePolicy{}, ""},
+		{"Hi 😀", E13_0, DowngradePolicy{}, "Hi 😀"},
+		{"Hi 🫠😀", E13_0, DowngradePolicy{}, "Hi 😀"},
+		{"Hi 🫠😀", E14_0, DowngradePolicy{}, "Hi 🫠😀"},
+		{"🇦🇦", E17_0, DowngradePolicy{}, ""},
+		{"👍\uFE0F", E17_0, DowngradePolicy{}, "👍\uFE0F"},
+		{"⏳\uFE0E ok", E17_0, DowngradePolicy{}, "⏳\uFE0E ok"},
+		{"⏳\uFE0E ok", E0_6, DowngradePolicy{}, "⏳\uFE0E ok"},
+		{"🫩🇨🇶", E16_0, DowngradePolicy{}, "🫩🇨🇶"},
+		{"🫩🇨🇶", E15_1, DowngradePolicy{}, ""},
+		{"👯🏽", E16_0, DowngradePolicy{DropSkinTone: true}, "👯"},
+		{"🤝🏽", E13_0, DowngradePolicy{}, ""},
+		{"🤝🏽", E13_0, DowngradePolicy{DropSkinTone: true}, "🤝"},
+		{"🫱🏽", E13_0, DowngradePolicy{DropSkinTone: true}, ""},
+		{"❤\uFE0F\u200D🔥", E13_0, DowngradePolicy{SplitZWJ: true}, "❤\uFE0F🔥"},
+		{"🧑\u200D🎄", E12_1, DowngradePolicy{SplitZWJ: true}, "🧑🎄"},
+		{"🧑\u200D🫠", E13_0, DowngradePolicy{SplitZWJ: true}, "🧑"},
+		{"🧑\u200D🫠", E13_0, DowngradePolicy{SplitZWJ: true, Fallback: box}, "🧑□"},
+		{"🫠", E13_0, DowngradePolicy{Shortcodes: CLDR}, ":melting_face:"},
+		{"🫠", E13_0, DowngradePolicy{Shortcodes: GitHub, Fallback: box}, ":melting_face:"},
+		{"🫠", E13_0, DowngradePolicy{Fallback: box}, "□"},
+		{"🤝🏽 🫠!", E13_0, all, "🤝 :melting_face:!"},
+	}
+
+	for _, c := range testCases {
+		if result := Downgrade(c.s, c.target, c.policy); result != c.expected {
+			t.Fatalf("Downgrade(%q, %v, %+v) = %q; want %q", c.s, c.target, c.policy, result, c.expected)
+		}
+	}
+}
+
+func TestDowngradeLatest(t *testing.T) {
+	for _, e := range emoji_test {
+		if result := Downgrade(e.sequence, E17_0, DowngradePolicy{}); result != e.sequence {
+			t.Fatalf("Downgrade(%q, E17_0, DowngradePolicy{}) = %q; want it unchanged", e.sequence, result)
+		}
+	}
+}
+
+func TestDowngradeAll(t *testing.T) {
+	// Every emoji found by All is at most Emoji 17.0
+	testCases := []string{
+		"👍\uFE0F",
+		"⏳\uFE0E ok",
+		"#\uFE0F 1\uFE0F",
+		"🫩 🫟 🇨🇶 🐦\u200D🔥",
+		"👯🏽\u200D♀ 👯🏽\u200D♀\uFE0F 🤼🏽",
+		"☀\uFE0E☀\uFE0F☀",
+	}
+	for _, e := range emoji_test {
+		testCases = append(testCases, e.sequence, e.sequence+"\uFE0F", e.sequence+"\uFE0E")
+	}
+
+	for _, s := range testCases {
+		for _, e := range All(s) {
+			if result := Downgrade(string(e), E17_0, DowngradePolicy{}); result != string(e) {
+				t.Fatalf("Downgrade(%q, E17_0, DowngradePolicy{}) = %q; want it unchanged", e, result)
+			}
+		}
+		if result := Downgrade(s, E17_0, DowngradePolicy{}); result != s {
+			t.Fatalf("Downgrade(%q, E17_0, DowngradePolicy{}) = %q; want it unchanged", s, result)
+		}
+	}
+}
@@ -1,12 +1,14 @@
 package emojitoolkit
 
 import (
+	"errors"
+	"fmt"
 	"slices"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
 )
 
-// Supported Unicode version
-const Version = "17.0.0"
-
 //go:generate go run generator/main.go
 
 // Matches codepoints that are default emoji presentation character ([ED-6])
@@ -41,6 +43,9 @@ func IsSingleCharacterEmoji(r rune) bool {
 //   - emoji presentation sequence ([ED-9a])
 //   - emoji keycap sequence ([ED-14c])
 //   - emoji flag sequence ([ED-14])
+//   - emoji modifier sequence ([ED-13])
+//   - emoji tag sequence ([ED-14a])
+//   - emoji zwj sequence ([ED-16])
 //
 // This should include all basic emoji defined by [ED-20].
 //
@@ -58,59 +63,90 @@ func IsSingleCharacterEmoji(r rune) bool {
 //
 // [ED-6]: https://www.unicode.org/reports/tr51/#def_emoji_presentation
 // [ED-9a]: https://www.unicode.org/reports/tr51/#def_emoji_presentation_sequence
+// [ED-13]: https://www.unicode.org/reports/tr51/#def_emoji_modifier_sequence
 // [ED-14]: https://www.unicode.org/reports/tr51/#def_emoji_flag_sequence
+// [ED-14a]: https://www.unicode.org/reports/tr51/#def_emoji_tag_sequence
 // [ED-14c]: https://www.unicode.org/reports/tr51/#def_emoji_keycap_sequence
+// [ED-16]: https://www.unicode.org/reports/tr51/#def_emoji_zwj_sequence
 // [ED-20]: https://www.unicode.org/reports/tr51/#def_basic_emoji_set
 // [emoji-sequences.txt]: https://www.unicode.org/Public/emoji/latest/emoji-sequences.txt
 func ContainsEmoji(s string) bool {
-	runes := []rune(s)
-	l := len(runes)
-	for i, r := range runes {
-		if IsSingleCharacterEmoji(r) {
-			return true
-		}
+	for range All(s) {
+		return true
+	}
+	return false
+}
 
-		if i+1 >= l {
-			break // skip last rune because there is no next rune
-		}
+// Matches a string that consists of exactly one emoji of any kind matched by [ContainsEmoji].
+// Does not check if the emoji is valid, see [IsRGIEmoji].
+//
+// Examples:
+//
+//	"😀" -> true
+//	"a😀" -> false
+//	"😀😀" -> false
+//	"👨‍👩‍👧" -> true
+//	"👨‍🌍" -> true // not RGI
+func IsEmoji(s string) bool {
+	return len(s) > 0 && sequenceLen(s) == len(s)
+}
 
-		next := runes[i+1]
-		if isInRange(r, emoji_ranges2) && next == vs16 {
-			// ED-7 default text presentation character
-			return true
-		}
+// Matches a string that consists of exactly one fully-qualified emoji from the
+// RGI emoji set ([ED-27]) which are the emojis recommended for general interchange.
+// Minimally-qualified and unqualified emojis like "☀" are not matched, see [FullyQualify].
+// See [emoji-test.txt] for a list of matching emojis.
+//
+// Examples:
+//
+//	"😀" -> true
+//	"☀️" -> true
+//	"☀" -> false
+//	"👨‍🌍" -> false
+//	"🇿🇿" -> false
+//
+// [ED-27]: https://www.unicode.org/reports/tr51/#def_rgi_set
+// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+func IsRGIEmoji(s string) bool {
+	return Qualification(s) == FullyQualified
+}
 
-		if isInRange(r, emoji_ranges3) && (next == vs16 || (next >= light_skin && next <= dark_skin)) {
-			// ED-22 RGI emoji modifier sequence set
-			return true
-		}
+// Like [ContainsEmoji] but for a byte slice. Does not allocate.
+func ContainsEmojiBytes(b []byte) bool {
+	return ContainsEmoji(unsafeString(b))
+}
 
-		if IsFlagSequence(runes[i:]) {
-			return true
-		}
-	}
-	return false
+// Returns a string sharing the memory of b. b must not be modified
+// while the string is in use.
+func unsafeString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
 }
 
 // Check if a rune is within any range of a set of ranges.
 // These sets are an array of int32 with each range being defined
 // as two consecutive int32 defining the first and last element
-// of a range respectively.
+// of a range respectively. The ranges have to be sorted and must not overlap
+// which allows for a binary search.
 func isInRange(r rune, ranges []int32) bool {
-	for i := 0; i < len(ranges); i += 2 {
-		if r >= rune(ranges[i]) && r <= rune(ranges[i+1]) {
-			return true
-		}
+	n := len(ranges) / 2
+	if n == 0 || r < rune(ranges[0]) || r > rune(ranges[len(ranges)-1]) {
+		return false
 	}
-	return false
-}
 
-func isInRangeF(ranges []int32) func(rune) bool {
-	return func(r rune) bool { return isInRange(r, ranges) }
+	// Find the first range that ends at or after r
+	i, j := 0, n
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if rune(ranges[2*h+1]) < r {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return rune(ranges[2*i]) <= r
 }
 
 // Matches flag emojis officially known as emoji flag sequence ([ED-14]).
-// Does not check if the flag is valid.
+// Does not check if the flag is valid, see [IsValidFlag].
 //
 // [ED-14]: https://www.unicode.org/reports/tr51/#def_emoji_flag_sequence
 func IsFlagSequence(runes []rune) bool {
@@ -118,20 +154,152 @@ func IsFlagSequence(runes []rune) bool {
 		return false
 	}
 
-	a := runes[0]
-	b := runes[1]
-	return a >= flagA && a <= flagB && b >= flagA && b <= flagB
+	return isRegionalIndicator(runes[0]) && isRegionalIndicator(runes[1])
 }
 
-// Matches a string that contains atleast one flag emoji officially known as emoji flag sequence ([ED-14]).
-// Does not check if the flag is valid.
+// Matches the REGIONAL INDICATOR SYMBOL LETTER characters U+1F1E6 .. U+1F1FF
+func isRegionalIndicator(r rune) bool {
+	return r >= flagA && r <= flagB
+}
+
+// Matches a string that contains atleast one flag emoji officially known as emoji flag sequence ([ED-14])
+// or a subdivision flag which is an emoji tag sequence ([ED-14a]) like 🏴󠁧󠁢󠁳󠁣󠁴󠁿.
+// Does not check if the flag is valid, see [ContainsValidFlag].
 //
 // [ED-14]: https://www.unicode.org/reports/tr51/#def_emoji_flag_sequence
+// [ED-14a]: https://www.unicode.org/reports/tr51/#def_emoji_tag_sequence
 func ContainsFlag(s string) bool {
+	for i := 0; i < len(s); {
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if next, _ := utf8.DecodeRuneInString(s[i+n:]); isRegionalIndicator(r) && isRegionalIndicator(next) {
+			return true
+		}
+		if r == black_flag && tagSequenceLen(s[i:]) > 0 {
+			return true
+		}
+		i += n
+	}
+	return false
+}
+
+// Matches an emoji tag sequence ([ED-14a]) at the start of runes.
+// Does not check if the sequence is valid.
+//
+// Tag sequences are used for subdivision flags like 🏴󠁧󠁢󠁳󠁣󠁴󠁿 (Scotland) which is
+// U+1F3F4 WAVING BLACK FLAG followed by the TAG characters "gbsct" and U+E007F CANCEL TAG.
+//
+// [ED-14a]: https://www.unicode.org/reports/tr51/#def_emoji_tag_sequence
+func IsTagSequence(runes []rune) bool {
+	return tagSequenceLen(string(runes)) > 0
+}
+
+// Matches an RGI emoji tag sequence ([ED-24]) at the start of runes.
+//
+// See section RGI_Emoji_Tag_Sequence of [emoji-sequences.txt] for a list of matching sequences.
+//
+// [ED-24]: https://www.unicode.org/reports/tr51/#def_rgi_emoji_tag_sequence_set
+// [emoji-sequences.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-sequences.txt
+func IsRGITagSequence(runes []rune) bool {
+	s := string(runes)
+	n := tagSequenceLen(s)
+	if n == 0 {
+		return false
+	}
+
+	_, found := slices.BinarySearch(tag_sequences, s[:n])
+	return found
+}
+
+// Returns the subdivision code of a subdivision flag like "gbsct" for 🏴󠁧󠁢󠁳󠁣󠁴󠁿 (Scotland).
+// The string has to consist of a single emoji tag sequence ([ED-14a])
+// starting with U+1F3F4 WAVING BLACK FLAG. Does not check if the flag is valid.
+//
+// [ED-14a]: https://www.unicode.org/reports/tr51/#def_emoji_tag_sequence
+func SubdivisionFromFlag(s string) (string, bool) {
+	if n := tagSequenceLen(s); n == 0 || n != len(s) {
+		return "", false
+	}
+
+	runes := []rune(s)
+	if runes[0] != black_flag {
+		return "", false
+	}
+
+	code := make([]byte, 0, len(runes)-2)
+	for _, r := range runes[1 : len(runes)-1] {
+		code = append(code, byte(r-tag_space+' '))
+	}
+	return string(code), true
+}
+
+// Returned by [FlagFromRegion] for region codes without an RGI flag
+var ErrInvalidRegion = errors.New("emojitoolkit: invalid region code")
+
+// Returns the flag emoji ([ED-14]) of a two letter region code like "DE" -> "🇩🇪".
+// Lower case region codes are accepted too. Returns [ErrInvalidRegion] if the region
+// code is malformed or has no flag in [emoji-sequences.txt] like "ZZ".
+//
+// [ED-14]: https://www.unicode.org/reports/tr51/#def_emoji_flag_sequence
+// [emoji-sequences.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-sequences.txt
+func FlagFromRegion(region string) (string, error) {
+	if len(region) != 2 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRegion, region)
+	}
+
+	runes := make([]rune, 2)
+	for i := range 2 {
+		c := region[i] | 0x20 // ASCII lower case
+		if c < 'a' || c > 'z' {
+			return "", fmt.Errorf("%w: %q", ErrInvalidRegion, region)
+		}
+		runes[i] = flagA + rune(c-'a')
+	}
+
+	flag := string(runes)
+	if !IsValidFlag(flag) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRegion, region)
+	}
+	return flag, nil
+}
+
+// Returns the upper case region code of a flag emoji ([ED-14]) like "🇩🇪" -> "DE".
+// The string has to consist of a single emoji flag sequence.
+// Does not check if the flag is valid.
+//
+// [ED-14]: https://www.unicode.org/reports/tr51/#def_emoji_flag_sequence
+func RegionFromFlag(s string) (string, bool) {
 	runes := []rune(s)
+	if len(runes) != 2 || !IsFlagSequence(runes) {
+		return "", false
+	}
+	return string([]rune{runes[0] - flagA + 'A', runes[1] - flagA + 'A'}), true
+}
 
-	for i := 0; i < len(runes)-1; i++ {
-		if IsFlagSequence(runes[i:]) {
+// Matches a string that consists of a single RGI flag. This is either an
+// RGI emoji flag sequence ([ED-23]) like 🇩🇪 or an RGI emoji tag sequence ([ED-24]) like 🏴󠁧󠁢󠁳󠁣󠁴󠁿.
+// See [emoji-sequences.txt] for a list of matching flags.
+//
+// Examples:
+//
+//	"🇩🇪" -> true
+//	"🇿🇿" -> false
+//	"🇩🇪🇩🇪" -> false
+//
+// [ED-23]: https://www.unicode.org/reports/tr51/#def_rgi_emoji_flag_sequence_set
+// [ED-24]: https://www.unicode.org/reports/tr51/#def_rgi_emoji_tag_sequence_set
+// [emoji-sequences.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-sequences.txt
+func IsValidFlag(s string) bool {
+	if _, found := slices.BinarySearch(flag_sequences, s); found {
+		return true
+	}
+	_, found := slices.BinarySearch(tag_sequences, s)
+	return found
+}
+
+// Like [ContainsFlag] but only matches flags that are valid according to [IsValidFlag].
+func ContainsValidFlag(s string) bool {
+	for _, e := range All(s) {
+		if IsValidFlag(string(e)) {
 			return true
 		}
 	}
@@ -144,6 +312,8 @@ func ContainsFlag(s string) bool {
 // This is done using the U+FE0E VARIATION SELECTOR-15 (VS15) to form a
 // [ED-8a] text presentation sequence. This can only be done to characters
 // listed in [emoji-variation-sequences.txt].
+// Emoji tag, zwj and modifier sequences are left unchanged.
+// s is returned without allocating if nothing has to be changed.
 //
 // Examples
 //
@@ -153,50 +323,13 @@ func ContainsFlag(s string) bool {
 // [ED-8a]: https://www.unicode.org/reports/tr51/#def_text_presentation_sequence
 // [emoji-variation-sequences.txt]: https://www.unicode.org/Public/17.0.0/ucd/emoji/emoji-variation-sequences.txt
 func ToTextPresentation(s string) string {
-	if s == "" {
-		return s
-	}
-
-	// returns the adjusted string and how many were taken
-	f := func(rs []rune) ([]rune, int) {
-		i := slices.IndexFunc(rs, isInRangeF(variant_ranges))
-		if i < 0 {
-			return rs, len(rs)
-		}
-		x := i + 1
-
-		if i+1 < len(rs) && rs[i+1] == vs16 {
-			x++
-		}
-
-		// Special treatment for 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, #, *
-		if r := rs[i]; (r >= '0' && r <= '9') || r == '#' || r == '*' {
-			// ED-14c emoji keycap sequence
-			if i+2 < len(rs) && rs[i+2] == keycap {
-				x++
-			}
-			return rs[:i+1], x
-		}
-
-		ret := make([]rune, i+1)
-		copy(ret, rs[:i+1])
-		return append(ret, vs15), x
-	}
-
-	runes := []rune(s)
-	ret := make([]rune, 0, len(runes))
-	n := 0
-
-	x, i := f(runes[n:])
-	ret = append(ret, x...)
-	n += i
-	for n < len(runes)-1 {
-		x, i := f(runes[n:])
-		ret = append(ret, x...)
-		n += i
-	}
+	return replacePresentation(s, vs15)
+}
 
-	return string(append(ret, runes[n:]...))
+// Like [ToTextPresentation] but appends the result to dst and returns the extended buffer.
+// Allocates only if dst has to grow. dst and src must not overlap.
+func AppendTextPresentation(dst, src []byte) []byte {
+	return appendPresentation(dst, src, vs15)
 }
 
 // Make all emojis in a given string appear in their emoji variants.
@@ -205,6 +338,8 @@ func ToTextPresentation(s string) string {
 // This is done using the U+FE0F VARIATION SELECTOR-16 (VS16) to form a
 // [ED-9a] text presentation sequence. This can only be done to characters
 // listed in [emoji-variation-sequences.txt].
+// Emoji tag, zwj and modifier sequences are left unchanged.
+// s is returned without allocating if nothing has to be changed.
 //
 // Examples
 //
@@ -214,52 +349,112 @@ func ToTextPresentation(s string) string {
 // [ED-9a]: https://www.unicode.org/reports/tr51/#def_emoji_presentation_sequence
 // [emoji-variation-sequences.txt]: https://www.unicode.org/Public/17.0.0/ucd/emoji/emoji-variation-sequences.txt
 func ToEmojiPresentation(s string) string {
-	if s == "" {
+	return replacePresentation(s, vs16)
+}
+
+// Like [ToEmojiPresentation] but appends the result to dst and returns the extended buffer.
+// Allocates only if dst has to grow. dst and src must not overlap.
+func AppendEmojiPresentation(dst, src []byte) []byte {
+	return appendPresentation(dst, src, vs16)
+}
+
+func replacePresentation(s string, vs rune) string {
+	var b strings.Builder
+	last := 0 // end of the last edit
+	changed := false
+	presentationEdits(s, vs, func(start, end int, repl string) {
+		if !changed {
+			b.Grow(len(s) + len(repl))
+			changed = true
+		}
+		b.WriteString(s[last:start])
+		b.WriteString(repl)
+		last = end
+	})
+
+	if !changed {
 		return s
 	}
+	b.WriteString(s[last:])
+	return b.String()
+}
 
-	// returns the adjusted string and how many runes were taken
-	f := func(rs []rune) ([]rune, int) {
-		i := slices.IndexFunc(rs, isInRangeF(variant_ranges))
-		if i < 0 {
-			return rs, len(rs)
+func appendPresentation(dst, src []byte, vs rune) []byte {
+	s := unsafeString(src)
+	last := 0 // end of the last edit
+	presentationEdits(s, vs, func(start, end int, repl string) {
+		dst = append(dst, s[last:start]...)
+		dst = append(dst, repl...)
+		last = end
+	})
+	return append(dst, s[last:]...)
+}
+
+// Calls edit for every change required to make all emojis in s appear as text (VS15)
+// or as emoji (VS16). s[start:end] has to be replaced by repl.
+// Edits are reported in order and do not overlap.
+func presentationEdits(s string, vs rune, edit func(start, end int, repl string)) {
+	for i := 0; i < len(s); {
+		if s[i] < utf8.RuneSelf && !isKeycapBase(rune(s[i])) {
+			i++ // ASCII fast path
+			continue
 		}
-		x := i + 1 // number of runes taken of rs
-
-		// Special treatment for 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, #, *
-		if r := rs[i]; (r >= '0' && r <= '9') || r == '#' || r == '*' {
-			// ED-14c emoji keycap sequence
-			if i+2 < len(rs) && rs[i+1] == vs16 && rs[i+2] == keycap {
-				// Keycap stays keycap.
-				// Return includig the VS16 and COMBINING ENCLOSING KEYCAP
-				return rs[:i+3], x + 2
-			}
-
-			// Ascii numbers stay numbers
-			return rs[:i+1], x
+
+		n, keep, add := presentationUnit(s[i:], vs)
+		if keep < n || add != "" {
+			edit(i+keep, i+n, add)
 		}
+		i += n
+	}
+}
+
+// Processes the unit at the start of s which is either an emoji sequence or a single rune.
+// The first n bytes of s have to be replaced by s[:keep] followed by add
+// to make the unit appear as text (VS15) or as emoji (VS16).
+func presentationUnit(s string, vs rune) (n, keep int, add string) {
+	if s[0] < utf8.RuneSelf && !isKeycapBase(rune(s[0])) {
+		return 1, 1, "" // ASCII fast path
+	}
 
-		if i+1 < len(rs) && (rs[i+1] == vs15 || rs[i+1] == vs16) {
-			x++
+	if n := compoundSequenceLen(s); n > 0 {
+		// Has no text presentation and must not be broken apart
+		return n, n, ""
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+	if !isInRange(r, variant_ranges) {
+		return n, n, ""
+	}
+
+	// Special treatment for 0, 1, 2, 3, 4, 5, 6, 7, 8, 9, #, *
+	if isKeycapBase(r) {
+		if vs == vs16 {
+			// Keycaps stay keycaps and ascii numbers stay numbers.
+			return n, n, ""
 		}
 
-		ret := make([]rune, i+1)
-		copy(ret, rs[:i+1])
-		return append(ret, vs16), x
+		// Remove the emoji presentation including an ED-14c emoji keycap sequence
+		end := n
+		if next, m := utf8.DecodeRuneInString(s[end:]); next == vs15 || next == vs16 {
+			end += m
+		}
+		if next, m := utf8.DecodeRuneInString(s[end:]); next == keycap {
+			end += m
+		}
+		return end, n, ""
 	}
 
-	runes := []rune(s)
-	ret := make([]rune, 0, len(runes))
-	n := 0
-
-	x, i := f(runes[n:])
-	ret = append(ret, x...)
-	n += i
-	for n < len(runes)-1 {
-		x, i = f(runes[n:])
-		ret = append(ret, x...)
-		n += i
+	repl := string(vs15)
+	if vs == vs16 {
+		repl = string(vs16)
 	}
 
-	return string(append(ret, runes[n:]...))
+	switch next, m := utf8.DecodeRuneInString(s[n:]); {
+	case next == vs:
+		return n + m, n + m, ""
+	case next == vs15 || next == vs16:
+		return n + m, n, repl
+	default:
+		return n, n, repl
+	}
 }
@@ -1,7 +1,10 @@
 package emojitoolkit
 
 import (
+	"errors"
+	"slices"
 	"testing"
+	"unicode"
 )
 
 func TestIsSingleCharacterEmoji(t *testing.T) {
@@ -64,18 +67,94 @@ func FuzzContainsEmoji(f *testing.F) {
 	})
 }
 
+func TestIsEmoji(t *testing.T) {
+	testCases := map[string]bool{
+		"":                false,
+		"A":               false,
+		"😀":               true,
+		"a😀":              false,
+		"😀😀":              false,
+		"😀 ":              false,
+		"☀":               false,
+		"☀\uFE0F":         true,
+		"1\uFE0F\u20E3":   true,
+		"🇩🇪":              true,
+		"🇿🇿":              true,
+		"👍🏽":              true,
+		"🏽":               false,
+		"👨\u200D👩\u200D👧": true,
+		"👨\u200D🌍":        true,
+		"👨\u200D":         false,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": true,
+	}
+
+	for input, expected := range testCases {
+		result := IsEmoji(input)
+		if result != expected {
+			t.Fatalf("IsEmoji(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestIsRGIEmoji(t *testing.T) {
+	testCases := map[string]bool{
+		"":                false,
+		"😀":               true,
+		"a😀":              false,
+		"☀":               false,
+		"☀\uFE0F":         true,
+		"1\uFE0F\u20E3":   true,
+		"🇩🇪":              true,
+		"🇿🇿":              false,
+		"👍🏽":              true,
+		"🏽":               false,
+		"👨\u200D👩\u200D👧": true,
+		"👨\u200D🌍":        false,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": true,
+	}
+
+	for input, expected := range testCases {
+		result := IsRGIEmoji(input)
+		if result != expected {
+			t.Fatalf("IsRGIEmoji(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
 func TestData(t *testing.T) {
 	ranges := [][]int32{
 		emoji_ranges1,
 		emoji_ranges2,
 		emoji_ranges3,
 		variant_ranges,
+		wide_ranges,
+		ambiguous_ranges,
 	}
 
 	for _, rs := range ranges {
 		if len(rs)%2 != 0 {
 			t.Fail()
 		}
+
+		// isInRange does a binary search
+		if !slices.IsSorted(rs) {
+			t.Fatal("ranges are not sorted")
+		}
+	}
+
+	if len(grapheme_ranges)%3 != 0 {
+		t.Fatal("grapheme_ranges has to consist of triples")
+	}
+	for i := 3; i < len(grapheme_ranges); i += 3 {
+		if grapheme_ranges[i] <= grapheme_ranges[i-2] {
+			t.Fatal("grapheme_ranges are not sorted")
+		}
+	}
+
+	for _, seqs := range [][]string{zwj_sequences, tag_sequences, flag_sequences} {
+		if !slices.IsSorted(seqs) {
+			t.Fatal("sequences are not sorted")
+		}
 	}
 }
 
@@ -99,6 +178,16 @@ func TestToTextPresentation(t *testing.T) {
 		".🌍.": ".🌍\uFE0E.",
 
 		".🌍.🌍..🌍.": ".🌍\uFE0E.🌍\uFE0E..🌍\uFE0E.",
+
+		"⏳⏳":      "⏳\uFE0E⏳\uFE0E",
+		"⏳\uFE0E": "⏳\uFE0E",
+		"👨‍👩‍👧":   "👨‍👩‍👧",
+		"❤️‍🔥":    "❤️‍🔥",
+		"a❤️‍🔥❤️": "a❤️‍🔥❤\uFE0E",
+		"👍🏻":      "👍🏻",
+		"🇩🇪":      "🇩🇪",
+
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F",
 	}
 
 	for input, expected := range testCases {
@@ -106,6 +195,10 @@ func TestToTextPresentation(t *testing.T) {
 		if result != expected {
 			t.Fatalf("ToTextPresentation(\"%s\") = %s; want %s", input, result, expected)
 		}
+
+		if result := AppendTextPresentation([]byte("x"), []byte(input)); string(result) != "x"+expected {
+			t.Fatalf("AppendTextPresentation(\"x\", %q) = %q; want %q", input, result, "x"+expected)
+		}
 	}
 }
 
@@ -132,6 +225,13 @@ func TestToEmojiPresentation(t *testing.T) {
 		".🌍\uFE0E.": ".🌍\uFE0F.",
 
 		".🌍\uFE0E.🌍..🌍\uFE0E.": ".🌍\uFE0F.🌍\uFE0F..🌍\uFE0F.",
+
+		"☀☀":    "☀️☀️",
+		"👨‍👩‍👧": "👨‍👩‍👧",
+		"🏳‍🌈":   "🏳‍🌈",
+		"a❤‍🔥❤": "a❤‍🔥❤️",
+		"👍🏻":    "👍🏻",
+		"🇩🇪":    "🇩🇪",
 	}
 
 	for input, expected := range testCases {
@@ -140,6 +240,10 @@ func TestToEmojiPresentation(t *testing.T) {
 		if result != expected {
 			t.Fatalf("ToEmojiPresentation(\"%s\") = %s; want %s", input, result, expected)
 		}
+
+		if result := AppendEmojiPresentation([]byte("x"), []byte(input)); string(result) != "x"+expected {
+			t.Fatalf("AppendEmojiPresentation(\"x\", %q) = %q; want %q", input, result, "x"+expected)
+		}
 	}
 }
 
@@ -160,3 +264,284 @@ func TestVariants(t *testing.T) {
 		t.Fatalf("ToTextPresentation(%q) = %q; want %s", emoji, text2, text)
 	}
 }
+
+func TestContainsFlag(t *testing.T) {
+	testCases := map[string]bool{
+		"":     false,
+		"A":    false,
+		"🇩":    false,
+		"🇩🇪":   true,
+		"A🇩🇪.": true,
+		"🏴":    false,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F":  true,
+		"A🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": true,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074":            false,
+	}
+
+	for input, expected := range testCases {
+		result := ContainsFlag(input)
+		if result != expected {
+			t.Fatalf("ContainsFlag(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestIsTagSequence(t *testing.T) {
+	testCases := map[string]bool{
+		"":                      false,
+		"🏴":                     false,
+		"🏴\U000E007F":           false,
+		"🏴\U000E0067\U000E007F": true,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F":  true,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007FA": true,
+		"A\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F":  false,
+	}
+
+	for input, expected := range testCases {
+		result := IsTagSequence([]rune(input))
+		if result != expected {
+			t.Fatalf("IsTagSequence(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestIsRGITagSequence(t *testing.T) {
+	testCases := map[string]bool{
+		"🏴\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F": true,  // gbeng
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": true,  // gbsct
+		"🏴\U000E0067\U000E0062\U000E0077\U000E006C\U000E0073\U000E007F": true,  // gbwls
+		"🏴\U000E0064\U000E0065\U000E0062\U000E0065\U000E007F":           false, // debe
+		"🏴\U000E0067\U000E007F":                                         false,
+		"🇩🇪":                                                            false,
+	}
+
+	for input, expected := range testCases {
+		result := IsRGITagSequence([]rune(input))
+		if result != expected {
+			t.Fatalf("IsRGITagSequence(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestSubdivisionFromFlag(t *testing.T) {
+	testCases := map[string]string{
+		"":   "",
+		"🏴":  "",
+		"🇩🇪": "",
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F":  "gbsct",
+		"🏴\U000E0064\U000E0065\U000E0062\U000E0065\U000E007F":            "debe",
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007FA": "",
+		"⏳\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F":  "",
+	}
+
+	for input, expected := range testCases {
+		result, ok := SubdivisionFromFlag(input)
+		if result != expected || ok != (expected != "") {
+			t.Fatalf("SubdivisionFromFlag(%q) = %q, %v; want %q", input, result, ok, expected)
+		}
+	}
+}
+
+func TestFlagFromRegion(t *testing.T) {
+	testCases := map[string]string{
+		"DE":  "🇩🇪",
+		"de":  "🇩🇪",
+		"uN":  "🇺🇳",
+		"EU":  "🇪🇺",
+		"CQ":  "🇨🇶",
+		"ZZ":  "",
+		"":    "",
+		"D":   "",
+		"DEU": "",
+		"D1":  "",
+		"Ä":   "",
+	}
+
+	for input, expected := range testCases {
+		result, err := FlagFromRegion(input)
+		if result != expected {
+			t.Fatalf("FlagFromRegion(%q) = %q, %v; want %q", input, result, err, expected)
+		}
+		if (err == nil) != (expected != "") || (err != nil && !errors.Is(err, ErrInvalidRegion)) {
+			t.Fatalf("FlagFromRegion(%q) returned error %v", input, err)
+		}
+	}
+}
+
+func TestRegionFromFlag(t *testing.T) {
+	testCases := map[string]string{
+		"🇩🇪":   "DE",
+		"🇿🇿":   "ZZ",
+		"🇩🇪🇩🇪": "",
+		"🇩":    "",
+		"DE":   "",
+		"":     "",
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": "",
+	}
+
+	for input, expected := range testCases {
+		result, ok := RegionFromFlag(input)
+		if result != expected || ok != (expected != "") {
+			t.Fatalf("RegionFromFlag(%q) = %q, %v; want %q", input, result, ok, expected)
+		}
+	}
+}
+
+func TestIsValidFlag(t *testing.T) {
+	testCases := map[string]bool{
+		"":     false,
+		"A":    false,
+		"🇩🇪":   true,
+		"🇺🇳":   true,
+		"🇨🇶":   true,
+		"🇿🇿":   false,
+		"🇩🇪🇩🇪": false,
+		"🇩🇪 ":  false,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": true,
+		"🏴\U000E0064\U000E0065\U000E0062\U000E0065\U000E007F":           false,
+	}
+
+	for input, expected := range testCases {
+		result := IsValidFlag(input)
+		if result != expected {
+			t.Fatalf("IsValidFlag(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestContainsValidFlag(t *testing.T) {
+	testCases := map[string]bool{
+		"":      false,
+		"A":     false,
+		"🇩🇪":    true,
+		"A🇩🇪B":  true,
+		"🇿🇿":    false,
+		"🇿🇿🇩🇪":  true,
+		"🇿🇿 🇿🇿": false,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": true,
+	}
+
+	for input, expected := range testCases {
+		result := ContainsValidFlag(input)
+		if result != expected {
+			t.Fatalf("ContainsValidFlag(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+// Linear reference implementation of isInRange
+func isInRangeLinear(r rune, ranges []int32) bool {
+	for i := 0; i < len(ranges); i += 2 {
+		if r >= rune(ranges[i]) && r <= rune(ranges[i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestIsInRange(t *testing.T) {
+	ranges := [][]int32{
+		emoji_ranges1,
+		emoji_ranges2,
+		emoji_ranges3,
+		variant_ranges,
+	}
+
+	for _, rs := range ranges {
+		for r := rune(0); r <= unicode.MaxRune; r++ {
+			if isInRange(r, rs) != isInRangeLinear(r, rs) {
+				t.Fatalf("isInRange(%U) = %v; want %v", r, isInRange(r, rs), !isInRange(r, rs))
+			}
+		}
+	}
+}
+
+func TestAllocations(t *testing.T) {
+	log, chat := []byte(benchmarkLog), []byte(benchmarkChat)
+	dst := make([]byte, 0, 2*len(benchmarkChat))
+
+	testCases := map[string]func(){
+		"ContainsEmoji":           func() { ContainsEmoji(benchmarkChat) },
+		"ContainsEmojiBytes":      func() { ContainsEmojiBytes(log) },
+		"ContainsFlag":            func() { ContainsFlag(benchmarkChat) },
+		"ToTextPresentation":      func() { ToTextPresentation(benchmarkLog) },
+		"ToEmojiPresentation":     func() { ToEmojiPresentation(benchmarkLog) },
+		"AppendTextPresentation":  func() { AppendTextPresentation(dst[:0], chat) },
+		"AppendEmojiPresentation": func() { AppendEmojiPresentation(dst[:0], log) },
+	}
+
+	for name, f := range testCases {
+		if allocs := testing.AllocsPerRun(100, f); allocs != 0 {
+			t.Fatalf("%s allocates %v times; want 0", name, allocs)
+		}
+	}
+}
+
+const benchmarkLog = `2025-10-17T12:00:00.000Z INFO request handled method=GET path=/api/v1/users/42 status=200 duration=1.234ms bytes=5123 user_agent="Mozilla/5.0 (X11; Linux x86_64)"`
+const benchmarkChat = "🌈 The sun ☀️ danced brightly in the sky, illuminating the bustling city 🏙️ filled with laughter 😂 and music 🎶. Children 🎈 played in the park 🌳, while couples ❤️ strolled hand in hand 👩‍❤️‍👨."
+
+func BenchmarkIsInRange(b *testing.B) {
+	runes := []rune(benchmarkChat)
+
+	b.Run("linear", func(b *testing.B) {
+		for b.Loop() {
+			for _, r := range runes {
+				isInRangeLinear(r, emoji_ranges2)
+			}
+		}
+	})
+
+	b.Run("binary", func(b *testing.B) {
+		for b.Loop() {
+			for _, r := range runes {
+				isInRange(r, emoji_ranges2)
+			}
+		}
+	})
+}
+
+func BenchmarkContainsEmoji(b *testing.B) {
+	b.Run("ascii", func(b *testing.B) {
+		for b.Loop() {
+			ContainsEmoji(benchmarkLog)
+		}
+	})
+
+	b.Run("emoji", func(b *testing.B) {
+		for b.Loop() {
+			ContainsEmoji(benchmarkChat)
+		}
+	})
+}
+
+func BenchmarkAll(b *testing.B) {
+	b.Run("ascii", func(b *testing.B) {
+		for b.Loop() {
+			for range All(benchmarkLog) {
+			}
+		}
+	})
+
+	b.Run("emoji", func(b *testing.B) {
+		for b.Loop() {
+			for range All(benchmarkChat) {
+			}
+		}
+	})
+}
+
+func BenchmarkToTextPresentation(b *testing.B) {
+	b.Run("string", func(b *testing.B) {
+		for b.Loop() {
+			ToTextPresentation(benchmarkChat)
+		}
+	})
+
+	b.Run("bytes", func(b *testing.B) {
+		src := []byte(benchmarkChat)
+		dst := make([]byte, 0, 2*len(src))
+		for b.Loop() {
+			dst = AppendTextPresentation(dst[:0], src)
+		}
+	})
+}
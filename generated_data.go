@@ -2,7 +2,9304 @@
 
 package emojitoolkit
 
+import "unicode"
+
+// Supported Unicode version
+const Version = "17.0.0"
+
 var emoji_ranges1 = []int32{8986, 8987, 9193, 9196, 9200, 9200, 9203, 9203, 9725, 9726, 9748, 9749, 9800, 9811, 9855, 9855, 9875, 9875, 9889, 9889, 9898, 9899, 9917, 9918, 9924, 9925, 9934, 9934, 9940, 9940, 9962, 9962, 9970, 9971, 9973, 9973, 9978, 9978, 9981, 9981, 9989, 9989, 9994, 9995, 10024, 10024, 10060, 10060, 10062, 10062, 10067, 10069, 10071, 10071, 10133, 10135, 10160, 10160, 10175, 10175, 11035, 11036, 11088, 11088, 11093, 11093, 126980, 126980, 127183, 127183, 127374, 127374, 127377, 127386, 127489, 127489, 127514, 127514, 127535, 127535, 127538, 127542, 127544, 127546, 127568, 127569, 127744, 127776, 127789, 127797, 127799, 127868, 127870, 127891, 127904, 127946, 127951, 127955, 127968, 127984, 127988, 127988, 127992, 127994, 128000, 128062, 128064, 128064, 128066, 128252, 128255, 128317, 128331, 128334, 128336, 128359, 128378, 128378, 128405, 128406, 128420, 128420, 128507, 128591, 128640, 128709, 128716, 128716, 128720, 128722, 128725, 128728, 128732, 128735, 128747, 128748, 128756, 128764, 128992, 129003, 129008, 129008, 129292, 129338, 129340, 129349, 129351, 129455, 129460, 129535, 129648, 129660, 129664, 129674, 129678, 129734, 129736, 129736, 129741, 129756, 129759, 129770, 129775, 129784}
 var emoji_ranges2 = []int32{35, 35, 42, 42, 48, 57, 169, 169, 174, 174, 8252, 8252, 8265, 8265, 8482, 8482, 8505, 8505, 8596, 8601, 8617, 8618, 9000, 9000, 9167, 9167, 9197, 9199, 9201, 9202, 9208, 9210, 9410, 9410, 9642, 9643, 9654, 9654, 9664, 9664, 9723, 9724, 9728, 9732, 9742, 9742, 9745, 9745, 9752, 9752, 9757, 9757, 9760, 9760, 9762, 9763, 9766, 9766, 9770, 9770, 9774, 9775, 9784, 9786, 9792, 9792, 9794, 9794, 9823, 9824, 9827, 9827, 9829, 9830, 9832, 9832, 9851, 9851, 9854, 9854, 9874, 9874, 9876, 9879, 9881, 9881, 9883, 9884, 9888, 9888, 9895, 9895, 9904, 9905, 9928, 9928, 9935, 9935, 9937, 9937, 9939, 9939, 9961, 9961, 9968, 9969, 9972, 9972, 9975, 9977, 9986, 9986, 9992, 9993, 9996, 9997, 9999, 9999, 10002, 10002, 10004, 10004, 10006, 10006, 10013, 10013, 10017, 10017, 10035, 10036, 10052, 10052, 10055, 10055, 10083, 10084, 10145, 10145, 10548, 10549, 11013, 11015, 12336, 12336, 12349, 12349, 12951, 12951, 12953, 12953, 127344, 127345, 127358, 127359, 127490, 127490, 127543, 127543, 127777, 127777, 127780, 127788, 127798, 127798, 127869, 127869, 127894, 127895, 127897, 127899, 127902, 127903, 127947, 127950, 127956, 127967, 127987, 127987, 127989, 127989, 127991, 127991, 128063, 128063, 128065, 128065, 128253, 128253, 128329, 128330, 128367, 128368, 128371, 128377, 128391, 128391, 128394, 128397, 128400, 128400, 128421, 128421, 128424, 128424, 128433, 128434, 128444, 128444, 128450, 128452, 128465, 128467, 128476, 128478, 128481, 128481, 128483, 128483, 128488, 128488, 128495, 128495, 128499, 128499, 128506, 128506, 128715, 128715, 128717, 128719, 128736, 128741, 128745, 128745, 128752, 128752, 128755, 128755}
 var emoji_ranges3 = []int32{9757, 9757, 9977, 9977, 9994, 9997, 127877, 127877, 127938, 127940, 127943, 127943, 127946, 127948, 128066, 128067, 128070, 128080, 128102, 128105, 128107, 128120, 128124, 128124, 128129, 128131, 128133, 128135, 128143, 128143, 128145, 128145, 128170, 128170, 128372, 128373, 128378, 128378, 128400, 128400, 128405, 128406, 128581, 128583, 128587, 128591, 128675, 128675, 128692, 128694, 128704, 128704, 128716, 128716, 129292, 129292, 129295, 129295, 129304, 129311, 129318, 129318, 129328, 129337, 129340, 129342, 129399, 129399, 129461, 129462, 129464, 129465, 129467, 129467, 129485, 129487, 129489, 129501, 129731, 129733, 129776, 129784}
+var emoji_table = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0023, Hi: 0x0023, Stride: 1},
+		{Lo: 0x002a, Hi: 0x002a, Stride: 1},
+		{Lo: 0x0030, Hi: 0x0039, Stride: 1},
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x2604, Stride: 1},
+		{Lo: 0x260e, Hi: 0x260e, Stride: 1},
+		{Lo: 0x2611, Hi: 0x2611, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2618, Hi: 0x2618, Stride: 1},
+		{Lo: 0x261d, Hi: 0x261d, Stride: 1},
+		{Lo: 0x2620, Hi: 0x2620, Stride: 1},
+		{Lo: 0x2622, Hi: 0x2623, Stride: 1},
+		{Lo: 0x2626, Hi: 0x2626, Stride: 1},
+		{Lo: 0x262a, Hi: 0x262a, Stride: 1},
+		{Lo: 0x262e, Hi: 0x262f, Stride: 1},
+		{Lo: 0x2638, Hi: 0x263a, Stride: 1},
+		{Lo: 0x2640, Hi: 0x2640, Stride: 1},
+		{Lo: 0x2642, Hi: 0x2642, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x265f, Hi: 0x2660, Stride: 1},
+		{Lo: 0x2663, Hi: 0x2663, Stride: 1},
+		{Lo: 0x2665, Hi: 0x2666, Stride: 1},
+		{Lo: 0x2668, Hi: 0x2668, Stride: 1},
+		{Lo: 0x267b, Hi: 0x267b, Stride: 1},
+		{Lo: 0x267e, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2692, Hi: 0x2697, Stride: 1},
+		{Lo: 0x2699, Hi: 0x2699, Stride: 1},
+		{Lo: 0x269b, Hi: 0x269c, Stride: 1},
+		{Lo: 0x26a0, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26a7, Hi: 0x26a7, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26b0, Hi: 0x26b1, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26c8, Hi: 0x26c8, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26cf, Stride: 1},
+		{Lo: 0x26d1, Hi: 0x26d1, Stride: 1},
+		{Lo: 0x26d3, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26e9, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f0, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26f7, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2702, Hi: 0x2702, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x2708, Hi: 0x270d, Stride: 1},
+		{Lo: 0x270f, Hi: 0x270f, Stride: 1},
+		{Lo: 0x2712, Hi: 0x2712, Stride: 1},
+		{Lo: 0x2714, Hi: 0x2714, Stride: 1},
+		{Lo: 0x2716, Hi: 0x2716, Stride: 1},
+		{Lo: 0x271d, Hi: 0x271d, Stride: 1},
+		{Lo: 0x2721, Hi: 0x2721, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x2733, Hi: 0x2734, Stride: 1},
+		{Lo: 0x2744, Hi: 0x2744, Stride: 1},
+		{Lo: 0x2747, Hi: 0x2747, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2763, Hi: 0x2764, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27a1, Hi: 0x27a1, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f170, Hi: 0x1f171, Stride: 1},
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f202, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f321, Stride: 1},
+		{Lo: 0x1f324, Hi: 0x1f393, Stride: 1},
+		{Lo: 0x1f396, Hi: 0x1f397, Stride: 1},
+		{Lo: 0x1f399, Hi: 0x1f39b, Stride: 1},
+		{Lo: 0x1f39e, Hi: 0x1f3f0, Stride: 1},
+		{Lo: 0x1f3f3, Hi: 0x1f3f5, Stride: 1},
+		{Lo: 0x1f3f7, Hi: 0x1f4fd, Stride: 1},
+		{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f549, Hi: 0x1f54e, Stride: 1},
+		{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+		{Lo: 0x1f56f, Hi: 0x1f570, Stride: 1},
+		{Lo: 0x1f573, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f587, Hi: 0x1f587, Stride: 1},
+		{Lo: 0x1f58a, Hi: 0x1f58d, Stride: 1},
+		{Lo: 0x1f590, Hi: 0x1f590, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f5a4, Hi: 0x1f5a5, Stride: 1},
+		{Lo: 0x1f5a8, Hi: 0x1f5a8, Stride: 1},
+		{Lo: 0x1f5b1, Hi: 0x1f5b2, Stride: 1},
+		{Lo: 0x1f5bc, Hi: 0x1f5bc, Stride: 1},
+		{Lo: 0x1f5c2, Hi: 0x1f5c4, Stride: 1},
+		{Lo: 0x1f5d1, Hi: 0x1f5d3, Stride: 1},
+		{Lo: 0x1f5dc, Hi: 0x1f5de, Stride: 1},
+		{Lo: 0x1f5e1, Hi: 0x1f5e1, Stride: 1},
+		{Lo: 0x1f5e3, Hi: 0x1f5e3, Stride: 1},
+		{Lo: 0x1f5e8, Hi: 0x1f5e8, Stride: 1},
+		{Lo: 0x1f5ef, Hi: 0x1f5ef, Stride: 1},
+		{Lo: 0x1f5f3, Hi: 0x1f5f3, Stride: 1},
+		{Lo: 0x1f5fa, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+		{Lo: 0x1f6cb, Hi: 0x1f6d2, Stride: 1},
+		{Lo: 0x1f6d5, Hi: 0x1f6d8, Stride: 1},
+		{Lo: 0x1f6dc, Hi: 0x1f6e5, Stride: 1},
+		{Lo: 0x1f6e9, Hi: 0x1f6e9, Stride: 1},
+		{Lo: 0x1f6eb, Hi: 0x1f6ec, Stride: 1},
+		{Lo: 0x1f6f0, Hi: 0x1f6f0, Stride: 1},
+		{Lo: 0x1f6f3, Hi: 0x1f6fc, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f7f0, Hi: 0x1f7f0, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1fa7c, Stride: 1},
+		{Lo: 0x1fa80, Hi: 0x1fa8a, Stride: 1},
+		{Lo: 0x1fa8e, Hi: 0x1fac6, Stride: 1},
+		{Lo: 0x1fac8, Hi: 0x1fac8, Stride: 1},
+		{Lo: 0x1facd, Hi: 0x1fadc, Stride: 1},
+		{Lo: 0x1fadf, Hi: 0x1faea, Stride: 1},
+		{Lo: 0x1faef, Hi: 0x1faf8, Stride: 1},
+	},
+	LatinOffset: 5,
+}
+var emoji_presentation_table = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23ec, Stride: 1},
+		{Lo: 0x23f0, Hi: 0x23f0, Stride: 1},
+		{Lo: 0x23f3, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x25fd, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267f, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26a1, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26ce, Stride: 1},
+		{Lo: 0x26d4, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26ea, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f2, Hi: 0x26f3, Stride: 1},
+		{Lo: 0x26f5, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26fa, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270b, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0cf, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f201, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f236, Stride: 1},
+		{Lo: 0x1f238, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f250, Hi: 0x1f251, Stride: 1},
+		{Lo: 0x1f300, Hi: 0x1f320, Stride: 1},
+		{Lo: 0x1f32d, Hi: 0x1f335, Stride: 1},
+		{Lo: 0x1f337, Hi: 0x1f37c, Stride: 1},
+		{Lo: 0x1f37e, Hi: 0x1f393, Stride: 1},
+		{Lo: 0x1f3a0, Hi: 0x1f3ca, Stride: 1},
+		{Lo: 0x1f3cf, Hi: 0x1f3d3, Stride: 1},
+		{Lo: 0x1f3e0, Hi: 0x1f3f0, Stride: 1},
+		{Lo: 0x1f3f4, Hi: 0x1f3f4, Stride: 1},
+		{Lo: 0x1f3f8, Hi: 0x1f43e, Stride: 1},
+		{Lo: 0x1f440, Hi: 0x1f440, Stride: 1},
+		{Lo: 0x1f442, Hi: 0x1f4fc, Stride: 1},
+		{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f54b, Hi: 0x1f54e, Stride: 1},
+		{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+		{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f5a4, Hi: 0x1f5a4, Stride: 1},
+		{Lo: 0x1f5fb, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+		{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+		{Lo: 0x1f6d0, Hi: 0x1f6d2, Stride: 1},
+		{Lo: 0x1f6d5, Hi: 0x1f6d8, Stride: 1},
+		{Lo: 0x1f6dc, Hi: 0x1f6df, Stride: 1},
+		{Lo: 0x1f6eb, Hi: 0x1f6ec, Stride: 1},
+		{Lo: 0x1f6f4, Hi: 0x1f6fc, Stride: 1},
+		{Lo: 0x1f7e0, Hi: 0x1f7eb, Stride: 1},
+		{Lo: 0x1f7f0, Hi: 0x1f7f0, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa70, Hi: 0x1fa7c, Stride: 1},
+		{Lo: 0x1fa80, Hi: 0x1fa8a, Stride: 1},
+		{Lo: 0x1fa8e, Hi: 0x1fac6, Stride: 1},
+		{Lo: 0x1fac8, Hi: 0x1fac8, Stride: 1},
+		{Lo: 0x1facd, Hi: 0x1fadc, Stride: 1},
+		{Lo: 0x1fadf, Hi: 0x1faea, Stride: 1},
+		{Lo: 0x1faef, Hi: 0x1faf8, Stride: 1},
+	},
+}
+var emoji_modifier_table = &unicode.RangeTable{
+	R32: []unicode.Range32{
+		{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
+	},
+}
+var emoji_modifier_base_table = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x261d, Hi: 0x261d, Stride: 1},
+		{Lo: 0x26f9, Hi: 0x26f9, Stride: 1},
+		{Lo: 0x270a, Hi: 0x270d, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f385, Hi: 0x1f385, Stride: 1},
+		{Lo: 0x1f3c2, Hi: 0x1f3c4, Stride: 1},
+		{Lo: 0x1f3c7, Hi: 0x1f3c7, Stride: 1},
+		{Lo: 0x1f3ca, Hi: 0x1f3cc, Stride: 1},
+		{Lo: 0x1f442, Hi: 0x1f443, Stride: 1},
+		{Lo: 0x1f446, Hi: 0x1f450, Stride: 1},
+		{Lo: 0x1f466, Hi: 0x1f478, Stride: 1},
+		{Lo: 0x1f47c, Hi: 0x1f47c, Stride: 1},
+		{Lo: 0x1f481, Hi: 0x1f483, Stride: 1},
+		{Lo: 0x1f485, Hi: 0x1f487, Stride: 1},
+		{Lo: 0x1f48f, Hi: 0x1f48f, Stride: 1},
+		{Lo: 0x1f491, Hi: 0x1f491, Stride: 1},
+		{Lo: 0x1f4aa, Hi: 0x1f4aa, Stride: 1},
+		{Lo: 0x1f574, Hi: 0x1f575, Stride: 1},
+		{Lo: 0x1f57a, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f590, Hi: 0x1f590, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f645, Hi: 0x1f647, Stride: 1},
+		{Lo: 0x1f64b, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f6a3, Hi: 0x1f6a3, Stride: 1},
+		{Lo: 0x1f6b4, Hi: 0x1f6b6, Stride: 1},
+		{Lo: 0x1f6c0, Hi: 0x1f6c0, Stride: 1},
+		{Lo: 0x1f6cc, Hi: 0x1f6cc, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f90c, Stride: 1},
+		{Lo: 0x1f90f, Hi: 0x1f90f, Stride: 1},
+		{Lo: 0x1f918, Hi: 0x1f91f, Stride: 1},
+		{Lo: 0x1f926, Hi: 0x1f926, Stride: 1},
+		{Lo: 0x1f930, Hi: 0x1f939, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f93e, Stride: 1},
+		{Lo: 0x1f977, Hi: 0x1f977, Stride: 1},
+		{Lo: 0x1f9b5, Hi: 0x1f9b6, Stride: 1},
+		{Lo: 0x1f9b8, Hi: 0x1f9b9, Stride: 1},
+		{Lo: 0x1f9bb, Hi: 0x1f9bb, Stride: 1},
+		{Lo: 0x1f9cd, Hi: 0x1f9cf, Stride: 1},
+		{Lo: 0x1f9d1, Hi: 0x1f9dd, Stride: 1},
+		{Lo: 0x1fac3, Hi: 0x1fac5, Stride: 1},
+		{Lo: 0x1faf0, Hi: 0x1faf8, Stride: 1},
+	},
+}
+var emoji_component_table = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x0023, Hi: 0x0023, Stride: 1},
+		{Lo: 0x002a, Hi: 0x002a, Stride: 1},
+		{Lo: 0x0030, Hi: 0x0039, Stride: 1},
+		{Lo: 0x200d, Hi: 0x200d, Stride: 1},
+		{Lo: 0x20e3, Hi: 0x20e3, Stride: 1},
+		{Lo: 0xfe0f, Hi: 0xfe0f, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f1e6, Hi: 0x1f1ff, Stride: 1},
+		{Lo: 0x1f3fb, Hi: 0x1f3ff, Stride: 1},
+		{Lo: 0x1f9b0, Hi: 0x1f9b3, Stride: 1},
+		{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
+	},
+	LatinOffset: 3,
+}
+var extended_pictographic_table = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00a9, Hi: 0x00a9, Stride: 1},
+		{Lo: 0x00ae, Hi: 0x00ae, Stride: 1},
+		{Lo: 0x203c, Hi: 0x203c, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21a9, Hi: 0x21aa, Stride: 1},
+		{Lo: 0x231a, Hi: 0x231b, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x23cf, Hi: 0x23cf, Stride: 1},
+		{Lo: 0x23e9, Hi: 0x23f3, Stride: 1},
+		{Lo: 0x23f8, Hi: 0x23fa, Stride: 1},
+		{Lo: 0x24c2, Hi: 0x24c2, Stride: 1},
+		{Lo: 0x25aa, Hi: 0x25ab, Stride: 1},
+		{Lo: 0x25b6, Hi: 0x25b6, Stride: 1},
+		{Lo: 0x25c0, Hi: 0x25c0, Stride: 1},
+		{Lo: 0x25fb, Hi: 0x25fe, Stride: 1},
+		{Lo: 0x2600, Hi: 0x2604, Stride: 1},
+		{Lo: 0x260e, Hi: 0x260e, Stride: 1},
+		{Lo: 0x2611, Hi: 0x2611, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2618, Hi: 0x2618, Stride: 1},
+		{Lo: 0x261d, Hi: 0x261d, Stride: 1},
+		{Lo: 0x2620, Hi: 0x2620, Stride: 1},
+		{Lo: 0x2622, Hi: 0x2623, Stride: 1},
+		{Lo: 0x2626, Hi: 0x2626, Stride: 1},
+		{Lo: 0x262a, Hi: 0x262a, Stride: 1},
+		{Lo: 0x262e, Hi: 0x262f, Stride: 1},
+		{Lo: 0x2638, Hi: 0x263a, Stride: 1},
+		{Lo: 0x2640, Hi: 0x2640, Stride: 1},
+		{Lo: 0x2642, Hi: 0x2642, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x265f, Hi: 0x2660, Stride: 1},
+		{Lo: 0x2663, Hi: 0x2663, Stride: 1},
+		{Lo: 0x2665, Hi: 0x2666, Stride: 1},
+		{Lo: 0x2668, Hi: 0x2668, Stride: 1},
+		{Lo: 0x267b, Hi: 0x267b, Stride: 1},
+		{Lo: 0x267e, Hi: 0x267f, Stride: 1},
+		{Lo: 0x2692, Hi: 0x2697, Stride: 1},
+		{Lo: 0x2699, Hi: 0x2699, Stride: 1},
+		{Lo: 0x269b, Hi: 0x269c, Stride: 1},
+		{Lo: 0x26a0, Hi: 0x26a1, Stride: 1},
+		{Lo: 0x26a7, Hi: 0x26a7, Stride: 1},
+		{Lo: 0x26aa, Hi: 0x26ab, Stride: 1},
+		{Lo: 0x26b0, Hi: 0x26b1, Stride: 1},
+		{Lo: 0x26bd, Hi: 0x26be, Stride: 1},
+		{Lo: 0x26c4, Hi: 0x26c5, Stride: 1},
+		{Lo: 0x26c8, Hi: 0x26c8, Stride: 1},
+		{Lo: 0x26ce, Hi: 0x26cf, Stride: 1},
+		{Lo: 0x26d1, Hi: 0x26d1, Stride: 1},
+		{Lo: 0x26d3, Hi: 0x26d4, Stride: 1},
+		{Lo: 0x26e9, Hi: 0x26ea, Stride: 1},
+		{Lo: 0x26f0, Hi: 0x26f5, Stride: 1},
+		{Lo: 0x26f7, Hi: 0x26fa, Stride: 1},
+		{Lo: 0x26fd, Hi: 0x26fd, Stride: 1},
+		{Lo: 0x2702, Hi: 0x2702, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x2708, Hi: 0x270d, Stride: 1},
+		{Lo: 0x270f, Hi: 0x270f, Stride: 1},
+		{Lo: 0x2712, Hi: 0x2712, Stride: 1},
+		{Lo: 0x2714, Hi: 0x2714, Stride: 1},
+		{Lo: 0x2716, Hi: 0x2716, Stride: 1},
+		{Lo: 0x271d, Hi: 0x271d, Stride: 1},
+		{Lo: 0x2721, Hi: 0x2721, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x2733, Hi: 0x2734, Stride: 1},
+		{Lo: 0x2744, Hi: 0x2744, Stride: 1},
+		{Lo: 0x2747, Hi: 0x2747, Stride: 1},
+		{Lo: 0x274c, Hi: 0x274c, Stride: 1},
+		{Lo: 0x274e, Hi: 0x274e, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2763, Hi: 0x2764, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27a1, Hi: 0x27a1, Stride: 1},
+		{Lo: 0x27b0, Hi: 0x27b0, Stride: 1},
+		{Lo: 0x27bf, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2b05, Hi: 0x2b07, Stride: 1},
+		{Lo: 0x2b1b, Hi: 0x2b1c, Stride: 1},
+		{Lo: 0x2b50, Hi: 0x2b50, Stride: 1},
+		{Lo: 0x2b55, Hi: 0x2b55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303d, Hi: 0x303d, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f004, Hi: 0x1f004, Stride: 1},
+		{Lo: 0x1f02c, Hi: 0x1f02f, Stride: 1},
+		{Lo: 0x1f094, Hi: 0x1f09f, Stride: 1},
+		{Lo: 0x1f0af, Hi: 0x1f0b0, Stride: 1},
+		{Lo: 0x1f0c0, Hi: 0x1f0c0, Stride: 1},
+		{Lo: 0x1f0cf, Hi: 0x1f0d0, Stride: 1},
+		{Lo: 0x1f0f6, Hi: 0x1f0ff, Stride: 1},
+		{Lo: 0x1f170, Hi: 0x1f171, Stride: 1},
+		{Lo: 0x1f17e, Hi: 0x1f17f, Stride: 1},
+		{Lo: 0x1f18e, Hi: 0x1f18e, Stride: 1},
+		{Lo: 0x1f191, Hi: 0x1f19a, Stride: 1},
+		{Lo: 0x1f1ae, Hi: 0x1f1e5, Stride: 1},
+		{Lo: 0x1f201, Hi: 0x1f20f, Stride: 1},
+		{Lo: 0x1f21a, Hi: 0x1f21a, Stride: 1},
+		{Lo: 0x1f22f, Hi: 0x1f22f, Stride: 1},
+		{Lo: 0x1f232, Hi: 0x1f23a, Stride: 1},
+		{Lo: 0x1f23c, Hi: 0x1f23f, Stride: 1},
+		{Lo: 0x1f249, Hi: 0x1f25f, Stride: 1},
+		{Lo: 0x1f266, Hi: 0x1f321, Stride: 1},
+		{Lo: 0x1f324, Hi: 0x1f393, Stride: 1},
+		{Lo: 0x1f396, Hi: 0x1f397, Stride: 1},
+		{Lo: 0x1f399, Hi: 0x1f39b, Stride: 1},
+		{Lo: 0x1f39e, Hi: 0x1f3f0, Stride: 1},
+		{Lo: 0x1f3f3, Hi: 0x1f3f5, Stride: 1},
+		{Lo: 0x1f3f7, Hi: 0x1f3fa, Stride: 1},
+		{Lo: 0x1f400, Hi: 0x1f4fd, Stride: 1},
+		{Lo: 0x1f4ff, Hi: 0x1f53d, Stride: 1},
+		{Lo: 0x1f549, Hi: 0x1f54e, Stride: 1},
+		{Lo: 0x1f550, Hi: 0x1f567, Stride: 1},
+		{Lo: 0x1f56f, Hi: 0x1f570, Stride: 1},
+		{Lo: 0x1f573, Hi: 0x1f57a, Stride: 1},
+		{Lo: 0x1f587, Hi: 0x1f587, Stride: 1},
+		{Lo: 0x1f58a, Hi: 0x1f58d, Stride: 1},
+		{Lo: 0x1f590, Hi: 0x1f590, Stride: 1},
+		{Lo: 0x1f595, Hi: 0x1f596, Stride: 1},
+		{Lo: 0x1f5a4, Hi: 0x1f5a5, Stride: 1},
+		{Lo: 0x1f5a8, Hi: 0x1f5a8, Stride: 1},
+		{Lo: 0x1f5b1, Hi: 0x1f5b2, Stride: 1},
+		{Lo: 0x1f5bc, Hi: 0x1f5bc, Stride: 1},
+		{Lo: 0x1f5c2, Hi: 0x1f5c4, Stride: 1},
+		{Lo: 0x1f5d1, Hi: 0x1f5d3, Stride: 1},
+		{Lo: 0x1f5dc, Hi: 0x1f5de, Stride: 1},
+		{Lo: 0x1f5e1, Hi: 0x1f5e1, Stride: 1},
+		{Lo: 0x1f5e3, Hi: 0x1f5e3, Stride: 1},
+		{Lo: 0x1f5e8, Hi: 0x1f5e8, Stride: 1},
+		{Lo: 0x1f5ef, Hi: 0x1f5ef, Stride: 1},
+		{Lo: 0x1f5f3, Hi: 0x1f5f3, Stride: 1},
+		{Lo: 0x1f5fa, Hi: 0x1f64f, Stride: 1},
+		{Lo: 0x1f680, Hi: 0x1f6c5, Stride: 1},
+		{Lo: 0x1f6cb, Hi: 0x1f6d2, Stride: 1},
+		{Lo: 0x1f6d5, Hi: 0x1f6e5, Stride: 1},
+		{Lo: 0x1f6e9, Hi: 0x1f6e9, Stride: 1},
+		{Lo: 0x1f6eb, Hi: 0x1f6f0, Stride: 1},
+		{Lo: 0x1f6f3, Hi: 0x1f6ff, Stride: 1},
+		{Lo: 0x1f7da, Hi: 0x1f7ff, Stride: 1},
+		{Lo: 0x1f80c, Hi: 0x1f80f, Stride: 1},
+		{Lo: 0x1f848, Hi: 0x1f84f, Stride: 1},
+		{Lo: 0x1f85a, Hi: 0x1f85f, Stride: 1},
+		{Lo: 0x1f888, Hi: 0x1f88f, Stride: 1},
+		{Lo: 0x1f8ae, Hi: 0x1f8af, Stride: 1},
+		{Lo: 0x1f8bc, Hi: 0x1f8bf, Stride: 1},
+		{Lo: 0x1f8c2, Hi: 0x1f8cf, Stride: 1},
+		{Lo: 0x1f8d9, Hi: 0x1f8ff, Stride: 1},
+		{Lo: 0x1f90c, Hi: 0x1f93a, Stride: 1},
+		{Lo: 0x1f93c, Hi: 0x1f945, Stride: 1},
+		{Lo: 0x1f947, Hi: 0x1f9ff, Stride: 1},
+		{Lo: 0x1fa58, Hi: 0x1fa5f, Stride: 1},
+		{Lo: 0x1fa6e, Hi: 0x1faff, Stride: 1},
+		{Lo: 0x1fc00, Hi: 0x1fffd, Stride: 1},
+	},
+	LatinOffset: 2,
+}
+var grapheme_ranges = []int32{
+	0, 9, gcbControl,
+	10, 10, gcbLF,
+	11, 12, gcbControl,
+	13, 13, gcbCR,
+	14, 31, gcbControl,
+	127, 159, gcbControl,
+	169, 169, gcbExtendedPictographic,
+	173, 173, gcbControl,
+	174, 174, gcbExtendedPictographic,
+	768, 879, gcbExtendInCB,
+	1155, 1161, gcbExtendInCB,
+	1425, 1469, gcbExtendInCB,
+	1471, 1471, gcbExtendInCB,
+	1473, 1474, gcbExtendInCB,
+	1476, 1477, gcbExtendInCB,
+	1479, 1479, gcbExtendInCB,
+	1536, 1541, gcbPrepend,
+	1552, 1562, gcbExtendInCB,
+	1564, 1564, gcbControl,
+	1611, 1631, gcbExtendInCB,
+	1648, 1648, gcbExtendInCB,
+	1750, 1756, gcbExtendInCB,
+	1757, 1757, gcbPrepend,
+	1759, 1764, gcbExtendInCB,
+	1767, 1768, gcbExtendInCB,
+	1770, 1773, gcbExtendInCB,
+	1807, 1807, gcbPrepend,
+	1809, 1809, gcbExtendInCB,
+	1840, 1866, gcbExtendInCB,
+	1958, 1968, gcbExtendInCB,
+	2027, 2035, gcbExtendInCB,
+	2045, 2045, gcbExtendInCB,
+	2070, 2073, gcbExtendInCB,
+	2075, 2083, gcbExtendInCB,
+	2085, 2087, gcbExtendInCB,
+	2089, 2093, gcbExtendInCB,
+	2137, 2139, gcbExtendInCB,
+	2192, 2193, gcbPrepend,
+	2199, 2207, gcbExtendInCB,
+	2250, 2273, gcbExtendInCB,
+	2274, 2274, gcbPrepend,
+	2275, 2306, gcbExtendInCB,
+	2307, 2307, gcbSpacingMark,
+	2325, 2361, gcbConsonant,
+	2362, 2362, gcbExtendInCB,
+	2363, 2363, gcbSpacingMark,
+	2364, 2364, gcbExtendInCB,
+	2366, 2368, gcbSpacingMark,
+	2369, 2376, gcbExtendInCB,
+	2377, 2380, gcbSpacingMark,
+	2381, 2381, gcbLinker,
+	2382, 2383, gcbSpacingMark,
+	2385, 2391, gcbExtendInCB,
+	2392, 2399, gcbConsonant,
+	2402, 2403, gcbExtendInCB,
+	2424, 2431, gcbConsonant,
+	2433, 2433, gcbExtendInCB,
+	2434, 2435, gcbSpacingMark,
+	2453, 2472, gcbConsonant,
+	2474, 2480, gcbConsonant,
+	2482, 2482, gcbConsonant,
+	2486, 2489, gcbConsonant,
+	2492, 2492, gcbExtendInCB,
+	2494, 2494, gcbExtendInCB,
+	2495, 2496, gcbSpacingMark,
+	2497, 2500, gcbExtendInCB,
+	2503, 2504, gcbSpacingMark,
+	2507, 2508, gcbSpacingMark,
+	2509, 2509, gcbLinker,
+	2519, 2519, gcbExtendInCB,
+	2524, 2525, gcbConsonant,
+	2527, 2527, gcbConsonant,
+	2530, 2531, gcbExtendInCB,
+	2544, 2545, gcbConsonant,
+	2558, 2558, gcbExtendInCB,
+	2561, 2562, gcbExtendInCB,
+	2563, 2563, gcbSpacingMark,
+	2620, 2620, gcbExtendInCB,
+	2622, 2624, gcbSpacingMark,
+	2625, 2626, gcbExtendInCB,
+	2631, 2632, gcbExtendInCB,
+	2635, 2637, gcbExtendInCB,
+	2641, 2641, gcbExtendInCB,
+	2672, 2673, gcbExtendInCB,
+	2677, 2677, gcbExtendInCB,
+	2689, 2690, gcbExtendInCB,
+	2691, 2691, gcbSpacingMark,
+	2709, 2728, gcbConsonant,
+	2730, 2736, gcbConsonant,
+	2738, 2739, gcbConsonant,
+	2741, 2745, gcbConsonant,
+	2748, 2748, gcbExtendInCB,
+	2750, 2752, gcbSpacingMark,
+	2753, 2757, gcbExtendInCB,
+	2759, 2760, gcbExtendInCB,
+	2761, 2761, gcbSpacingMark,
+	2763, 2764, gcbSpacingMark,
+	2765, 2765, gcbLinker,
+	2786, 2787, gcbExtendInCB,
+	2809, 2809, gcbConsonant,
+	2810, 2815, gcbExtendInCB,
+	2817, 2817, gcbExtendInCB,
+	2818, 2819, gcbSpacingMark,
+	2837, 2856, gcbConsonant,
+	2858, 2864, gcbConsonant,
+	2866, 2867, gcbConsonant,
+	2869, 2873, gcbConsonant,
+	2876, 2876, gcbExtendInCB,
+	2878, 2879, gcbExtendInCB,
+	2880, 2880, gcbSpacingMark,
+	2881, 2884, gcbExtendInCB,
+	2887, 2888, gcbSpacingMark,
+	2891, 2892, gcbSpacingMark,
+	2893, 2893, gcbLinker,
+	2901, 2903, gcbExtendInCB,
+	2908, 2909, gcbConsonant,
+	2911, 2911, gcbConsonant,
+	2914, 2915, gcbExtendInCB,
+	2929, 2929, gcbConsonant,
+	2946, 2946, gcbExtendInCB,
+	3006, 3006, gcbExtendInCB,
+	3007, 3007, gcbSpacingMark,
+	3008, 3008, gcbExtendInCB,
+	3009, 3010, gcbSpacingMark,
+	3014, 3016, gcbSpacingMark,
+	3018, 3020, gcbSpacingMark,
+	3021, 3021, gcbExtendInCB,
+	3031, 3031, gcbExtendInCB,
+	3072, 3072, gcbExtendInCB,
+	3073, 3075, gcbSpacingMark,
+	3076, 3076, gcbExtendInCB,
+	3093, 3112, gcbConsonant,
+	3114, 3129, gcbConsonant,
+	3132, 3132, gcbExtendInCB,
+	3134, 3136, gcbExtendInCB,
+	3137, 3140, gcbSpacingMark,
+	3142, 3144, gcbExtendInCB,
+	3146, 3148, gcbExtendInCB,
+	3149, 3149, gcbLinker,
+	3157, 3158, gcbExtendInCB,
+	3160, 3162, gcbConsonant,
+	3170, 3171, gcbExtendInCB,
+	3201, 3201, gcbExtendInCB,
+	3202, 3203, gcbSpacingMark,
+	3260, 3260, gcbExtendInCB,
+	3262, 3262, gcbSpacingMark,
+	3263, 3264, gcbExtendInCB,
+	3265, 3265, gcbSpacingMark,
+	3266, 3266, gcbExtendInCB,
+	3267, 3268, gcbSpacingMark,
+	3270, 3272, gcbExtendInCB,
+	3274, 3277, gcbExtendInCB,
+	3285, 3286, gcbExtendInCB,
+	3298, 3299, gcbExtendInCB,
+	3315, 3315, gcbSpacingMark,
+	3328, 3329, gcbExtendInCB,
+	3330, 3331, gcbSpacingMark,
+	3349, 3386, gcbConsonant,
+	3387, 3388, gcbExtendInCB,
+	3390, 3390, gcbExtendInCB,
+	3391, 3392, gcbSpacingMark,
+	3393, 3396, gcbExtendInCB,
+	3398, 3400, gcbSpacingMark,
+	3402, 3404, gcbSpacingMark,
+	3405, 3405, gcbLinker,
+	3406, 3406, gcbPrepend,
+	3415, 3415, gcbExtendInCB,
+	3426, 3427, gcbExtendInCB,
+	3457, 3457, gcbExtendInCB,
+	3458, 3459, gcbSpacingMark,
+	3530, 3530, gcbExtendInCB,
+	3535, 3535, gcbExtendInCB,
+	3536, 3537, gcbSpacingMark,
+	3538, 3540, gcbExtendInCB,
+	3542, 3542, gcbExtendInCB,
+	3544, 3550, gcbSpacingMark,
+	3551, 3551, gcbExtendInCB,
+	3570, 3571, gcbSpacingMark,
+	3633, 3633, gcbExtendInCB,
+	3635, 3635, gcbSpacingMark,
+	3636, 3642, gcbExtendInCB,
+	3655, 3662, gcbExtendInCB,
+	3761, 3761, gcbExtendInCB,
+	3763, 3763, gcbSpacingMark,
+	3764, 3772, gcbExtendInCB,
+	3784, 3790, gcbExtendInCB,
+	3864, 3865, gcbExtendInCB,
+	3893, 3893, gcbExtendInCB,
+	3895, 3895, gcbExtendInCB,
+	3897, 3897, gcbExtendInCB,
+	3902, 3903, gcbSpacingMark,
+	3953, 3966, gcbExtendInCB,
+	3967, 3967, gcbSpacingMark,
+	3968, 3972, gcbExtendInCB,
+	3974, 3975, gcbExtendInCB,
+	3981, 3991, gcbExtendInCB,
+	3993, 4028, gcbExtendInCB,
+	4038, 4038, gcbExtendInCB,
+	4096, 4138, gcbConsonant,
+	4141, 4144, gcbExtendInCB,
+	4145, 4145, gcbSpacingMark,
+	4146, 4151, gcbExtendInCB,
+	4153, 4153, gcbLinker,
+	4154, 4154, gcbExtendInCB,
+	4155, 4156, gcbSpacingMark,
+	4157, 4158, gcbExtendInCB,
+	4159, 4159, gcbConsonant,
+	4176, 4181, gcbConsonant,
+	4182, 4183, gcbSpacingMark,
+	4184, 4185, gcbExtendInCB,
+	4186, 4189, gcbConsonant,
+	4190, 4192, gcbExtendInCB,
+	4193, 4193, gcbConsonant,
+	4197, 4198, gcbConsonant,
+	4206, 4208, gcbConsonant,
+	4209, 4212, gcbExtendInCB,
+	4213, 4225, gcbConsonant,
+	4226, 4226, gcbExtendInCB,
+	4228, 4228, gcbSpacingMark,
+	4229, 4230, gcbExtendInCB,
+	4237, 4237, gcbExtendInCB,
+	4238, 4238, gcbConsonant,
+	4253, 4253, gcbExtendInCB,
+	4352, 4447, gcbL,
+	4448, 4519, gcbV,
+	4520, 4607, gcbT,
+	4957, 4959, gcbExtendInCB,
+	5906, 5909, gcbExtendInCB,
+	5938, 5940, gcbExtendInCB,
+	5970, 5971, gcbExtendInCB,
+	6002, 6003, gcbExtendInCB,
+	6016, 6067, gcbConsonant,
+	6068, 6069, gcbExtendInCB,
+	6070, 6070, gcbSpacingMark,
+	6071, 6077, gcbExtendInCB,
+	6078, 6085, gcbSpacingMark,
+	6086, 6086, gcbExtendInCB,
+	6087, 6088, gcbSpacingMark,
+	6089, 6097, gcbExtendInCB,
+	6098, 6098, gcbLinker,
+	6099, 6099, gcbExtendInCB,
+	6109, 6109, gcbExtendInCB,
+	6155, 6157, gcbExtendInCB,
+	6158, 6158, gcbControl,
+	6159, 6159, gcbExtendInCB,
+	6277, 6278, gcbExtendInCB,
+	6313, 6313, gcbExtendInCB,
+	6432, 6434, gcbExtendInCB,
+	6435, 6438, gcbSpacingMark,
+	6439, 6440, gcbExtendInCB,
+	6441, 6443, gcbSpacingMark,
+	6448, 6449, gcbSpacingMark,
+	6450, 6450, gcbExtendInCB,
+	6451, 6456, gcbSpacingMark,
+	6457, 6459, gcbExtendInCB,
+	6679, 6680, gcbExtendInCB,
+	6681, 6682, gcbSpacingMark,
+	6683, 6683, gcbExtendInCB,
+	6688, 6740, gcbConsonant,
+	6741, 6741, gcbSpacingMark,
+	6742, 6742, gcbExtendInCB,
+	6743, 6743, gcbSpacingMark,
+	6744, 6750, gcbExtendInCB,
+	6752, 6752, gcbLinker,
+	6754, 6754, gcbExtendInCB,
+	6757, 6764, gcbExtendInCB,
+	6765, 6770, gcbSpacingMark,
+	6771, 6780, gcbExtendInCB,
+	6783, 6783, gcbExtendInCB,
+	6832, 6877, gcbExtendInCB,
+	6880, 6891, gcbExtendInCB,
+	6912, 6915, gcbExtendInCB,
+	6916, 6916, gcbSpacingMark,
+	6923, 6924, gcbConsonant,
+	6931, 6963, gcbConsonant,
+	6964, 6973, gcbExtendInCB,
+	6974, 6977, gcbSpacingMark,
+	6978, 6979, gcbExtendInCB,
+	6980, 6980, gcbLinker,
+	6981, 6988, gcbConsonant,
+	7019, 7027, gcbExtendInCB,
+	7040, 7041, gcbExtendInCB,
+	7042, 7042, gcbSpacingMark,
+	7043, 7072, gcbConsonant,
+	7073, 7073, gcbSpacingMark,
+	7074, 7077, gcbExtendInCB,
+	7078, 7079, gcbSpacingMark,
+	7080, 7082, gcbExtendInCB,
+	7083, 7083, gcbLinker,
+	7084, 7085, gcbExtendInCB,
+	7086, 7087, gcbConsonant,
+	7099, 7101, gcbConsonant,
+	7142, 7142, gcbExtendInCB,
+	7143, 7143, gcbSpacingMark,
+	7144, 7145, gcbExtendInCB,
+	7146, 7148, gcbSpacingMark,
+	7149, 7149, gcbExtendInCB,
+	7150, 7150, gcbSpacingMark,
+	7151, 7155, gcbExtendInCB,
+	7204, 7211, gcbSpacingMark,
+	7212, 7219, gcbExtendInCB,
+	7220, 7221, gcbSpacingMark,
+	7222, 7223, gcbExtendInCB,
+	7376, 7378, gcbExtendInCB,
+	7380, 7392, gcbExtendInCB,
+	7393, 7393, gcbSpacingMark,
+	7394, 7400, gcbExtendInCB,
+	7405, 7405, gcbExtendInCB,
+	7412, 7412, gcbExtendInCB,
+	7415, 7415, gcbSpacingMark,
+	7416, 7417, gcbExtendInCB,
+	7616, 7679, gcbExtendInCB,
+	8203, 8203, gcbControl,
+	8204, 8204, gcbExtend,
+	8205, 8205, gcbZWJ,
+	8206, 8207, gcbControl,
+	8232, 8238, gcbControl,
+	8252, 8252, gcbExtendedPictographic,
+	8265, 8265, gcbExtendedPictographic,
+	8288, 8303, gcbControl,
+	8400, 8432, gcbExtendInCB,
+	8482, 8482, gcbExtendedPictographic,
+	8505, 8505, gcbExtendedPictographic,
+	8596, 8601, gcbExtendedPictographic,
+	8617, 8618, gcbExtendedPictographic,
+	8986, 8987, gcbExtendedPictographic,
+	9000, 9000, gcbExtendedPictographic,
+	9167, 9167, gcbExtendedPictographic,
+	9193, 9203, gcbExtendedPictographic,
+	9208, 9210, gcbExtendedPictographic,
+	9410, 9410, gcbExtendedPictographic,
+	9642, 9643, gcbExtendedPictographic,
+	9654, 9654, gcbExtendedPictographic,
+	9664, 9664, gcbExtendedPictographic,
+	9723, 9726, gcbExtendedPictographic,
+	9728, 9732, gcbExtendedPictographic,
+	9742, 9742, gcbExtendedPictographic,
+	9745, 9745, gcbExtendedPictographic,
+	9748, 9749, gcbExtendedPictographic,
+	9752, 9752, gcbExtendedPictographic,
+	9757, 9757, gcbExtendedPictographic,
+	9760, 9760, gcbExtendedPictographic,
+	9762, 9763, gcbExtendedPictographic,
+	9766, 9766, gcbExtendedPictographic,
+	9770, 9770, gcbExtendedPictographic,
+	9774, 9775, gcbExtendedPictographic,
+	9784, 9786, gcbExtendedPictographic,
+	9792, 9792, gcbExtendedPictographic,
+	9794, 9794, gcbExtendedPictographic,
+	9800, 9811, gcbExtendedPictographic,
+	9823, 9824, gcbExtendedPictographic,
+	9827, 9827, gcbExtendedPictographic,
+	9829, 9830, gcbExtendedPictographic,
+	9832, 9832, gcbExtendedPictographic,
+	9851, 9851, gcbExtendedPictographic,
+	9854, 9855, gcbExtendedPictographic,
+	9874, 9879, gcbExtendedPictographic,
+	9881, 9881, gcbExtendedPictographic,
+	9883, 9884, gcbExtendedPictographic,
+	9888, 9889, gcbExtendedPictographic,
+	9895, 9895, gcbExtendedPictographic,
+	9898, 9899, gcbExtendedPictographic,
+	9904, 9905, gcbExtendedPictographic,
+	9917, 9918, gcbExtendedPictographic,
+	9924, 9925, gcbExtendedPictographic,
+	9928, 9928, gcbExtendedPictographic,
+	9934, 9935, gcbExtendedPictographic,
+	9937, 9937, gcbExtendedPictographic,
+	9939, 9940, gcbExtendedPictographic,
+	9961, 9962, gcbExtendedPictographic,
+	9968, 9973, gcbExtendedPictographic,
+	9975, 9978, gcbExtendedPictographic,
+	9981, 9981, gcbExtendedPictographic,
+	9986, 9986, gcbExtendedPictographic,
+	9989, 9989, gcbExtendedPictographic,
+	9992, 9997, gcbExtendedPictographic,
+	9999, 9999, gcbExtendedPictographic,
+	10002, 10002, gcbExtendedPictographic,
+	10004, 10004, gcbExtendedPictographic,
+	10006, 10006, gcbExtendedPictographic,
+	10013, 10013, gcbExtendedPictographic,
+	10017, 10017, gcbExtendedPictographic,
+	10024, 10024, gcbExtendedPictographic,
+	10035, 10036, gcbExtendedPictographic,
+	10052, 10052, gcbExtendedPictographic,
+	10055, 10055, gcbExtendedPictographic,
+	10060, 10060, gcbExtendedPictographic,
+	10062, 10062, gcbExtendedPictographic,
+	10067, 10069, gcbExtendedPictographic,
+	10071, 10071, gcbExtendedPictographic,
+	10083, 10084, gcbExtendedPictographic,
+	10133, 10135, gcbExtendedPictographic,
+	10145, 10145, gcbExtendedPictographic,
+	10160, 10160, gcbExtendedPictographic,
+	10175, 10175, gcbExtendedPictographic,
+	10548, 10549, gcbExtendedPictographic,
+	11013, 11015, gcbExtendedPictographic,
+	11035, 11036, gcbExtendedPictographic,
+	11088, 11088, gcbExtendedPictographic,
+	11093, 11093, gcbExtendedPictographic,
+	11503, 11505, gcbExtendInCB,
+	11647, 11647, gcbExtendInCB,
+	11744, 11775, gcbExtendInCB,
+	12330, 12335, gcbExtendInCB,
+	12336, 12336, gcbExtendedPictographic,
+	12349, 12349, gcbExtendedPictographic,
+	12441, 12442, gcbExtendInCB,
+	12951, 12951, gcbExtendedPictographic,
+	12953, 12953, gcbExtendedPictographic,
+	42607, 42610, gcbExtendInCB,
+	42612, 42621, gcbExtendInCB,
+	42654, 42655, gcbExtendInCB,
+	42736, 42737, gcbExtendInCB,
+	43010, 43010, gcbExtendInCB,
+	43014, 43014, gcbExtendInCB,
+	43019, 43019, gcbExtendInCB,
+	43043, 43044, gcbSpacingMark,
+	43045, 43046, gcbExtendInCB,
+	43047, 43047, gcbSpacingMark,
+	43052, 43052, gcbExtendInCB,
+	43136, 43137, gcbSpacingMark,
+	43188, 43203, gcbSpacingMark,
+	43204, 43205, gcbExtendInCB,
+	43232, 43249, gcbExtendInCB,
+	43263, 43263, gcbExtendInCB,
+	43302, 43309, gcbExtendInCB,
+	43335, 43345, gcbExtendInCB,
+	43346, 43346, gcbSpacingMark,
+	43347, 43347, gcbExtendInCB,
+	43360, 43388, gcbL,
+	43392, 43394, gcbExtendInCB,
+	43395, 43395, gcbSpacingMark,
+	43401, 43403, gcbConsonant,
+	43407, 43442, gcbConsonant,
+	43443, 43443, gcbExtendInCB,
+	43444, 43445, gcbSpacingMark,
+	43446, 43449, gcbExtendInCB,
+	43450, 43451, gcbSpacingMark,
+	43452, 43453, gcbExtendInCB,
+	43454, 43455, gcbSpacingMark,
+	43456, 43456, gcbLinker,
+	43488, 43492, gcbConsonant,
+	43493, 43493, gcbExtendInCB,
+	43495, 43503, gcbConsonant,
+	43514, 43518, gcbConsonant,
+	43561, 43566, gcbExtendInCB,
+	43567, 43568, gcbSpacingMark,
+	43569, 43570, gcbExtendInCB,
+	43571, 43572, gcbSpacingMark,
+	43573, 43574, gcbExtendInCB,
+	43587, 43587, gcbExtendInCB,
+	43596, 43596, gcbExtendInCB,
+	43597, 43597, gcbSpacingMark,
+	43616, 43631, gcbConsonant,
+	43633, 43635, gcbConsonant,
+	43642, 43642, gcbConsonant,
+	43644, 43644, gcbExtendInCB,
+	43646, 43647, gcbConsonant,
+	43696, 43696, gcbExtendInCB,
+	43698, 43700, gcbExtendInCB,
+	43703, 43704, gcbExtendInCB,
+	43710, 43711, gcbExtendInCB,
+	43713, 43713, gcbExtendInCB,
+	43744, 43754, gcbConsonant,
+	43755, 43755, gcbSpacingMark,
+	43756, 43757, gcbExtendInCB,
+	43758, 43759, gcbSpacingMark,
+	43765, 43765, gcbSpacingMark,
+	43766, 43766, gcbLinker,
+	43968, 43994, gcbConsonant,
+	44003, 44004, gcbSpacingMark,
+	44005, 44005, gcbExtendInCB,
+	44006, 44007, gcbSpacingMark,
+	44008, 44008, gcbExtendInCB,
+	44009, 44010, gcbSpacingMark,
+	44012, 44012, gcbSpacingMark,
+	44013, 44013, gcbExtendInCB,
+	44032, 44032, gcbLV,
+	44033, 44059, gcbLVT,
+	44060, 44060, gcbLV,
+	44061, 44087, gcbLVT,
+	44088, 44088, gcbLV,
+	44089, 44115, gcbLVT,
+	44116, 44116, gcbLV,
+	44117, 44143, gcbLVT,
+	44144, 44144, gcbLV,
+	44145, 44171, gcbLVT,
+	44172, 44172, gcbLV,
+	44173, 44199, gcbLVT,
+	44200, 44200, gcbLV,
+	44201, 44227, gcbLVT,
+	44228, 44228, gcbLV,
+	44229, 44255, gcbLVT,
+	44256, 44256, gcbLV,
+	44257, 44283, gcbLVT,
+	44284, 44284, gcbLV,
+	44285, 44311, gcbLVT,
+	44312, 44312, gcbLV,
+	44313, 44339, gcbLVT,
+	44340, 44340, gcbLV,
+	44341, 44367, gcbLVT,
+	44368, 44368, gcbLV,
+	44369, 44395, gcbLVT,
+	44396, 44396, gcbLV,
+	44397, 44423, gcbLVT,
+	44424, 44424, gcbLV,
+	44425, 44451, gcbLVT,
+	44452, 44452, gcbLV,
+	44453, 44479, gcbLVT,
+	44480, 44480, gcbLV,
+	44481, 44507, gcbLVT,
+	44508, 44508, gcbLV,
+	44509, 44535, gcbLVT,
+	44536, 44536, gcbLV,
+	44537, 44563, gcbLVT,
+	44564, 44564, gcbLV,
+	44565, 44591, gcbLVT,
+	44592, 44592, gcbLV,
+	44593, 44619, gcbLVT,
+	44620, 44620, gcbLV,
+	44621, 44647, gcbLVT,
+	44648, 44648, gcbLV,
+	44649, 44675, gcbLVT,
+	44676, 44676, gcbLV,
+	44677, 44703, gcbLVT,
+	44704, 44704, gcbLV,
+	44705, 44731, gcbLVT,
+	44732, 44732, gcbLV,
+	44733, 44759, gcbLVT,
+	44760, 44760, gcbLV,
+	44761, 44787, gcbLVT,
+	44788, 44788, gcbLV,
+	44789, 44815, gcbLVT,
+	44816, 44816, gcbLV,
+	44817, 44843, gcbLVT,
+	44844, 44844, gcbLV,
+	44845, 44871, gcbLVT,
+	44872, 44872, gcbLV,
+	44873, 44899, gcbLVT,
+	44900, 44900, gcbLV,
+	44901, 44927, gcbLVT,
+	44928, 44928, gcbLV,
+	44929, 44955, gcbLVT,
+	44956, 44956, gcbLV,
+	44957, 44983, gcbLVT,
+	44984, 44984, gcbLV,
+	44985, 45011, gcbLVT,
+	45012, 45012, gcbLV,
+	45013, 45039, gcbLVT,
+	45040, 45040, gcbLV,
+	45041, 45067, gcbLVT,
+	45068, 45068, gcbLV,
+	45069, 45095, gcbLVT,
+	45096, 45096, gcbLV,
+	45097, 45123, gcbLVT,
+	45124, 45124, gcbLV,
+	45125, 45151, gcbLVT,
+	45152, 45152, gcbLV,
+	45153, 45179, gcbLVT,
+	45180, 45180, gcbLV,
+	45181, 45207, gcbLVT,
+	45208, 45208, gcbLV,
+	45209, 45235, gcbLVT,
+	45236, 45236, gcbLV,
+	45237, 45263, gcbLVT,
+	45264, 45264, gcbLV,
+	45265, 45291, gcbLVT,
+	45292, 45292, gcbLV,
+	45293, 45319, gcbLVT,
+	45320, 45320, gcbLV,
+	45321, 45347, gcbLVT,
+	45348, 45348, gcbLV,
+	45349, 45375, gcbLVT,
+	45376, 45376, gcbLV,
+	45377, 45403, gcbLVT,
+	45404, 45404, gcbLV,
+	45405, 45431, gcbLVT,
+	45432, 45432, gcbLV,
+	45433, 45459, gcbLVT,
+	45460, 45460, gcbLV,
+	45461, 45487, gcbLVT,
+	45488, 45488, gcbLV,
+	45489, 45515, gcbLVT,
+	45516, 45516, gcbLV,
+	45517, 45543, gcbLVT,
+	45544, 45544, gcbLV,
+	45545, 45571, gcbLVT,
+	45572, 45572, gcbLV,
+	45573, 45599, gcbLVT,
+	45600, 45600, gcbLV,
+	45601, 45627, gcbLVT,
+	45628, 45628, gcbLV,
+	45629, 45655, gcbLVT,
+	45656, 45656, gcbLV,
+	45657, 45683, gcbLVT,
+	45684, 45684, gcbLV,
+	45685, 45711, gcbLVT,
+	45712, 45712, gcbLV,
+	45713, 45739, gcbLVT,
+	45740, 45740, gcbLV,
+	45741, 45767, gcbLVT,
+	45768, 45768, gcbLV,
+	45769, 45795, gcbLVT,
+	45796, 45796, gcbLV,
+	45797, 45823, gcbLVT,
+	45824, 45824, gcbLV,
+	45825, 45851, gcbLVT,
+	45852, 45852, gcbLV,
+	45853, 45879, gcbLVT,
+	45880, 45880, gcbLV,
+	45881, 45907, gcbLVT,
+	45908, 45908, gcbLV,
+	45909, 45935, gcbLVT,
+	45936, 45936, gcbLV,
+	45937, 45963, gcbLVT,
+	45964, 45964, gcbLV,
+	45965, 45991, gcbLVT,
+	45992, 45992, gcbLV,
+	45993, 46019, gcbLVT,
+	46020, 46020, gcbLV,
+	46021, 46047, gcbLVT,
+	46048, 46048, gcbLV,
+	46049, 46075, gcbLVT,
+	46076, 46076, gcbLV,
+	46077, 46103, gcbLVT,
+	46104, 46104, gcbLV,
+	46105, 46131, gcbLVT,
+	46132, 46132, gcbLV,
+	46133, 46159, gcbLVT,
+	46160, 46160, gcbLV,
+	46161, 46187, gcbLVT,
+	46188, 46188, gcbLV,
+	46189, 46215, gcbLVT,
+	46216, 46216, gcbLV,
+	46217, 46243, gcbLVT,
+	46244, 46244, gcbLV,
+	46245, 46271, gcbLVT,
+	46272, 46272, gcbLV,
+	46273, 46299, gcbLVT,
+	46300, 46300, gcbLV,
+	46301, 46327, gcbLVT,
+	46328, 46328, gcbLV,
+	46329, 46355, gcbLVT,
+	46356, 46356, gcbLV,
+	46357, 46383, gcbLVT,
+	46384, 46384, gcbLV,
+	46385, 46411, gcbLVT,
+	46412, 46412, gcbLV,
+	46413, 46439, gcbLVT,
+	46440, 46440, gcbLV,
+	46441, 46467, gcbLVT,
+	46468, 46468, gcbLV,
+	46469, 46495, gcbLVT,
+	46496, 46496, gcbLV,
+	46497, 46523, gcbLVT,
+	46524, 46524, gcbLV,
+	46525, 46551, gcbLVT,
+	46552, 46552, gcbLV,
+	46553, 46579, gcbLVT,
+	46580, 46580, gcbLV,
+	46581, 46607, gcbLVT,
+	46608, 46608, gcbLV,
+	46609, 46635, gcbLVT,
+	46636, 46636, gcbLV,
+	46637, 46663, gcbLVT,
+	46664, 46664, gcbLV,
+	46665, 46691, gcbLVT,
+	46692, 46692, gcbLV,
+	46693, 46719, gcbLVT,
+	46720, 46720, gcbLV,
+	46721, 46747, gcbLVT,
+	46748, 46748, gcbLV,
+	46749, 46775, gcbLVT,
+	46776, 46776, gcbLV,
+	46777, 46803, gcbLVT,
+	46804, 46804, gcbLV,
+	46805, 46831, gcbLVT,
+	46832, 46832, gcbLV,
+	46833, 46859, gcbLVT,
+	46860, 46860, gcbLV,
+	46861, 46887, gcbLVT,
+	46888, 46888, gcbLV,
+	46889, 46915, gcbLVT,
+	46916, 46916, gcbLV,
+	46917, 46943, gcbLVT,
+	46944, 46944, gcbLV,
+	46945, 46971, gcbLVT,
+	46972, 46972, gcbLV,
+	46973, 46999, gcbLVT,
+	47000, 47000, gcbLV,
+	47001, 47027, gcbLVT,
+	47028, 47028, gcbLV,
+	47029, 47055, gcbLVT,
+	47056, 47056, gcbLV,
+	47057, 47083, gcbLVT,
+	47084, 47084, gcbLV,
+	47085, 47111, gcbLVT,
+	47112, 47112, gcbLV,
+	47113, 47139, gcbLVT,
+	47140, 47140, gcbLV,
+	47141, 47167, gcbLVT,
+	47168, 47168, gcbLV,
+	47169, 47195, gcbLVT,
+	47196, 47196, gcbLV,
+	47197, 47223, gcbLVT,
+	47224, 47224, gcbLV,
+	47225, 47251, gcbLVT,
+	47252, 47252, gcbLV,
+	47253, 47279, gcbLVT,
+	47280, 47280, gcbLV,
+	47281, 47307, gcbLVT,
+	47308, 47308, gcbLV,
+	47309, 47335, gcbLVT,
+	47336, 47336, gcbLV,
+	47337, 47363, gcbLVT,
+	47364, 47364, gcbLV,
+	47365, 47391, gcbLVT,
+	47392, 47392, gcbLV,
+	47393, 47419, gcbLVT,
+	47420, 47420, gcbLV,
+	47421, 47447, gcbLVT,
+	47448, 47448, gcbLV,
+	47449, 47475, gcbLVT,
+	47476, 47476, gcbLV,
+	47477, 47503, gcbLVT,
+	47504, 47504, gcbLV,
+	47505, 47531, gcbLVT,
+	47532, 47532, gcbLV,
+	47533, 47559, gcbLVT,
+	47560, 47560, gcbLV,
+	47561, 47587, gcbLVT,
+	47588, 47588, gcbLV,
+	47589, 47615, gcbLVT,
+	47616, 47616, gcbLV,
+	47617, 47643, gcbLVT,
+	47644, 47644, gcbLV,
+	47645, 47671, gcbLVT,
+	47672, 47672, gcbLV,
+	47673, 47699, gcbLVT,
+	47700, 47700, gcbLV,
+	47701, 47727, gcbLVT,
+	47728, 47728, gcbLV,
+	47729, 47755, gcbLVT,
+	47756, 47756, gcbLV,
+	47757, 47783, gcbLVT,
+	47784, 47784, gcbLV,
+	47785, 47811, gcbLVT,
+	47812, 47812, gcbLV,
+	47813, 47839, gcbLVT,
+	47840, 47840, gcbLV,
+	47841, 47867, gcbLVT,
+	47868, 47868, gcbLV,
+	47869, 47895, gcbLVT,
+	47896, 47896, gcbLV,
+	47897, 47923, gcbLVT,
+	47924, 47924, gcbLV,
+	47925, 47951, gcbLVT,
+	47952, 47952, gcbLV,
+	47953, 47979, gcbLVT,
+	47980, 47980, gcbLV,
+	47981, 48007, gcbLVT,
+	48008, 48008, gcbLV,
+	48009, 48035, gcbLVT,
+	48036, 48036, gcbLV,
+	48037, 48063, gcbLVT,
+	48064, 48064, gcbLV,
+	48065, 48091, gcbLVT,
+	48092, 48092, gcbLV,
+	48093, 48119, gcbLVT,
+	48120, 48120, gcbLV,
+	48121, 48147, gcbLVT,
+	48148, 48148, gcbLV,
+	48149, 48175, gcbLVT,
+	48176, 48176, gcbLV,
+	48177, 48203, gcbLVT,
+	48204, 48204, gcbLV,
+	48205, 48231, gcbLVT,
+	48232, 48232, gcbLV,
+	48233, 48259, gcbLVT,
+	48260, 48260, gcbLV,
+	48261, 48287, gcbLVT,
+	48288, 48288, gcbLV,
+	48289, 48315, gcbLVT,
+	48316, 48316, gcbLV,
+	48317, 48343, gcbLVT,
+	48344, 48344, gcbLV,
+	48345, 48371, gcbLVT,
+	48372, 48372, gcbLV,
+	48373, 48399, gcbLVT,
+	48400, 48400, gcbLV,
+	48401, 48427, gcbLVT,
+	48428, 48428, gcbLV,
+	48429, 48455, gcbLVT,
+	48456, 48456, gcbLV,
+	48457, 48483, gcbLVT,
+	48484, 48484, gcbLV,
+	48485, 48511, gcbLVT,
+	48512, 48512, gcbLV,
+	48513, 48539, gcbLVT,
+	48540, 48540, gcbLV,
+	48541, 48567, gcbLVT,
+	48568, 48568, gcbLV,
+	48569, 48595, gcbLVT,
+	48596, 48596, gcbLV,
+	48597, 48623, gcbLVT,
+	48624, 48624, gcbLV,
+	48625, 48651, gcbLVT,
+	48652, 48652, gcbLV,
+	48653, 48679, gcbLVT,
+	48680, 48680, gcbLV,
+	48681, 48707, gcbLVT,
+	48708, 48708, gcbLV,
+	48709, 48735, gcbLVT,
+	48736, 48736, gcbLV,
+	48737, 48763, gcbLVT,
+	48764, 48764, gcbLV,
+	48765, 48791, gcbLVT,
+	48792, 48792, gcbLV,
+	48793, 48819, gcbLVT,
+	48820, 48820, gcbLV,
+	48821, 48847, gcbLVT,
+	48848, 48848, gcbLV,
+	48849, 48875, gcbLVT,
+	48876, 48876, gcbLV,
+	48877, 48903, gcbLVT,
+	48904, 48904, gcbLV,
+	48905, 48931, gcbLVT,
+	48932, 48932, gcbLV,
+	48933, 48959, gcbLVT,
+	48960, 48960, gcbLV,
+	48961, 48987, gcbLVT,
+	48988, 48988, gcbLV,
+	48989, 49015, gcbLVT,
+	49016, 49016, gcbLV,
+	49017, 49043, gcbLVT,
+	49044, 49044, gcbLV,
+	49045, 49071, gcbLVT,
+	49072, 49072, gcbLV,
+	49073, 49099, gcbLVT,
+	49100, 49100, gcbLV,
+	49101, 49127, gcbLVT,
+	49128, 49128, gcbLV,
+	49129, 49155, gcbLVT,
+	49156, 49156, gcbLV,
+	49157, 49183, gcbLVT,
+	49184, 49184, gcbLV,
+	49185, 49211, gcbLVT,
+	49212, 49212, gcbLV,
+	49213, 49239, gcbLVT,
+	49240, 49240, gcbLV,
+	49241, 49267, gcbLVT,
+	49268, 49268, gcbLV,
+	49269, 49295, gcbLVT,
+	49296, 49296, gcbLV,
+	49297, 49323, gcbLVT,
+	49324, 49324, gcbLV,
+	49325, 49351, gcbLVT,
+	49352, 49352, gcbLV,
+	49353, 49379, gcbLVT,
+	49380, 49380, gcbLV,
+	49381, 49407, gcbLVT,
+	49408, 49408, gcbLV,
+	49409, 49435, gcbLVT,
+	49436, 49436, gcbLV,
+	49437, 49463, gcbLVT,
+	49464, 49464, gcbLV,
+	49465, 49491, gcbLVT,
+	49492, 49492, gcbLV,
+	49493, 49519, gcbLVT,
+	49520, 49520, gcbLV,
+	49521, 49547, gcbLVT,
+	49548, 49548, gcbLV,
+	49549, 49575, gcbLVT,
+	49576, 49576, gcbLV,
+	49577, 49603, gcbLVT,
+	49604, 49604, gcbLV,
+	49605, 49631, gcbLVT,
+	49632, 49632, gcbLV,
+	49633, 49659, gcbLVT,
+	49660, 49660, gcbLV,
+	49661, 49687, gcbLVT,
+	49688, 49688, gcbLV,
+	49689, 49715, gcbLVT,
+	49716, 49716, gcbLV,
+	49717, 49743, gcbLVT,
+	49744, 49744, gcbLV,
+	49745, 49771, gcbLVT,
+	49772, 49772, gcbLV,
+	49773, 49799, gcbLVT,
+	49800, 49800, gcbLV,
+	49801, 49827, gcbLVT,
+	49828, 49828, gcbLV,
+	49829, 49855, gcbLVT,
+	49856, 49856, gcbLV,
+	49857, 49883, gcbLVT,
+	49884, 49884, gcbLV,
+	49885, 49911, gcbLVT,
+	49912, 49912, gcbLV,
+	49913, 49939, gcbLVT,
+	49940, 49940, gcbLV,
+	49941, 49967, gcbLVT,
+	49968, 49968, gcbLV,
+	49969, 49995, gcbLVT,
+	49996, 49996, gcbLV,
+	49997, 50023, gcbLVT,
+	50024, 50024, gcbLV,
+	50025, 50051, gcbLVT,
+	50052, 50052, gcbLV,
+	50053, 50079, gcbLVT,
+	50080, 50080, gcbLV,
+	50081, 50107, gcbLVT,
+	50108, 50108, gcbLV,
+	50109, 50135, gcbLVT,
+	50136, 50136, gcbLV,
+	50137, 50163, gcbLVT,
+	50164, 50164, gcbLV,
+	50165, 50191, gcbLVT,
+	50192, 50192, gcbLV,
+	50193, 50219, gcbLVT,
+	50220, 50220, gcbLV,
+	50221, 50247, gcbLVT,
+	50248, 50248, gcbLV,
+	50249, 50275, gcbLVT,
+	50276, 50276, gcbLV,
+	50277, 50303, gcbLVT,
+	50304, 50304, gcbLV,
+	50305, 50331, gcbLVT,
+	50332, 50332, gcbLV,
+	50333, 50359, gcbLVT,
+	50360, 50360, gcbLV,
+	50361, 50387, gcbLVT,
+	50388, 50388, gcbLV,
+	50389, 50415, gcbLVT,
+	50416, 50416, gcbLV,
+	50417, 50443, gcbLVT,
+	50444, 50444, gcbLV,
+	50445, 50471, gcbLVT,
+	50472, 50472, gcbLV,
+	50473, 50499, gcbLVT,
+	50500, 50500, gcbLV,
+	50501, 50527, gcbLVT,
+	50528, 50528, gcbLV,
+	50529, 50555, gcbLVT,
+	50556, 50556, gcbLV,
+	50557, 50583, gcbLVT,
+	50584, 50584, gcbLV,
+	50585, 50611, gcbLVT,
+	50612, 50612, gcbLV,
+	50613, 50639, gcbLVT,
+	50640, 50640, gcbLV,
+	50641, 50667, gcbLVT,
+	50668, 50668, gcbLV,
+	50669, 50695, gcbLVT,
+	50696, 50696, gcbLV,
+	50697, 50723, gcbLVT,
+	50724, 50724, gcbLV,
+	50725, 50751, gcbLVT,
+	50752, 50752, gcbLV,
+	50753, 50779, gcbLVT,
+	50780, 50780, gcbLV,
+	50781, 50807, gcbLVT,
+	50808, 50808, gcbLV,
+	50809, 50835, gcbLVT,
+	50836, 50836, gcbLV,
+	50837, 50863, gcbLVT,
+	50864, 50864, gcbLV,
+	50865, 50891, gcbLVT,
+	50892, 50892, gcbLV,
+	50893, 50919, gcbLVT,
+	50920, 50920, gcbLV,
+	50921, 50947, gcbLVT,
+	50948, 50948, gcbLV,
+	50949, 50975, gcbLVT,
+	50976, 50976, gcbLV,
+	50977, 51003, gcbLVT,
+	51004, 51004, gcbLV,
+	51005, 51031, gcbLVT,
+	51032, 51032, gcbLV,
+	51033, 51059, gcbLVT,
+	51060, 51060, gcbLV,
+	51061, 51087, gcbLVT,
+	51088, 51088, gcbLV,
+	51089, 51115, gcbLVT,
+	51116, 51116, gcbLV,
+	51117, 51143, gcbLVT,
+	51144, 51144, gcbLV,
+	51145, 51171, gcbLVT,
+	51172, 51172, gcbLV,
+	51173, 51199, gcbLVT,
+	51200, 51200, gcbLV,
+	51201, 51227, gcbLVT,
+	51228, 51228, gcbLV,
+	51229, 51255, gcbLVT,
+	51256, 51256, gcbLV,
+	51257, 51283, gcbLVT,
+	51284, 51284, gcbLV,
+	51285, 51311, gcbLVT,
+	51312, 51312, gcbLV,
+	51313, 51339, gcbLVT,
+	51340, 51340, gcbLV,
+	51341, 51367, gcbLVT,
+	51368, 51368, gcbLV,
+	51369, 51395, gcbLVT,
+	51396, 51396, gcbLV,
+	51397, 51423, gcbLVT,
+	51424, 51424, gcbLV,
+	51425, 51451, gcbLVT,
+	51452, 51452, gcbLV,
+	51453, 51479, gcbLVT,
+	51480, 51480, gcbLV,
+	51481, 51507, gcbLVT,
+	51508, 51508, gcbLV,
+	51509, 51535, gcbLVT,
+	51536, 51536, gcbLV,
+	51537, 51563, gcbLVT,
+	51564, 51564, gcbLV,
+	51565, 51591, gcbLVT,
+	51592, 51592, gcbLV,
+	51593, 51619, gcbLVT,
+	51620, 51620, gcbLV,
+	51621, 51647, gcbLVT,
+	51648, 51648, gcbLV,
+	51649, 51675, gcbLVT,
+	51676, 51676, gcbLV,
+	51677, 51703, gcbLVT,
+	51704, 51704, gcbLV,
+	51705, 51731, gcbLVT,
+	51732, 51732, gcbLV,
+	51733, 51759, gcbLVT,
+	51760, 51760, gcbLV,
+	51761, 51787, gcbLVT,
+	51788, 51788, gcbLV,
+	51789, 51815, gcbLVT,
+	51816, 51816, gcbLV,
+	51817, 51843, gcbLVT,
+	51844, 51844, gcbLV,
+	51845, 51871, gcbLVT,
+	51872, 51872, gcbLV,
+	51873, 51899, gcbLVT,
+	51900, 51900, gcbLV,
+	51901, 51927, gcbLVT,
+	51928, 51928, gcbLV,
+	51929, 51955, gcbLVT,
+	51956, 51956, gcbLV,
+	51957, 51983, gcbLVT,
+	51984, 51984, gcbLV,
+	51985, 52011, gcbLVT,
+	52012, 52012, gcbLV,
+	52013, 52039, gcbLVT,
+	52040, 52040, gcbLV,
+	52041, 52067, gcbLVT,
+	52068, 52068, gcbLV,
+	52069, 52095, gcbLVT,
+	52096, 52096, gcbLV,
+	52097, 52123, gcbLVT,
+	52124, 52124, gcbLV,
+	52125, 52151, gcbLVT,
+	52152, 52152, gcbLV,
+	52153, 52179, gcbLVT,
+	52180, 52180, gcbLV,
+	52181, 52207, gcbLVT,
+	52208, 52208, gcbLV,
+	52209, 52235, gcbLVT,
+	52236, 52236, gcbLV,
+	52237, 52263, gcbLVT,
+	52264, 52264, gcbLV,
+	52265, 52291, gcbLVT,
+	52292, 52292, gcbLV,
+	52293, 52319, gcbLVT,
+	52320, 52320, gcbLV,
+	52321, 52347, gcbLVT,
+	52348, 52348, gcbLV,
+	52349, 52375, gcbLVT,
+	52376, 52376, gcbLV,
+	52377, 52403, gcbLVT,
+	52404, 52404, gcbLV,
+	52405, 52431, gcbLVT,
+	52432, 52432, gcbLV,
+	52433, 52459, gcbLVT,
+	52460, 52460, gcbLV,
+	52461, 52487, gcbLVT,
+	52488, 52488, gcbLV,
+	52489, 52515, gcbLVT,
+	52516, 52516, gcbLV,
+	52517, 52543, gcbLVT,
+	52544, 52544, gcbLV,
+	52545, 52571, gcbLVT,
+	52572, 52572, gcbLV,
+	52573, 52599, gcbLVT,
+	52600, 52600, gcbLV,
+	52601, 52627, gcbLVT,
+	52628, 52628, gcbLV,
+	52629, 52655, gcbLVT,
+	52656, 52656, gcbLV,
+	52657, 52683, gcbLVT,
+	52684, 52684, gcbLV,
+	52685, 52711, gcbLVT,
+	52712, 52712, gcbLV,
+	52713, 52739, gcbLVT,
+	52740, 52740, gcbLV,
+	52741, 52767, gcbLVT,
+	52768, 52768, gcbLV,
+	52769, 52795, gcbLVT,
+	52796, 52796, gcbLV,
+	52797, 52823, gcbLVT,
+	52824, 52824, gcbLV,
+	52825, 52851, gcbLVT,
+	52852, 52852, gcbLV,
+	52853, 52879, gcbLVT,
+	52880, 52880, gcbLV,
+	52881, 52907, gcbLVT,
+	52908, 52908, gcbLV,
+	52909, 52935, gcbLVT,
+	52936, 52936, gcbLV,
+	52937, 52963, gcbLVT,
+	52964, 52964, gcbLV,
+	52965, 52991, gcbLVT,
+	52992, 52992, gcbLV,
+	52993, 53019, gcbLVT,
+	53020, 53020, gcbLV,
+	53021, 53047, gcbLVT,
+	53048, 53048, gcbLV,
+	53049, 53075, gcbLVT,
+	53076, 53076, gcbLV,
+	53077, 53103, gcbLVT,
+	53104, 53104, gcbLV,
+	53105, 53131, gcbLVT,
+	53132, 53132, gcbLV,
+	53133, 53159, gcbLVT,
+	53160, 53160, gcbLV,
+	53161, 53187, gcbLVT,
+	53188, 53188, gcbLV,
+	53189, 53215, gcbLVT,
+	53216, 53216, gcbLV,
+	53217, 53243, gcbLVT,
+	53244, 53244, gcbLV,
+	53245, 53271, gcbLVT,
+	53272, 53272, gcbLV,
+	53273, 53299, gcbLVT,
+	53300, 53300, gcbLV,
+	53301, 53327, gcbLVT,
+	53328, 53328, gcbLV,
+	53329, 53355, gcbLVT,
+	53356, 53356, gcbLV,
+	53357, 53383, gcbLVT,
+	53384, 53384, gcbLV,
+	53385, 53411, gcbLVT,
+	53412, 53412, gcbLV,
+	53413, 53439, gcbLVT,
+	53440, 53440, gcbLV,
+	53441, 53467, gcbLVT,
+	53468, 53468, gcbLV,
+	53469, 53495, gcbLVT,
+	53496, 53496, gcbLV,
+	53497, 53523, gcbLVT,
+	53524, 53524, gcbLV,
+	53525, 53551, gcbLVT,
+	53552, 53552, gcbLV,
+	53553, 53579, gcbLVT,
+	53580, 53580, gcbLV,
+	53581, 53607, gcbLVT,
+	53608, 53608, gcbLV,
+	53609, 53635, gcbLVT,
+	53636, 53636, gcbLV,
+	53637, 53663, gcbLVT,
+	53664, 53664, gcbLV,
+	53665, 53691, gcbLVT,
+	53692, 53692, gcbLV,
+	53693, 53719, gcbLVT,
+	53720, 53720, gcbLV,
+	53721, 53747, gcbLVT,
+	53748, 53748, gcbLV,
+	53749, 53775, gcbLVT,
+	53776, 53776, gcbLV,
+	53777, 53803, gcbLVT,
+	53804, 53804, gcbLV,
+	53805, 53831, gcbLVT,
+	53832, 53832, gcbLV,
+	53833, 53859, gcbLVT,
+	53860, 53860, gcbLV,
+	53861, 53887, gcbLVT,
+	53888, 53888, gcbLV,
+	53889, 53915, gcbLVT,
+	53916, 53916, gcbLV,
+	53917, 53943, gcbLVT,
+	53944, 53944, gcbLV,
+	53945, 53971, gcbLVT,
+	53972, 53972, gcbLV,
+	53973, 53999, gcbLVT,
+	54000, 54000, gcbLV,
+	54001, 54027, gcbLVT,
+	54028, 54028, gcbLV,
+	54029, 54055, gcbLVT,
+	54056, 54056, gcbLV,
+	54057, 54083, gcbLVT,
+	54084, 54084, gcbLV,
+	54085, 54111, gcbLVT,
+	54112, 54112, gcbLV,
+	54113, 54139, gcbLVT,
+	54140, 54140, gcbLV,
+	54141, 54167, gcbLVT,
+	54168, 54168, gcbLV,
+	54169, 54195, gcbLVT,
+	54196, 54196, gcbLV,
+	54197, 54223, gcbLVT,
+	54224, 54224, gcbLV,
+	54225, 54251, gcbLVT,
+	54252, 54252, gcbLV,
+	54253, 54279, gcbLVT,
+	54280, 54280, gcbLV,
+	54281, 54307, gcbLVT,
+	54308, 54308, gcbLV,
+	54309, 54335, gcbLVT,
+	54336, 54336, gcbLV,
+	54337, 54363, gcbLVT,
+	54364, 54364, gcbLV,
+	54365, 54391, gcbLVT,
+	54392, 54392, gcbLV,
+	54393, 54419, gcbLVT,
+	54420, 54420, gcbLV,
+	54421, 54447, gcbLVT,
+	54448, 54448, gcbLV,
+	54449, 54475, gcbLVT,
+	54476, 54476, gcbLV,
+	54477, 54503, gcbLVT,
+	54504, 54504, gcbLV,
+	54505, 54531, gcbLVT,
+	54532, 54532, gcbLV,
+	54533, 54559, gcbLVT,
+	54560, 54560, gcbLV,
+	54561, 54587, gcbLVT,
+	54588, 54588, gcbLV,
+	54589, 54615, gcbLVT,
+	54616, 54616, gcbLV,
+	54617, 54643, gcbLVT,
+	54644, 54644, gcbLV,
+	54645, 54671, gcbLVT,
+	54672, 54672, gcbLV,
+	54673, 54699, gcbLVT,
+	54700, 54700, gcbLV,
+	54701, 54727, gcbLVT,
+	54728, 54728, gcbLV,
+	54729, 54755, gcbLVT,
+	54756, 54756, gcbLV,
+	54757, 54783, gcbLVT,
+	54784, 54784, gcbLV,
+	54785, 54811, gcbLVT,
+	54812, 54812, gcbLV,
+	54813, 54839, gcbLVT,
+	54840, 54840, gcbLV,
+	54841, 54867, gcbLVT,
+	54868, 54868, gcbLV,
+	54869, 54895, gcbLVT,
+	54896, 54896, gcbLV,
+	54897, 54923, gcbLVT,
+	54924, 54924, gcbLV,
+	54925, 54951, gcbLVT,
+	54952, 54952, gcbLV,
+	54953, 54979, gcbLVT,
+	54980, 54980, gcbLV,
+	54981, 55007, gcbLVT,
+	55008, 55008, gcbLV,
+	55009, 55035, gcbLVT,
+	55036, 55036, gcbLV,
+	55037, 55063, gcbLVT,
+	55064, 55064, gcbLV,
+	55065, 55091, gcbLVT,
+	55092, 55092, gcbLV,
+	55093, 55119, gcbLVT,
+	55120, 55120, gcbLV,
+	55121, 55147, gcbLVT,
+	55148, 55148, gcbLV,
+	55149, 55175, gcbLVT,
+	55176, 55176, gcbLV,
+	55177, 55203, gcbLVT,
+	55216, 55238, gcbV,
+	55243, 55291, gcbT,
+	64286, 64286, gcbExtendInCB,
+	65024, 65039, gcbExtendInCB,
+	65056, 65071, gcbExtendInCB,
+	65279, 65279, gcbControl,
+	65438, 65439, gcbExtendInCB,
+	65520, 65531, gcbControl,
+	66045, 66045, gcbExtendInCB,
+	66272, 66272, gcbExtendInCB,
+	66422, 66426, gcbExtendInCB,
+	68096, 68096, gcbConsonant,
+	68097, 68099, gcbExtendInCB,
+	68101, 68102, gcbExtendInCB,
+	68108, 68111, gcbExtendInCB,
+	68112, 68115, gcbConsonant,
+	68117, 68119, gcbConsonant,
+	68121, 68149, gcbConsonant,
+	68152, 68154, gcbExtendInCB,
+	68159, 68159, gcbLinker,
+	68325, 68326, gcbExtendInCB,
+	68900, 68903, gcbExtendInCB,
+	68969, 68973, gcbExtendInCB,
+	69291, 69292, gcbExtendInCB,
+	69370, 69375, gcbExtendInCB,
+	69446, 69456, gcbExtendInCB,
+	69506, 69509, gcbExtendInCB,
+	69632, 69632, gcbSpacingMark,
+	69633, 69633, gcbExtendInCB,
+	69634, 69634, gcbSpacingMark,
+	69688, 69702, gcbExtendInCB,
+	69744, 69744, gcbExtendInCB,
+	69747, 69748, gcbExtendInCB,
+	69759, 69761, gcbExtendInCB,
+	69762, 69762, gcbSpacingMark,
+	69808, 69810, gcbSpacingMark,
+	69811, 69814, gcbExtendInCB,
+	69815, 69816, gcbSpacingMark,
+	69817, 69818, gcbExtendInCB,
+	69821, 69821, gcbPrepend,
+	69826, 69826, gcbExtendInCB,
+	69837, 69837, gcbPrepend,
+	69888, 69890, gcbExtendInCB,
+	69891, 69926, gcbConsonant,
+	69927, 69931, gcbExtendInCB,
+	69932, 69932, gcbSpacingMark,
+	69933, 69938, gcbExtendInCB,
+	69939, 69939, gcbLinker,
+	69940, 69940, gcbExtendInCB,
+	69956, 69956, gcbConsonant,
+	69957, 69958, gcbSpacingMark,
+	69959, 69959, gcbConsonant,
+	70003, 70003, gcbExtendInCB,
+	70016, 70017, gcbExtendInCB,
+	70018, 70018, gcbSpacingMark,
+	70067, 70069, gcbSpacingMark,
+	70070, 70078, gcbExtendInCB,
+	70079, 70079, gcbSpacingMark,
+	70080, 70080, gcbExtendInCB,
+	70082, 70083, gcbPrepend,
+	70089, 70092, gcbExtendInCB,
+	70094, 70094, gcbSpacingMark,
+	70095, 70095, gcbExtendInCB,
+	70188, 70190, gcbSpacingMark,
+	70191, 70193, gcbExtendInCB,
+	70194, 70195, gcbSpacingMark,
+	70196, 70199, gcbExtendInCB,
+	70206, 70206, gcbExtendInCB,
+	70209, 70209, gcbExtendInCB,
+	70367, 70367, gcbExtendInCB,
+	70368, 70370, gcbSpacingMark,
+	70371, 70378, gcbExtendInCB,
+	70400, 70401, gcbExtendInCB,
+	70402, 70403, gcbSpacingMark,
+	70459, 70460, gcbExtendInCB,
+	70462, 70462, gcbExtendInCB,
+	70463, 70463, gcbSpacingMark,
+	70464, 70464, gcbExtendInCB,
+	70465, 70468, gcbSpacingMark,
+	70471, 70472, gcbSpacingMark,
+	70475, 70476, gcbSpacingMark,
+	70477, 70477, gcbExtendInCB,
+	70487, 70487, gcbExtendInCB,
+	70498, 70499, gcbSpacingMark,
+	70502, 70508, gcbExtendInCB,
+	70512, 70516, gcbExtendInCB,
+	70528, 70537, gcbConsonant,
+	70539, 70539, gcbConsonant,
+	70542, 70542, gcbConsonant,
+	70544, 70581, gcbConsonant,
+	70584, 70584, gcbExtendInCB,
+	70585, 70586, gcbSpacingMark,
+	70587, 70592, gcbExtendInCB,
+	70594, 70594, gcbExtendInCB,
+	70597, 70597, gcbExtendInCB,
+	70599, 70601, gcbExtendInCB,
+	70602, 70602, gcbSpacingMark,
+	70604, 70605, gcbSpacingMark,
+	70606, 70607, gcbExtendInCB,
+	70608, 70608, gcbLinker,
+	70609, 70609, gcbPrepend,
+	70610, 70610, gcbExtendInCB,
+	70625, 70626, gcbExtendInCB,
+	70709, 70711, gcbSpacingMark,
+	70712, 70719, gcbExtendInCB,
+	70720, 70721, gcbSpacingMark,
+	70722, 70724, gcbExtendInCB,
+	70725, 70725, gcbSpacingMark,
+	70726, 70726, gcbExtendInCB,
+	70750, 70750, gcbExtendInCB,
+	70832, 70832, gcbExtendInCB,
+	70833, 70834, gcbSpacingMark,
+	70835, 70840, gcbExtendInCB,
+	70841, 70841, gcbSpacingMark,
+	70842, 70842, gcbExtendInCB,
+	70843, 70844, gcbSpacingMark,
+	70845, 70845, gcbExtendInCB,
+	70846, 70846, gcbSpacingMark,
+	70847, 70848, gcbExtendInCB,
+	70849, 70849, gcbSpacingMark,
+	70850, 70851, gcbExtendInCB,
+	71087, 71087, gcbExtendInCB,
+	71088, 71089, gcbSpacingMark,
+	71090, 71093, gcbExtendInCB,
+	71096, 71099, gcbSpacingMark,
+	71100, 71101, gcbExtendInCB,
+	71102, 71102, gcbSpacingMark,
+	71103, 71104, gcbExtendInCB,
+	71132, 71133, gcbExtendInCB,
+	71216, 71218, gcbSpacingMark,
+	71219, 71226, gcbExtendInCB,
+	71227, 71228, gcbSpacingMark,
+	71229, 71229, gcbExtendInCB,
+	71230, 71230, gcbSpacingMark,
+	71231, 71232, gcbExtendInCB,
+	71339, 71339, gcbExtendInCB,
+	71340, 71340, gcbSpacingMark,
+	71341, 71341, gcbExtendInCB,
+	71342, 71343, gcbSpacingMark,
+	71344, 71351, gcbExtendInCB,
+	71453, 71453, gcbExtendInCB,
+	71454, 71454, gcbSpacingMark,
+	71455, 71455, gcbExtendInCB,
+	71458, 71461, gcbExtendInCB,
+	71462, 71462, gcbSpacingMark,
+	71463, 71467, gcbExtendInCB,
+	71724, 71726, gcbSpacingMark,
+	71727, 71735, gcbExtendInCB,
+	71736, 71736, gcbSpacingMark,
+	71737, 71738, gcbExtendInCB,
+	71936, 71942, gcbConsonant,
+	71945, 71945, gcbConsonant,
+	71948, 71955, gcbConsonant,
+	71957, 71958, gcbConsonant,
+	71960, 71983, gcbConsonant,
+	71984, 71984, gcbExtendInCB,
+	71985, 71989, gcbSpacingMark,
+	71991, 71992, gcbSpacingMark,
+	71995, 71997, gcbExtendInCB,
+	71998, 71998, gcbLinker,
+	71999, 71999, gcbPrepend,
+	72000, 72000, gcbSpacingMark,
+	72001, 72001, gcbPrepend,
+	72002, 72002, gcbSpacingMark,
+	72003, 72003, gcbExtendInCB,
+	72145, 72147, gcbSpacingMark,
+	72148, 72151, gcbExtendInCB,
+	72154, 72155, gcbExtendInCB,
+	72156, 72159, gcbSpacingMark,
+	72160, 72160, gcbExtendInCB,
+	72164, 72164, gcbSpacingMark,
+	72192, 72192, gcbConsonant,
+	72193, 72202, gcbExtendInCB,
+	72203, 72242, gcbConsonant,
+	72243, 72248, gcbExtendInCB,
+	72249, 72249, gcbSpacingMark,
+	72251, 72254, gcbExtendInCB,
+	72263, 72263, gcbLinker,
+	72272, 72272, gcbConsonant,
+	72273, 72278, gcbExtendInCB,
+	72279, 72280, gcbSpacingMark,
+	72281, 72283, gcbExtendInCB,
+	72284, 72323, gcbConsonant,
+	72324, 72329, gcbPrepend,
+	72330, 72342, gcbExtendInCB,
+	72343, 72343, gcbSpacingMark,
+	72344, 72344, gcbExtendInCB,
+	72345, 72345, gcbLinker,
+	72544, 72544, gcbExtendInCB,
+	72545, 72545, gcbSpacingMark,
+	72546, 72548, gcbExtendInCB,
+	72549, 72549, gcbSpacingMark,
+	72550, 72550, gcbExtendInCB,
+	72551, 72551, gcbSpacingMark,
+	72751, 72751, gcbSpacingMark,
+	72752, 72758, gcbExtendInCB,
+	72760, 72765, gcbExtendInCB,
+	72766, 72766, gcbSpacingMark,
+	72767, 72767, gcbExtendInCB,
+	72850, 72871, gcbExtendInCB,
+	72873, 72873, gcbSpacingMark,
+	72874, 72880, gcbExtendInCB,
+	72881, 72881, gcbSpacingMark,
+	72882, 72883, gcbExtendInCB,
+	72884, 72884, gcbSpacingMark,
+	72885, 72886, gcbExtendInCB,
+	73009, 73014, gcbExtendInCB,
+	73018, 73018, gcbExtendInCB,
+	73020, 73021, gcbExtendInCB,
+	73023, 73029, gcbExtendInCB,
+	73030, 73030, gcbPrepend,
+	73031, 73031, gcbExtendInCB,
+	73098, 73102, gcbSpacingMark,
+	73104, 73105, gcbExtendInCB,
+	73107, 73108, gcbSpacingMark,
+	73109, 73109, gcbExtendInCB,
+	73110, 73110, gcbSpacingMark,
+	73111, 73111, gcbExtendInCB,
+	73459, 73460, gcbExtendInCB,
+	73461, 73462, gcbSpacingMark,
+	73472, 73473, gcbExtendInCB,
+	73474, 73474, gcbPrepend,
+	73475, 73475, gcbSpacingMark,
+	73476, 73488, gcbConsonant,
+	73490, 73523, gcbConsonant,
+	73524, 73525, gcbSpacingMark,
+	73526, 73530, gcbExtendInCB,
+	73534, 73535, gcbSpacingMark,
+	73536, 73537, gcbExtendInCB,
+	73538, 73538, gcbLinker,
+	73562, 73562, gcbExtendInCB,
+	78896, 78911, gcbControl,
+	78912, 78912, gcbExtendInCB,
+	78919, 78933, gcbExtendInCB,
+	90398, 90409, gcbExtendInCB,
+	90410, 90412, gcbSpacingMark,
+	90413, 90415, gcbExtendInCB,
+	92912, 92916, gcbExtendInCB,
+	92976, 92982, gcbExtendInCB,
+	93539, 93539, gcbV,
+	93543, 93546, gcbV,
+	94031, 94031, gcbExtendInCB,
+	94033, 94087, gcbSpacingMark,
+	94095, 94098, gcbExtendInCB,
+	94180, 94180, gcbExtendInCB,
+	94192, 94193, gcbExtendInCB,
+	113821, 113822, gcbExtendInCB,
+	113824, 113827, gcbControl,
+	118528, 118573, gcbExtendInCB,
+	118576, 118598, gcbExtendInCB,
+	119141, 119145, gcbExtendInCB,
+	119149, 119154, gcbExtendInCB,
+	119155, 119162, gcbControl,
+	119163, 119170, gcbExtendInCB,
+	119173, 119179, gcbExtendInCB,
+	119210, 119213, gcbExtendInCB,
+	119362, 119364, gcbExtendInCB,
+	121344, 121398, gcbExtendInCB,
+	121403, 121452, gcbExtendInCB,
+	121461, 121461, gcbExtendInCB,
+	121476, 121476, gcbExtendInCB,
+	121499, 121503, gcbExtendInCB,
+	121505, 121519, gcbExtendInCB,
+	122880, 122886, gcbExtendInCB,
+	122888, 122904, gcbExtendInCB,
+	122907, 122913, gcbExtendInCB,
+	122915, 122916, gcbExtendInCB,
+	122918, 122922, gcbExtendInCB,
+	123023, 123023, gcbExtendInCB,
+	123184, 123190, gcbExtendInCB,
+	123566, 123566, gcbExtendInCB,
+	123628, 123631, gcbExtendInCB,
+	124140, 124143, gcbExtendInCB,
+	124398, 124399, gcbExtendInCB,
+	124643, 124643, gcbExtendInCB,
+	124646, 124646, gcbExtendInCB,
+	124654, 124655, gcbExtendInCB,
+	124661, 124661, gcbExtendInCB,
+	125136, 125142, gcbExtendInCB,
+	125252, 125258, gcbExtendInCB,
+	126980, 126980, gcbExtendedPictographic,
+	127020, 127023, gcbExtendedPictographic,
+	127124, 127135, gcbExtendedPictographic,
+	127151, 127152, gcbExtendedPictographic,
+	127168, 127168, gcbExtendedPictographic,
+	127183, 127184, gcbExtendedPictographic,
+	127222, 127231, gcbExtendedPictographic,
+	127344, 127345, gcbExtendedPictographic,
+	127358, 127359, gcbExtendedPictographic,
+	127374, 127374, gcbExtendedPictographic,
+	127377, 127386, gcbExtendedPictographic,
+	127406, 127461, gcbExtendedPictographic,
+	127462, 127487, gcbRegionalIndicator,
+	127489, 127503, gcbExtendedPictographic,
+	127514, 127514, gcbExtendedPictographic,
+	127535, 127535, gcbExtendedPictographic,
+	127538, 127546, gcbExtendedPictographic,
+	127548, 127551, gcbExtendedPictographic,
+	127561, 127583, gcbExtendedPictographic,
+	127590, 127777, gcbExtendedPictographic,
+	127780, 127891, gcbExtendedPictographic,
+	127894, 127895, gcbExtendedPictographic,
+	127897, 127899, gcbExtendedPictographic,
+	127902, 127984, gcbExtendedPictographic,
+	127987, 127989, gcbExtendedPictographic,
+	127991, 127994, gcbExtendedPictographic,
+	127995, 127999, gcbExtendInCB,
+	128000, 128253, gcbExtendedPictographic,
+	128255, 128317, gcbExtendedPictographic,
+	128329, 128334, gcbExtendedPictographic,
+	128336, 128359, gcbExtendedPictographic,
+	128367, 128368, gcbExtendedPictographic,
+	128371, 128378, gcbExtendedPictographic,
+	128391, 128391, gcbExtendedPictographic,
+	128394, 128397, gcbExtendedPictographic,
+	128400, 128400, gcbExtendedPictographic,
+	128405, 128406, gcbExtendedPictographic,
+	128420, 128421, gcbExtendedPictographic,
+	128424, 128424, gcbExtendedPictographic,
+	128433, 128434, gcbExtendedPictographic,
+	128444, 128444, gcbExtendedPictographic,
+	128450, 128452, gcbExtendedPictographic,
+	128465, 128467, gcbExtendedPictographic,
+	128476, 128478, gcbExtendedPictographic,
+	128481, 128481, gcbExtendedPictographic,
+	128483, 128483, gcbExtendedPictographic,
+	128488, 128488, gcbExtendedPictographic,
+	128495, 128495, gcbExtendedPictographic,
+	128499, 128499, gcbExtendedPictographic,
+	128506, 128591, gcbExtendedPictographic,
+	128640, 128709, gcbExtendedPictographic,
+	128715, 128722, gcbExtendedPictographic,
+	128725, 128741, gcbExtendedPictographic,
+	128745, 128745, gcbExtendedPictographic,
+	128747, 128752, gcbExtendedPictographic,
+	128755, 128767, gcbExtendedPictographic,
+	128986, 129023, gcbExtendedPictographic,
+	129036, 129039, gcbExtendedPictographic,
+	129096, 129103, gcbExtendedPictographic,
+	129114, 129119, gcbExtendedPictographic,
+	129160, 129167, gcbExtendedPictographic,
+	129198, 129199, gcbExtendedPictographic,
+	129212, 129215, gcbExtendedPictographic,
+	129218, 129231, gcbExtendedPictographic,
+	129241, 129279, gcbExtendedPictographic,
+	129292, 129338, gcbExtendedPictographic,
+	129340, 129349, gcbExtendedPictographic,
+	129351, 129535, gcbExtendedPictographic,
+	129624, 129631, gcbExtendedPictographic,
+	129646, 129791, gcbExtendedPictographic,
+	130048, 131069, gcbExtendedPictographic,
+	917504, 917535, gcbControl,
+	917536, 917631, gcbExtendInCB,
+	917632, 917759, gcbControl,
+	917760, 917999, gcbExtendInCB,
+	918000, 921599, gcbControl,
+}
+var wide_ranges = []int32{4352, 4447, 8986, 8987, 9001, 9002, 9193, 9196, 9200, 9200, 9203, 9203, 9725, 9726, 9748, 9749, 9776, 9783, 9800, 9811, 9855, 9855, 9866, 9871, 9875, 9875, 9889, 9889, 9898, 9899, 9917, 9918, 9924, 9925, 9934, 9934, 9940, 9940, 9962, 9962, 9970, 9971, 9973, 9973, 9978, 9978, 9981, 9981, 9989, 9989, 9994, 9995, 10024, 10024, 10060, 10060, 10062, 10062, 10067, 10069, 10071, 10071, 10133, 10135, 10160, 10160, 10175, 10175, 11035, 11036, 11088, 11088, 11093, 11093, 11904, 11929, 11931, 12019, 12032, 12245, 12272, 12350, 12353, 12438, 12441, 12543, 12549, 12591, 12593, 12686, 12688, 12773, 12783, 12830, 12832, 12871, 12880, 42124, 42128, 42182, 43360, 43388, 44032, 55203, 63744, 64255, 65040, 65049, 65072, 65106, 65108, 65126, 65128, 65131, 65281, 65376, 65504, 65510, 94176, 94180, 94192, 94198, 94208, 101589, 101631, 101662, 101760, 101874, 110576, 110579, 110581, 110587, 110589, 110590, 110592, 110882, 110898, 110898, 110928, 110930, 110933, 110933, 110948, 110951, 110960, 111355, 119552, 119638, 119648, 119670, 126980, 126980, 127183, 127183, 127374, 127374, 127377, 127386, 127488, 127490, 127504, 127547, 127552, 127560, 127568, 127569, 127584, 127589, 127744, 127776, 127789, 127797, 127799, 127868, 127870, 127891, 127904, 127946, 127951, 127955, 127968, 127984, 127988, 127988, 127992, 128062, 128064, 128064, 128066, 128252, 128255, 128317, 128331, 128334, 128336, 128359, 128378, 128378, 128405, 128406, 128420, 128420, 128507, 128591, 128640, 128709, 128716, 128716, 128720, 128722, 128725, 128728, 128732, 128735, 128747, 128748, 128756, 128764, 128992, 129003, 129008, 129008, 129292, 129338, 129340, 129349, 129351, 129535, 129648, 129660, 129664, 129674, 129678, 129734, 129736, 129736, 129741, 129756, 129759, 129770, 129775, 129784, 131072, 196605, 196608, 262141}
+var ambiguous_ranges = []int32{161, 161, 164, 164, 167, 168, 170, 170, 173, 174, 176, 180, 182, 186, 188, 191, 198, 198, 208, 208, 215, 216, 222, 225, 230, 230, 232, 234, 236, 237, 240, 240, 242, 243, 247, 250, 252, 252, 254, 254, 257, 257, 273, 273, 275, 275, 283, 283, 294, 295, 299, 299, 305, 307, 312, 312, 319, 322, 324, 324, 328, 331, 333, 333, 338, 339, 358, 359, 363, 363, 462, 462, 464, 464, 466, 466, 468, 468, 470, 470, 472, 472, 474, 474, 476, 476, 593, 593, 609, 609, 708, 708, 711, 711, 713, 715, 717, 717, 720, 720, 728, 731, 733, 733, 735, 735, 768, 879, 913, 929, 931, 937, 945, 961, 963, 969, 1025, 1025, 1040, 1103, 1105, 1105, 8208, 8208, 8211, 8214, 8216, 8217, 8220, 8221, 8224, 8226, 8228, 8231, 8240, 8240, 8242, 8243, 8245, 8245, 8251, 8251, 8254, 8254, 8308, 8308, 8319, 8319, 8321, 8324, 8364, 8364, 8451, 8451, 8453, 8453, 8457, 8457, 8467, 8467, 8470, 8470, 8481, 8482, 8486, 8486, 8491, 8491, 8531, 8532, 8539, 8542, 8544, 8555, 8560, 8569, 8585, 8585, 8592, 8601, 8632, 8633, 8658, 8658, 8660, 8660, 8679, 8679, 8704, 8704, 8706, 8707, 8711, 8712, 8715, 8715, 8719, 8719, 8721, 8721, 8725, 8725, 8730, 8730, 8733, 8736, 8739, 8739, 8741, 8741, 8743, 8748, 8750, 8750, 8756, 8759, 8764, 8765, 8776, 8776, 8780, 8780, 8786, 8786, 8800, 8801, 8804, 8807, 8810, 8811, 8814, 8815, 8834, 8835, 8838, 8839, 8853, 8853, 8857, 8857, 8869, 8869, 8895, 8895, 8978, 8978, 9312, 9449, 9451, 9547, 9552, 9587, 9600, 9615, 9618, 9621, 9632, 9633, 9635, 9641, 9650, 9651, 9654, 9655, 9660, 9661, 9664, 9665, 9670, 9672, 9675, 9675, 9678, 9681, 9698, 9701, 9711, 9711, 9733, 9734, 9737, 9737, 9742, 9743, 9756, 9756, 9758, 9758, 9792, 9792, 9794, 9794, 9824, 9825, 9827, 9829, 9831, 9834, 9836, 9837, 9839, 9839, 9886, 9887, 9919, 9919, 9926, 9933, 9935, 9939, 9941, 9953, 9955, 9955, 9960, 9961, 9963, 9969, 9972, 9972, 9974, 9977, 9979, 9980, 9982, 9983, 10045, 10045, 10102, 10111, 11094, 11097, 12872, 12879, 57344, 63743, 65024, 65039, 65533, 65533, 127232, 127242, 127248, 127277, 127280, 127337, 127344, 127373, 127375, 127376, 127387, 127404, 917760, 917999, 983040, 1048573, 1048576, 1114109}
 var variant_ranges = []int32{35, 35, 42, 42, 48, 57, 169, 169, 174, 174, 8252, 8252, 8265, 8265, 8482, 8482, 8505, 8505, 8596, 8601, 8617, 8618, 8986, 8987, 9000, 9000, 9167, 9167, 9193, 9203, 9208, 9210, 9410, 9410, 9642, 9643, 9654, 9654, 9664, 9664, 9723, 9726, 9728, 9732, 9742, 9742, 9745, 9745, 9748, 9749, 9752, 9752, 9757, 9757, 9760, 9760, 9762, 9763, 9766, 9766, 9770, 9770, 9774, 9775, 9784, 9786, 9792, 9792, 9794, 9794, 9800, 9811, 9823, 9824, 9827, 9827, 9829, 9830, 9832, 9832, 9851, 9851, 9854, 9855, 9874, 9879, 9881, 9881, 9883, 9884, 9888, 9889, 9895, 9895, 9898, 9899, 9904, 9905, 9917, 9918, 9924, 9925, 9928, 9928, 9934, 9935, 9937, 9937, 9939, 9940, 9961, 9962, 9968, 9973, 9975, 9978, 9981, 9981, 9986, 9986, 9989, 9989, 9992, 9997, 9999, 9999, 10002, 10002, 10004, 10004, 10006, 10006, 10013, 10013, 10017, 10017, 10024, 10024, 10035, 10036, 10052, 10052, 10055, 10055, 10060, 10060, 10062, 10062, 10067, 10069, 10071, 10071, 10083, 10084, 10133, 10135, 10145, 10145, 10160, 10160, 10175, 10175, 10548, 10549, 11013, 11015, 11035, 11036, 11088, 11088, 11093, 11093, 12336, 12336, 12349, 12349, 12951, 12951, 12953, 12953, 126980, 126980, 127344, 127345, 127358, 127359, 127490, 127490, 127514, 127514, 127535, 127535, 127543, 127543, 127757, 127759, 127765, 127765, 127772, 127772, 127777, 127777, 127780, 127788, 127798, 127798, 127864, 127864, 127869, 127869, 127891, 127891, 127894, 127895, 127897, 127899, 127902, 127903, 127911, 127911, 127916, 127918, 127938, 127938, 127940, 127940, 127942, 127942, 127946, 127950, 127956, 127968, 127981, 127981, 127987, 127987, 127989, 127989, 127991, 127991, 128008, 128008, 128021, 128021, 128031, 128031, 128038, 128038, 128063, 128063, 128065, 128066, 128070, 128073, 128077, 128078, 128083, 128083, 128106, 128106, 128125, 128125, 128163, 128163, 128176, 128176, 128179, 128179, 128187, 128187, 128191, 128191, 128203, 128203, 128218, 128218, 128223, 128223, 128228, 128230, 128234, 128237, 128247, 128247, 128249, 128251, 128253, 128253, 128264, 128264, 128269, 128269, 128274, 128275, 128329, 128330, 128336, 128359, 128367, 128368, 128371, 128377, 128391, 128391, 128394, 128397, 128400, 128400, 128421, 128421, 128424, 128424, 128433, 128434, 128444, 128444, 128450, 128452, 128465, 128467, 128476, 128478, 128481, 128481, 128483, 128483, 128488, 128488, 128495, 128495, 128499, 128499, 128506, 128506, 128528, 128528, 128647, 128647, 128653, 128653, 128657, 128657, 128660, 128660, 128664, 128664, 128685, 128685, 128690, 128690, 128697, 128698, 128700, 128700, 128715, 128715, 128717, 128719, 128736, 128741, 128745, 128745, 128752, 128752, 128755, 128755}
+var zwj_sequences = []string{"⛓\u200d💥", "⛹\u200d♀", "⛹\u200d♂", "⛹🏻\u200d♀", "⛹🏻\u200d♂", "⛹🏼\u200d♀", "⛹🏼\u200d♂", "⛹🏽\u200d♀", "⛹🏽\u200d♂", "⛹🏾\u200d♀", "⛹🏾\u200d♂", "⛹🏿\u200d♀", "⛹🏿\u200d♂", "❤\u200d🔥", "❤\u200d🩹", "🍄\u200d🟫", "🍋\u200d🟩", "🏃\u200d♀", "🏃\u200d♀\u200d➡", "🏃\u200d♂", "🏃\u200d♂\u200d➡", "🏃\u200d➡", "🏃🏻\u200d♀", "🏃🏻\u200d♀\u200d➡", "🏃🏻\u200d♂", "🏃🏻\u200d♂\u200d➡", "🏃🏻\u200d➡", "🏃🏼\u200d♀", "🏃🏼\u200d♀\u200d➡", "🏃🏼\u200d♂", "🏃🏼\u200d♂\u200d➡", "🏃🏼\u200d➡", "🏃🏽\u200d♀", "🏃🏽\u200d♀\u200d➡", "🏃🏽\u200d♂", "🏃🏽\u200d♂\u200d➡", "🏃🏽\u200d➡", "🏃🏾\u200d♀", "🏃🏾\u200d♀\u200d➡", "🏃🏾\u200d♂", "🏃🏾\u200d♂\u200d➡", "🏃🏾\u200d➡", "🏃🏿\u200d♀", "🏃🏿\u200d♀\u200d➡", "🏃🏿\u200d♂", "🏃🏿\u200d♂\u200d➡", "🏃🏿\u200d➡", "🏄\u200d♀", "🏄\u200d♂", "🏄🏻\u200d♀", "🏄🏻\u200d♂", "🏄🏼\u200d♀", "🏄🏼\u200d♂", "🏄🏽\u200d♀", "🏄🏽\u200d♂", "🏄🏾\u200d♀", "🏄🏾\u200d♂", "🏄🏿\u200d♀", "🏄🏿\u200d♂", "🏊\u200d♀", "🏊\u200d♂", "🏊🏻\u200d♀", "🏊🏻\u200d♂", "🏊🏼\u200d♀", "🏊🏼\u200d♂", "🏊🏽\u200d♀", "🏊🏽\u200d♂", "🏊🏾\u200d♀", "🏊🏾\u200d♂", "🏊🏿\u200d♀", "🏊🏿\u200d♂", "🏋\u200d♀", "🏋\u200d♂", "🏋🏻\u200d♀", "🏋🏻\u200d♂", "🏋🏼\u200d♀", "🏋🏼\u200d♂", "🏋🏽\u200d♀", "🏋🏽\u200d♂", "🏋🏾\u200d♀", "🏋🏾\u200d♂", "🏋🏿\u200d♀", "🏋🏿\u200d♂", "🏌\u200d♀", "🏌\u200d♂", "🏌🏻\u200d♀", "🏌🏻\u200d♂", "🏌🏼\u200d♀", "🏌🏼\u200d♂", "🏌🏽\u200d♀", "🏌🏽\u200d♂", "🏌🏾\u200d♀", "🏌🏾\u200d♂", "🏌🏿\u200d♀", "🏌🏿\u200d♂", "🏳\u200d⚧", "🏳\u200d🌈", "🏴\u200d☠", "🐈\u200d⬛", "🐕\u200d🦺", "🐦\u200d⬛", "🐦\u200d🔥", "🐻\u200d❄", "👁\u200d🗨", "👨\u200d⚕", "👨\u200d⚖", "👨\u200d✈", "👨\u200d❤\u200d👨", "👨\u200d❤\u200d💋\u200d👨", "👨\u200d🌾", "👨\u200d🍳", "👨\u200d🍼", "👨\u200d🎓", "👨\u200d🎤", "👨\u200d🎨", "👨\u200d🏫", "👨\u200d🏭", "👨\u200d👦", "👨\u200d👦\u200d👦", "👨\u200d👧", "👨\u200d👧\u200d👦", "👨\u200d👧\u200d👧", "👨\u200d👨\u200d👦", "👨\u200d👨\u200d👦\u200d👦", "👨\u200d👨\u200d👧", "👨\u200d👨\u200d👧\u200d👦", "👨\u200d👨\u200d👧\u200d👧", "👨\u200d👩\u200d👦", "👨\u200d👩\u200d👦\u200d👦", "👨\u200d👩\u200d👧", "👨\u200d👩\u200d👧\u200d👦", "👨\u200d👩\u200d👧\u200d👧", "👨\u200d💻", "👨\u200d💼", "👨\u200d🔧", "👨\u200d🔬", "👨\u200d🚀", "👨\u200d🚒", "👨\u200d🦯", "👨\u200d🦯\u200d➡", "👨\u200d🦰", "👨\u200d🦱", "👨\u200d🦲", "👨\u200d🦳", "👨\u200d🦼", "👨\u200d🦼\u200d➡", "👨\u200d🦽", "👨\u200d🦽\u200d➡", "👨🏻\u200d⚕", "👨🏻\u200d⚖", "👨🏻\u200d✈", "👨🏻\u200d❤\u200d👨🏻", "👨🏻\u200d❤\u200d👨🏼", "👨🏻\u200d❤\u200d👨🏽", "👨🏻\u200d❤\u200d👨🏾", "👨🏻\u200d❤\u200d👨🏿", "👨🏻\u200d❤\u200d💋\u200d👨🏻", "👨🏻\u200d❤\u200d💋\u200d👨🏼", "👨🏻\u200d❤\u200d💋\u200d👨🏽", "👨🏻\u200d❤\u200d💋\u200d👨🏾", "👨🏻\u200d❤\u200d💋\u200d👨🏿", "👨🏻\u200d🌾", "👨🏻\u200d🍳", "👨🏻\u200d🍼", "👨🏻\u200d🎓", "👨🏻\u200d🎤", "👨🏻\u200d🎨", "👨🏻\u200d🏫", "👨🏻\u200d🏭", "👨🏻\u200d🐰\u200d👨🏼", "👨🏻\u200d🐰\u200d👨🏽", "👨🏻\u200d🐰\u200d👨🏾", "👨🏻\u200d🐰\u200d👨🏿", "👨🏻\u200d💻", "👨🏻\u200d💼", "👨🏻\u200d🔧", "👨🏻\u200d🔬", "👨🏻\u200d🚀", "👨🏻\u200d🚒", "👨🏻\u200d🤝\u200d👨🏼", "👨🏻\u200d🤝\u200d👨🏽", "👨🏻\u200d🤝\u200d👨🏾", "👨🏻\u200d🤝\u200d👨🏿", "👨🏻\u200d🦯", "👨🏻\u200d🦯\u200d➡", "👨🏻\u200d🦰", "👨🏻\u200d🦱", "👨🏻\u200d🦲", "👨🏻\u200d🦳", "👨🏻\u200d🦼", "👨🏻\u200d🦼\u200d➡", "👨🏻\u200d🦽", "👨🏻\u200d🦽\u200d➡", "👨🏻\u200d🫯\u200d👨🏼", "👨🏻\u200d🫯\u200d👨🏽", "👨🏻\u200d🫯\u200d👨🏾", "👨🏻\u200d🫯\u200d👨🏿", "👨🏼\u200d⚕", "👨🏼\u200d⚖", "👨🏼\u200d✈", "👨🏼\u200d❤\u200d👨🏻", "👨🏼\u200d❤\u200d👨🏼", "👨🏼\u200d❤\u200d👨🏽", "👨🏼\u200d❤\u200d👨🏾", "👨🏼\u200d❤\u200d👨🏿", "👨🏼\u200d❤\u200d💋\u200d👨🏻", "👨🏼\u200d❤\u200d💋\u200d👨🏼", "👨🏼\u200d❤\u200d💋\u200d👨🏽", "👨🏼\u200d❤\u200d💋\u200d👨🏾", "👨🏼\u200d❤\u200d💋\u200d👨🏿", "👨🏼\u200d🌾", "👨🏼\u200d🍳", "👨🏼\u200d🍼", "👨🏼\u200d🎓", "👨🏼\u200d🎤", "👨🏼\u200d🎨", "👨🏼\u200d🏫", "👨🏼\u200d🏭", "👨🏼\u200d🐰\u200d👨🏻", "👨🏼\u200d🐰\u200d👨🏽", "👨🏼\u200d🐰\u200d👨🏾", "👨🏼\u200d🐰\u200d👨🏿", "👨🏼\u200d💻", "👨🏼\u200d💼", "👨🏼\u200d🔧", "👨🏼\u200d🔬", "👨🏼\u200d🚀", "👨🏼\u200d🚒", "👨🏼\u200d🤝\u200d👨🏻", "👨🏼\u200d🤝\u200d👨🏽", "👨🏼\u200d🤝\u200d👨🏾", "👨🏼\u200d🤝\u200d👨🏿", "👨🏼\u200d🦯", "👨🏼\u200d🦯\u200d➡", "👨🏼\u200d🦰", "👨🏼\u200d🦱", "👨🏼\u200d🦲", "👨🏼\u200d🦳", "👨🏼\u200d🦼", "👨🏼\u200d🦼\u200d➡", "👨🏼\u200d🦽", "👨🏼\u200d🦽\u200d➡", "👨🏼\u200d🫯\u200d👨🏻", "👨🏼\u200d🫯\u200d👨🏽", "👨🏼\u200d🫯\u200d👨🏾", "👨🏼\u200d🫯\u200d👨🏿", "👨🏽\u200d⚕", "👨🏽\u200d⚖", "👨🏽\u200d✈", "👨🏽\u200d❤\u200d👨🏻", "👨🏽\u200d❤\u200d👨🏼", "👨🏽\u200d❤\u200d👨🏽", "👨🏽\u200d❤\u200d👨🏾", "👨🏽\u200d❤\u200d👨🏿", "👨🏽\u200d❤\u200d💋\u200d👨🏻", "👨🏽\u200d❤\u200d💋\u200d👨🏼", "👨🏽\u200d❤\u200d💋\u200d👨🏽", "👨🏽\u200d❤\u200d💋\u200d👨🏾", "👨🏽\u200d❤\u200d💋\u200d👨🏿", "👨🏽\u200d🌾", "👨🏽\u200d🍳", "👨🏽\u200d🍼", "👨🏽\u200d🎓", "👨🏽\u200d🎤", "👨🏽\u200d🎨", "👨🏽\u200d🏫", "👨🏽\u200d🏭", "👨🏽\u200d🐰\u200d👨🏻", "👨🏽\u200d🐰\u200d👨🏼", "👨🏽\u200d🐰\u200d👨🏾", "👨🏽\u200d🐰\u200d👨🏿", "👨🏽\u200d💻", "👨🏽\u200d💼", "👨🏽\u200d🔧", "👨🏽\u200d🔬", "👨🏽\u200d🚀", "👨🏽\u200d🚒", "👨🏽\u200d🤝\u200d👨🏻", "👨🏽\u200d🤝\u200d👨🏼", "👨🏽\u200d🤝\u200d👨🏾", "👨🏽\u200d🤝\u200d👨🏿", "👨🏽\u200d🦯", "👨🏽\u200d🦯\u200d➡", "👨🏽\u200d🦰", "👨🏽\u200d🦱", "👨🏽\u200d🦲", "👨🏽\u200d🦳", "👨🏽\u200d🦼", "👨🏽\u200d🦼\u200d➡", "👨🏽\u200d🦽", "👨🏽\u200d🦽\u200d➡", "👨🏽\u200d🫯\u200d👨🏻", "👨🏽\u200d🫯\u200d👨🏼", "👨🏽\u200d🫯\u200d👨🏾", "👨🏽\u200d🫯\u200d👨🏿", "👨🏾\u200d⚕", "👨🏾\u200d⚖", "👨🏾\u200d✈", "👨🏾\u200d❤\u200d👨🏻", "👨🏾\u200d❤\u200d👨🏼", "👨🏾\u200d❤\u200d👨🏽", "👨🏾\u200d❤\u200d👨🏾", "👨🏾\u200d❤\u200d👨🏿", "👨🏾\u200d❤\u200d💋\u200d👨🏻", "👨🏾\u200d❤\u200d💋\u200d👨🏼", "👨🏾\u200d❤\u200d💋\u200d👨🏽", "👨🏾\u200d❤\u200d💋\u200d👨🏾", "👨🏾\u200d❤\u200d💋\u200d👨🏿", "👨🏾\u200d🌾", "👨🏾\u200d🍳", "👨🏾\u200d🍼", "👨🏾\u200d🎓", "👨🏾\u200d🎤", "👨🏾\u200d🎨", "👨🏾\u200d🏫", "👨🏾\u200d🏭", "👨🏾\u200d🐰\u200d👨🏻", "👨🏾\u200d🐰\u200d👨🏼", "👨🏾\u200d🐰\u200d👨🏽", "👨🏾\u200d🐰\u200d👨🏿", "👨🏾\u200d💻", "👨🏾\u200d💼", "👨🏾\u200d🔧", "👨🏾\u200d🔬", "👨🏾\u200d🚀", "👨🏾\u200d🚒", "👨🏾\u200d🤝\u200d👨🏻", "👨🏾\u200d🤝\u200d👨🏼", "👨🏾\u200d🤝\u200d👨🏽", "👨🏾\u200d🤝\u200d👨🏿", "👨🏾\u200d🦯", "👨🏾\u200d🦯\u200d➡", "👨🏾\u200d🦰", "👨🏾\u200d🦱", "👨🏾\u200d🦲", "👨🏾\u200d🦳", "👨🏾\u200d🦼", "👨🏾\u200d🦼\u200d➡", "👨🏾\u200d🦽", "👨🏾\u200d🦽\u200d➡", "👨🏾\u200d🫯\u200d👨🏻", "👨🏾\u200d🫯\u200d👨🏼", "👨🏾\u200d🫯\u200d👨🏽", "👨🏾\u200d🫯\u200d👨🏿", "👨🏿\u200d⚕", "👨🏿\u200d⚖", "👨🏿\u200d✈", "👨🏿\u200d❤\u200d👨🏻", "👨🏿\u200d❤\u200d👨🏼", "👨🏿\u200d❤\u200d👨🏽", "👨🏿\u200d❤\u200d👨🏾", "👨🏿\u200d❤\u200d👨🏿", "👨🏿\u200d❤\u200d💋\u200d👨🏻", "👨🏿\u200d❤\u200d💋\u200d👨🏼", "👨🏿\u200d❤\u200d💋\u200d👨🏽", "👨🏿\u200d❤\u200d💋\u200d👨🏾", "👨🏿\u200d❤\u200d💋\u200d👨🏿", "👨🏿\u200d🌾", "👨🏿\u200d🍳", "👨🏿\u200d🍼", "👨🏿\u200d🎓", "👨🏿\u200d🎤", "👨🏿\u200d🎨", "👨🏿\u200d🏫", "👨🏿\u200d🏭", "👨🏿\u200d🐰\u200d👨🏻", "👨🏿\u200d🐰\u200d👨🏼", "👨🏿\u200d🐰\u200d👨🏽", "👨🏿\u200d🐰\u200d👨🏾", "👨🏿\u200d💻", "👨🏿\u200d💼", "👨🏿\u200d🔧", "👨🏿\u200d🔬", "👨🏿\u200d🚀", "👨🏿\u200d🚒", "👨🏿\u200d🤝\u200d👨🏻", "👨🏿\u200d🤝\u200d👨🏼", "👨🏿\u200d🤝\u200d👨🏽", "👨🏿\u200d🤝\u200d👨🏾", "👨🏿\u200d🦯", "👨🏿\u200d🦯\u200d➡", "👨🏿\u200d🦰", "👨🏿\u200d🦱", "👨🏿\u200d🦲", "👨🏿\u200d🦳", "👨🏿\u200d🦼", "👨🏿\u200d🦼\u200d➡", "👨🏿\u200d🦽", "👨🏿\u200d🦽\u200d➡", "👨🏿\u200d🫯\u200d👨🏻", "👨🏿\u200d🫯\u200d👨🏼", "👨🏿\u200d🫯\u200d👨🏽", "👨🏿\u200d🫯\u200d👨🏾", "👩\u200d⚕", "👩\u200d⚖", "👩\u200d✈", "👩\u200d❤\u200d👨", "👩\u200d❤\u200d👩", "👩\u200d❤\u200d💋\u200d👨", "👩\u200d❤\u200d💋\u200d👩", "👩\u200d🌾", "👩\u200d🍳", "👩\u200d🍼", "👩\u200d🎓", "👩\u200d🎤", "👩\u200d🎨", "👩\u200d🏫", "👩\u200d🏭", "👩\u200d👦", "👩\u200d👦\u200d👦", "👩\u200d👧", "👩\u200d👧\u200d👦", "👩\u200d👧\u200d👧", "👩\u200d👩\u200d👦", "👩\u200d👩\u200d👦\u200d👦", "👩\u200d👩\u200d👧", "👩\u200d👩\u200d👧\u200d👦", "👩\u200d👩\u200d👧\u200d👧", "👩\u200d💻", "👩\u200d💼", "👩\u200d🔧", "👩\u200d🔬", "👩\u200d🚀", "👩\u200d🚒", "👩\u200d🦯", "👩\u200d🦯\u200d➡", "👩\u200d🦰", "👩\u200d🦱", "👩\u200d🦲", "👩\u200d🦳", "👩\u200d🦼", "👩\u200d🦼\u200d➡", "👩\u200d🦽", "👩\u200d🦽\u200d➡", "👩🏻\u200d⚕", "👩🏻\u200d⚖", "👩🏻\u200d✈", "👩🏻\u200d❤\u200d👨🏻", "👩🏻\u200d❤\u200d👨🏼", "👩🏻\u200d❤\u200d👨🏽", "👩🏻\u200d❤\u200d👨🏾", "👩🏻\u200d❤\u200d👨🏿", "👩🏻\u200d❤\u200d👩🏻", "👩🏻\u200d❤\u200d👩🏼", "👩🏻\u200d❤\u200d👩🏽", "👩🏻\u200d❤\u200d👩🏾", "👩🏻\u200d❤\u200d👩🏿", "👩🏻\u200d❤\u200d💋\u200d👨🏻", "👩🏻\u200d❤\u200d💋\u200d👨🏼", "👩🏻\u200d❤\u200d💋\u200d👨🏽", "👩🏻\u200d❤\u200d💋\u200d👨🏾", "👩🏻\u200d❤\u200d💋\u200d👨🏿", "👩🏻\u200d❤\u200d💋\u200d👩🏻", "👩🏻\u200d❤\u200d💋\u200d👩🏼", "👩🏻\u200d❤\u200d💋\u200d👩🏽", "👩🏻\u200d❤\u200d💋\u200d👩🏾", "👩🏻\u200d❤\u200d💋\u200d👩🏿", "👩🏻\u200d🌾", "👩🏻\u200d🍳", "👩🏻\u200d🍼", "👩🏻\u200d🎓", "👩🏻\u200d🎤", "👩🏻\u200d🎨", "👩🏻\u200d🏫", "👩🏻\u200d🏭", "👩🏻\u200d🐰\u200d👩🏼", "👩🏻\u200d🐰\u200d👩🏽", "👩🏻\u200d🐰\u200d👩🏾", "👩🏻\u200d🐰\u200d👩🏿", "👩🏻\u200d💻", "👩🏻\u200d💼", "👩🏻\u200d🔧", "👩🏻\u200d🔬", "👩🏻\u200d🚀", "👩🏻\u200d🚒", "👩🏻\u200d🤝\u200d👨🏼", "👩🏻\u200d🤝\u200d👨🏽", "👩🏻\u200d🤝\u200d👨🏾", "👩🏻\u200d🤝\u200d👨🏿", "👩🏻\u200d🤝\u200d👩🏼", "👩🏻\u200d🤝\u200d👩🏽", "👩🏻\u200d🤝\u200d👩🏾", "👩🏻\u200d🤝\u200d👩🏿", "👩🏻\u200d🦯", "👩🏻\u200d🦯\u200d➡", "👩🏻\u200d🦰", "👩🏻\u200d🦱", "👩🏻\u200d🦲", "👩🏻\u200d🦳", "👩🏻\u200d🦼", "👩🏻\u200d🦼\u200d➡", "👩🏻\u200d🦽", "👩🏻\u200d🦽\u200d➡", "👩🏻\u200d🫯\u200d👩🏼", "👩🏻\u200d🫯\u200d👩🏽", "👩🏻\u200d🫯\u200d👩🏾", "👩🏻\u200d🫯\u200d👩🏿", "👩🏼\u200d⚕", "👩🏼\u200d⚖", "👩🏼\u200d✈", "👩🏼\u200d❤\u200d👨🏻", "👩🏼\u200d❤\u200d👨🏼", "👩🏼\u200d❤\u200d👨🏽", "👩🏼\u200d❤\u200d👨🏾", "👩🏼\u200d❤\u200d👨🏿", "👩🏼\u200d❤\u200d👩🏻", "👩🏼\u200d❤\u200d👩🏼", "👩🏼\u200d❤\u200d👩🏽", "👩🏼\u200d❤\u200d👩🏾", "👩🏼\u200d❤\u200d👩🏿", "👩🏼\u200d❤\u200d💋\u200d👨🏻", "👩🏼\u200d❤\u200d💋\u200d👨🏼", "👩🏼\u200d❤\u200d💋\u200d👨🏽", "👩🏼\u200d❤\u200d💋\u200d👨🏾", "👩🏼\u200d❤\u200d💋\u200d👨🏿", "👩🏼\u200d❤\u200d💋\u200d👩🏻", "👩🏼\u200d❤\u200d💋\u200d👩🏼", "👩🏼\u200d❤\u200d💋\u200d👩🏽", "👩🏼\u200d❤\u200d💋\u200d👩🏾", "👩🏼\u200d❤\u200d💋\u200d👩🏿", "👩🏼\u200d🌾", "👩🏼\u200d🍳", "👩🏼\u200d🍼", "👩🏼\u200d🎓", "👩🏼\u200d🎤", "👩🏼\u200d🎨", "👩🏼\u200d🏫", "👩🏼\u200d🏭", "👩🏼\u200d🐰\u200d👩🏻", "👩🏼\u200d🐰\u200d👩🏽", "👩🏼\u200d🐰\u200d👩🏾", "👩🏼\u200d🐰\u200d👩🏿", "👩🏼\u200d💻", "👩🏼\u200d💼", "👩🏼\u200d🔧", "👩🏼\u200d🔬", "👩🏼\u200d🚀", "👩🏼\u200d🚒", "👩🏼\u200d🤝\u200d👨🏻", "👩🏼\u200d🤝\u200d👨🏽", "👩🏼\u200d🤝\u200d👨🏾", "👩🏼\u200d🤝\u200d👨🏿", "👩🏼\u200d🤝\u200d👩🏻", "👩🏼\u200d🤝\u200d👩🏽", "👩🏼\u200d🤝\u200d👩🏾", "👩🏼\u200d🤝\u200d👩🏿", "👩🏼\u200d🦯", "👩🏼\u200d🦯\u200d➡", "👩🏼\u200d🦰", "👩🏼\u200d🦱", "👩🏼\u200d🦲", "👩🏼\u200d🦳", "👩🏼\u200d🦼", "👩🏼\u200d🦼\u200d➡", "👩🏼\u200d🦽", "👩🏼\u200d🦽\u200d➡", "👩🏼\u200d🫯\u200d👩🏻", "👩🏼\u200d🫯\u200d👩🏽", "👩🏼\u200d🫯\u200d👩🏾", "👩🏼\u200d🫯\u200d👩🏿", "👩🏽\u200d⚕", "👩🏽\u200d⚖", "👩🏽\u200d✈", "👩🏽\u200d❤\u200d👨🏻", "👩🏽\u200d❤\u200d👨🏼", "👩🏽\u200d❤\u200d👨🏽", "👩🏽\u200d❤\u200d👨🏾", "👩🏽\u200d❤\u200d👨🏿", "👩🏽\u200d❤\u200d👩🏻", "👩🏽\u200d❤\u200d👩🏼", "👩🏽\u200d❤\u200d👩🏽", "👩🏽\u200d❤\u200d👩🏾", "👩🏽\u200d❤\u200d👩🏿", "👩🏽\u200d❤\u200d💋\u200d👨🏻", "👩🏽\u200d❤\u200d💋\u200d👨🏼", "👩🏽\u200d❤\u200d💋\u200d👨🏽", "👩🏽\u200d❤\u200d💋\u200d👨🏾", "👩🏽\u200d❤\u200d💋\u200d👨🏿", "👩🏽\u200d❤\u200d💋\u200d👩🏻", "👩🏽\u200d❤\u200d💋\u200d👩🏼", "👩🏽\u200d❤\u200d💋\u200d👩🏽", "👩🏽\u200d❤\u200d💋\u200d👩🏾", "👩🏽\u200d❤\u200d💋\u200d👩🏿", "👩🏽\u200d🌾", "👩🏽\u200d🍳", "👩🏽\u200d🍼", "👩🏽\u200d🎓", "👩🏽\u200d🎤", "👩🏽\u200d🎨", "👩🏽\u200d🏫", "👩🏽\u200d🏭", "👩🏽\u200d🐰\u200d👩🏻", "👩🏽\u200d🐰\u200d👩🏼", "👩🏽\u200d🐰\u200d👩🏾", "👩🏽\u200d🐰\u200d👩🏿", "👩🏽\u200d💻", "👩🏽\u200d💼", "👩🏽\u200d🔧", "👩🏽\u200d🔬", "👩🏽\u200d🚀", "👩🏽\u200d🚒", "👩🏽\u200d🤝\u200d👨🏻", "👩🏽\u200d🤝\u200d👨🏼", "👩🏽\u200d🤝\u200d👨🏾", "👩🏽\u200d🤝\u200d👨🏿", "👩🏽\u200d🤝\u200d👩🏻", "👩🏽\u200d🤝\u200d👩🏼", "👩🏽\u200d🤝\u200d👩🏾", "👩🏽\u200d🤝\u200d👩🏿", "👩🏽\u200d🦯", "👩🏽\u200d🦯\u200d➡", "👩🏽\u200d🦰", "👩🏽\u200d🦱", "👩🏽\u200d🦲", "👩🏽\u200d🦳", "👩🏽\u200d🦼", "👩🏽\u200d🦼\u200d➡", "👩🏽\u200d🦽", "👩🏽\u200d🦽\u200d➡", "👩🏽\u200d🫯\u200d👩🏻", "👩🏽\u200d🫯\u200d👩🏼", "👩🏽\u200d🫯\u200d👩🏾", "👩🏽\u200d🫯\u200d👩🏿", "👩🏾\u200d⚕", "👩🏾\u200d⚖", "👩🏾\u200d✈", "👩🏾\u200d❤\u200d👨🏻", "👩🏾\u200d❤\u200d👨🏼", "👩🏾\u200d❤\u200d👨🏽", "👩🏾\u200d❤\u200d👨🏾", "👩🏾\u200d❤\u200d👨🏿", "👩🏾\u200d❤\u200d👩🏻", "👩🏾\u200d❤\u200d👩🏼", "👩🏾\u200d❤\u200d👩🏽", "👩🏾\u200d❤\u200d👩🏾", "👩🏾\u200d❤\u200d👩🏿", "👩🏾\u200d❤\u200d💋\u200d👨🏻", "👩🏾\u200d❤\u200d💋\u200d👨🏼", "👩🏾\u200d❤\u200d💋\u200d👨🏽", "👩🏾\u200d❤\u200d💋\u200d👨🏾", "👩🏾\u200d❤\u200d💋\u200d👨🏿", "👩🏾\u200d❤\u200d💋\u200d👩🏻", "👩🏾\u200d❤\u200d💋\u200d👩🏼", "👩🏾\u200d❤\u200d💋\u200d👩🏽", "👩🏾\u200d❤\u200d💋\u200d👩🏾", "👩🏾\u200d❤\u200d💋\u200d👩🏿", "👩🏾\u200d🌾", "👩🏾\u200d🍳", "👩🏾\u200d🍼", "👩🏾\u200d🎓", "👩🏾\u200d🎤", "👩🏾\u200d🎨", "👩🏾\u200d🏫", "👩🏾\u200d🏭", "👩🏾\u200d🐰\u200d👩🏻", "👩🏾\u200d🐰\u200d👩🏼", "👩🏾\u200d🐰\u200d👩🏽", "👩🏾\u200d🐰\u200d👩🏿", "👩🏾\u200d💻", "👩🏾\u200d💼", "👩🏾\u200d🔧", "👩🏾\u200d🔬", "👩🏾\u200d🚀", "👩🏾\u200d🚒", "👩🏾\u200d🤝\u200d👨🏻", "👩🏾\u200d🤝\u200d👨🏼", "👩🏾\u200d🤝\u200d👨🏽", "👩🏾\u200d🤝\u200d👨🏿", "👩🏾\u200d🤝\u200d👩🏻", "👩🏾\u200d🤝\u200d👩🏼", "👩🏾\u200d🤝\u200d👩🏽", "👩🏾\u200d🤝\u200d👩🏿", "👩🏾\u200d🦯", "👩🏾\u200d🦯\u200d➡", "👩🏾\u200d🦰", "👩🏾\u200d🦱", "👩🏾\u200d🦲", "👩🏾\u200d🦳", "👩🏾\u200d🦼", "👩🏾\u200d🦼\u200d➡", "👩🏾\u200d🦽", "👩🏾\u200d🦽\u200d➡", "👩🏾\u200d🫯\u200d👩🏻", "👩🏾\u200d🫯\u200d👩🏼", "👩🏾\u200d🫯\u200d👩🏽", "👩🏾\u200d🫯\u200d👩🏿", "👩🏿\u200d⚕", "👩🏿\u200d⚖", "👩🏿\u200d✈", "👩🏿\u200d❤\u200d👨🏻", "👩🏿\u200d❤\u200d👨🏼", "👩🏿\u200d❤\u200d👨🏽", "👩🏿\u200d❤\u200d👨🏾", "👩🏿\u200d❤\u200d👨🏿", "👩🏿\u200d❤\u200d👩🏻", "👩🏿\u200d❤\u200d👩🏼", "👩🏿\u200d❤\u200d👩🏽", "👩🏿\u200d❤\u200d👩🏾", "👩🏿\u200d❤\u200d👩🏿", "👩🏿\u200d❤\u200d💋\u200d👨🏻", "👩🏿\u200d❤\u200d💋\u200d👨🏼", "👩🏿\u200d❤\u200d💋\u200d👨🏽", "👩🏿\u200d❤\u200d💋\u200d👨🏾", "👩🏿\u200d❤\u200d💋\u200d👨🏿", "👩🏿\u200d❤\u200d💋\u200d👩🏻", "👩🏿\u200d❤\u200d💋\u200d👩🏼", "👩🏿\u200d❤\u200d💋\u200d👩🏽", "👩🏿\u200d❤\u200d💋\u200d👩🏾", "👩🏿\u200d❤\u200d💋\u200d👩🏿", "👩🏿\u200d🌾", "👩🏿\u200d🍳", "👩🏿\u200d🍼", "👩🏿\u200d🎓", "👩🏿\u200d🎤", "👩🏿\u200d🎨", "👩🏿\u200d🏫", "👩🏿\u200d🏭", "👩🏿\u200d🐰\u200d👩🏻", "👩🏿\u200d🐰\u200d👩🏼", "👩🏿\u200d🐰\u200d👩🏽", "👩🏿\u200d🐰\u200d👩🏾", "👩🏿\u200d💻", "👩🏿\u200d💼", "👩🏿\u200d🔧", "👩🏿\u200d🔬", "👩🏿\u200d🚀", "👩🏿\u200d🚒", "👩🏿\u200d🤝\u200d👨🏻", "👩🏿\u200d🤝\u200d👨🏼", "👩🏿\u200d🤝\u200d👨🏽", "👩🏿\u200d🤝\u200d👨🏾", "👩🏿\u200d🤝\u200d👩🏻", "👩🏿\u200d🤝\u200d👩🏼", "👩🏿\u200d🤝\u200d👩🏽", "👩🏿\u200d🤝\u200d👩🏾", "👩🏿\u200d🦯", "👩🏿\u200d🦯\u200d➡", "👩🏿\u200d🦰", "👩🏿\u200d🦱", "👩🏿\u200d🦲", "👩🏿\u200d🦳", "👩🏿\u200d🦼", "👩🏿\u200d🦼\u200d➡", "👩🏿\u200d🦽", "👩🏿\u200d🦽\u200d➡", "👩🏿\u200d🫯\u200d👩🏻", "👩🏿\u200d🫯\u200d👩🏼", "👩🏿\u200d🫯\u200d👩🏽", "👩🏿\u200d🫯\u200d👩🏾", "👮\u200d♀", "👮\u200d♂", "👮🏻\u200d♀", "👮🏻\u200d♂", "👮🏼\u200d♀", "👮🏼\u200d♂", "👮🏽\u200d♀", "👮🏽\u200d♂", "👮🏾\u200d♀", "👮🏾\u200d♂", "👮🏿\u200d♀", "👮🏿\u200d♂", "👯\u200d♀", "👯\u200d♂", "👯🏻\u200d♀", "👯🏻\u200d♂", "👯🏼\u200d♀", "👯🏼\u200d♂", "👯🏽\u200d♀", "👯🏽\u200d♂", "👯🏾\u200d♀", "👯🏾\u200d♂", "👯🏿\u200d♀", "👯🏿\u200d♂", "👰\u200d♀", "👰\u200d♂", "👰🏻\u200d♀", "👰🏻\u200d♂", "👰🏼\u200d♀", "👰🏼\u200d♂", "👰🏽\u200d♀", "👰🏽\u200d♂", "👰🏾\u200d♀", "👰🏾\u200d♂", "👰🏿\u200d♀", "👰🏿\u200d♂", "👱\u200d♀", "👱\u200d♂", "👱🏻\u200d♀", "👱🏻\u200d♂", "👱🏼\u200d♀", "👱🏼\u200d♂", "👱🏽\u200d♀", "👱🏽\u200d♂", "👱🏾\u200d♀", "👱🏾\u200d♂", "👱🏿\u200d♀", "👱🏿\u200d♂", "👳\u200d♀", "👳\u200d♂", "👳🏻\u200d♀", "👳🏻\u200d♂", "👳🏼\u200d♀", "👳🏼\u200d♂", "👳🏽\u200d♀", "👳🏽\u200d♂", "👳🏾\u200d♀", "👳🏾\u200d♂", "👳🏿\u200d♀", "👳🏿\u200d♂", "👷\u200d♀", "👷\u200d♂", "👷🏻\u200d♀", "👷🏻\u200d♂", "👷🏼\u200d♀", "👷🏼\u200d♂", "👷🏽\u200d♀", "👷🏽\u200d♂", "👷🏾\u200d♀", "👷🏾\u200d♂", "👷🏿\u200d♀", "👷🏿\u200d♂", "💁\u200d♀", "💁\u200d♂", "💁🏻\u200d♀", "💁🏻\u200d♂", "💁🏼\u200d♀", "💁🏼\u200d♂", "💁🏽\u200d♀", "💁🏽\u200d♂", "💁🏾\u200d♀", "💁🏾\u200d♂", "💁🏿\u200d♀", "💁🏿\u200d♂", "💂\u200d♀", "💂\u200d♂", "💂🏻\u200d♀", "💂🏻\u200d♂", "💂🏼\u200d♀", "💂🏼\u200d♂", "💂🏽\u200d♀", "💂🏽\u200d♂", "💂🏾\u200d♀", "💂🏾\u200d♂", "💂🏿\u200d♀", "💂🏿\u200d♂", "💆\u200d♀", "💆\u200d♂", "💆🏻\u200d♀", "💆🏻\u200d♂", "💆🏼\u200d♀", "💆🏼\u200d♂", "💆🏽\u200d♀", "💆🏽\u200d♂", "💆🏾\u200d♀", "💆🏾\u200d♂", "💆🏿\u200d♀", "💆🏿\u200d♂", "💇\u200d♀", "💇\u200d♂", "💇🏻\u200d♀", "💇🏻\u200d♂", "💇🏼\u200d♀", "💇🏼\u200d♂", "💇🏽\u200d♀", "💇🏽\u200d♂", "💇🏾\u200d♀", "💇🏾\u200d♂", "💇🏿\u200d♀", "💇🏿\u200d♂", "🕵\u200d♀", "🕵\u200d♂", "🕵🏻\u200d♀", "🕵🏻\u200d♂", "🕵🏼\u200d♀", "🕵🏼\u200d♂", "🕵🏽\u200d♀", "🕵🏽\u200d♂", "🕵🏾\u200d♀", "🕵🏾\u200d♂", "🕵🏿\u200d♀", "🕵🏿\u200d♂", "😮\u200d💨", "😵\u200d💫", "😶\u200d🌫", "🙂\u200d↔", "🙂\u200d↕", "🙅\u200d♀", "🙅\u200d♂", "🙅🏻\u200d♀", "🙅🏻\u200d♂", "🙅🏼\u200d♀", "🙅🏼\u200d♂", "🙅🏽\u200d♀", "🙅🏽\u200d♂", "🙅🏾\u200d♀", "🙅🏾\u200d♂", "🙅🏿\u200d♀", "🙅🏿\u200d♂", "🙆\u200d♀", "🙆\u200d♂", "🙆🏻\u200d♀", "🙆🏻\u200d♂", "🙆🏼\u200d♀", "🙆🏼\u200d♂", "🙆🏽\u200d♀", "🙆🏽\u200d♂", "🙆🏾\u200d♀", "🙆🏾\u200d♂", "🙆🏿\u200d♀", "🙆🏿\u200d♂", "🙇\u200d♀", "🙇\u200d♂", "🙇🏻\u200d♀", "🙇🏻\u200d♂", "🙇🏼\u200d♀", "🙇🏼\u200d♂", "🙇🏽\u200d♀", "🙇🏽\u200d♂", "🙇🏾\u200d♀", "🙇🏾\u200d♂", "🙇🏿\u200d♀", "🙇🏿\u200d♂", "🙋\u200d♀", "🙋\u200d♂", "🙋🏻\u200d♀", "🙋🏻\u200d♂", "🙋🏼\u200d♀", "🙋🏼\u200d♂", "🙋🏽\u200d♀", "🙋🏽\u200d♂", "🙋🏾\u200d♀", "🙋🏾\u200d♂", "🙋🏿\u200d♀", "🙋🏿\u200d♂", "🙍\u200d♀", "🙍\u200d♂", "🙍🏻\u200d♀", "🙍🏻\u200d♂", "🙍🏼\u200d♀", "🙍🏼\u200d♂", "🙍🏽\u200d♀", "🙍🏽\u200d♂", "🙍🏾\u200d♀", "🙍🏾\u200d♂", "🙍🏿\u200d♀", "🙍🏿\u200d♂", "🙎\u200d♀", "🙎\u200d♂", "🙎🏻\u200d♀", "🙎🏻\u200d♂", "🙎🏼\u200d♀", "🙎🏼\u200d♂", "🙎🏽\u200d♀", "🙎🏽\u200d♂", "🙎🏾\u200d♀", "🙎🏾\u200d♂", "🙎🏿\u200d♀", "🙎🏿\u200d♂", "🚣\u200d♀", "🚣\u200d♂", "🚣🏻\u200d♀", "🚣🏻\u200d♂", "🚣🏼\u200d♀", "🚣🏼\u200d♂", "🚣🏽\u200d♀", "🚣🏽\u200d♂", "🚣🏾\u200d♀", "🚣🏾\u200d♂", "🚣🏿\u200d♀", "🚣🏿\u200d♂", "🚴\u200d♀", "🚴\u200d♂", "🚴🏻\u200d♀", "🚴🏻\u200d♂", "🚴🏼\u200d♀", "🚴🏼\u200d♂", "🚴🏽\u200d♀", "🚴🏽\u200d♂", "🚴🏾\u200d♀", "🚴🏾\u200d♂", "🚴🏿\u200d♀", "🚴🏿\u200d♂", "🚵\u200d♀", "🚵\u200d♂", "🚵🏻\u200d♀", "🚵🏻\u200d♂", "🚵🏼\u200d♀", "🚵🏼\u200d♂", "🚵🏽\u200d♀", "🚵🏽\u200d♂", "🚵🏾\u200d♀", "🚵🏾\u200d♂", "🚵🏿\u200d♀", "🚵🏿\u200d♂", "🚶\u200d♀", "🚶\u200d♀\u200d➡", "🚶\u200d♂", "🚶\u200d♂\u200d➡", "🚶\u200d➡", "🚶🏻\u200d♀", "🚶🏻\u200d♀\u200d➡", "🚶🏻\u200d♂", "🚶🏻\u200d♂\u200d➡", "🚶🏻\u200d➡", "🚶🏼\u200d♀", "🚶🏼\u200d♀\u200d➡", "🚶🏼\u200d♂", "🚶🏼\u200d♂\u200d➡", "🚶🏼\u200d➡", "🚶🏽\u200d♀", "🚶🏽\u200d♀\u200d➡", "🚶🏽\u200d♂", "🚶🏽\u200d♂\u200d➡", "🚶🏽\u200d➡", "🚶🏾\u200d♀", "🚶🏾\u200d♀\u200d➡", "🚶🏾\u200d♂", "🚶🏾\u200d♂\u200d➡", "🚶🏾\u200d➡", "🚶🏿\u200d♀", "🚶🏿\u200d♀\u200d➡", "🚶🏿\u200d♂", "🚶🏿\u200d♂\u200d➡", "🚶🏿\u200d➡", "🤦\u200d♀", "🤦\u200d♂", "🤦🏻\u200d♀", "🤦🏻\u200d♂", "🤦🏼\u200d♀", "🤦🏼\u200d♂", "🤦🏽\u200d♀", "🤦🏽\u200d♂", "🤦🏾\u200d♀", "🤦🏾\u200d♂", "🤦🏿\u200d♀", "🤦🏿\u200d♂", "🤵\u200d♀", "🤵\u200d♂", "🤵🏻\u200d♀", "🤵🏻\u200d♂", "🤵🏼\u200d♀", "🤵🏼\u200d♂", "🤵🏽\u200d♀", "🤵🏽\u200d♂", "🤵🏾\u200d♀", "🤵🏾\u200d♂", "🤵🏿\u200d♀", "🤵🏿\u200d♂", "🤷\u200d♀", "🤷\u200d♂", "🤷🏻\u200d♀", "🤷🏻\u200d♂", "🤷🏼\u200d♀", "🤷🏼\u200d♂", "🤷🏽\u200d♀", "🤷🏽\u200d♂", "🤷🏾\u200d♀", "🤷🏾\u200d♂", "🤷🏿\u200d♀", "🤷🏿\u200d♂", "🤸\u200d♀", "🤸\u200d♂", "🤸🏻\u200d♀", "🤸🏻\u200d♂", "🤸🏼\u200d♀", "🤸🏼\u200d♂", "🤸🏽\u200d♀", "🤸🏽\u200d♂", "🤸🏾\u200d♀", "🤸🏾\u200d♂", "🤸🏿\u200d♀", "🤸🏿\u200d♂", "🤹\u200d♀", "🤹\u200d♂", "🤹🏻\u200d♀", "🤹🏻\u200d♂", "🤹🏼\u200d♀", "🤹🏼\u200d♂", "🤹🏽\u200d♀", "🤹🏽\u200d♂", "🤹🏾\u200d♀", "🤹🏾\u200d♂", "🤹🏿\u200d♀", "🤹🏿\u200d♂", "🤼\u200d♀", "🤼\u200d♂", "🤼🏻\u200d♀", "🤼🏻\u200d♂", "🤼🏼\u200d♀", "🤼🏼\u200d♂", "🤼🏽\u200d♀", "🤼🏽\u200d♂", "🤼🏾\u200d♀", "🤼🏾\u200d♂", "🤼🏿\u200d♀", "🤼🏿\u200d♂", "🤽\u200d♀", "🤽\u200d♂", "🤽🏻\u200d♀", "🤽🏻\u200d♂", "🤽🏼\u200d♀", "🤽🏼\u200d♂", "🤽🏽\u200d♀", "🤽🏽\u200d♂", "🤽🏾\u200d♀", "🤽🏾\u200d♂", "🤽🏿\u200d♀", "🤽🏿\u200d♂", "🤾\u200d♀", "🤾\u200d♂", "🤾🏻\u200d♀", "🤾🏻\u200d♂", "🤾🏼\u200d♀", "🤾🏼\u200d♂", "🤾🏽\u200d♀", "🤾🏽\u200d♂", "🤾🏾\u200d♀", "🤾🏾\u200d♂", "🤾🏿\u200d♀", "🤾🏿\u200d♂", "🦸\u200d♀", "🦸\u200d♂", "🦸🏻\u200d♀", "🦸🏻\u200d♂", "🦸🏼\u200d♀", "🦸🏼\u200d♂", "🦸🏽\u200d♀", "🦸🏽\u200d♂", "🦸🏾\u200d♀", "🦸🏾\u200d♂", "🦸🏿\u200d♀", "🦸🏿\u200d♂", "🦹\u200d♀", "🦹\u200d♂", "🦹🏻\u200d♀", "🦹🏻\u200d♂", "🦹🏼\u200d♀", "🦹🏼\u200d♂", "🦹🏽\u200d♀", "🦹🏽\u200d♂", "🦹🏾\u200d♀", "🦹🏾\u200d♂", "🦹🏿\u200d♀", "🦹🏿\u200d♂", "🧍\u200d♀", "🧍\u200d♂", "🧍🏻\u200d♀", "🧍🏻\u200d♂", "🧍🏼\u200d♀", "🧍🏼\u200d♂", "🧍🏽\u200d♀", "🧍🏽\u200d♂", "🧍🏾\u200d♀", "🧍🏾\u200d♂", "🧍🏿\u200d♀", "🧍🏿\u200d♂", "🧎\u200d♀", "🧎\u200d♀\u200d➡", "🧎\u200d♂", "🧎\u200d♂\u200d➡", "🧎\u200d➡", "🧎🏻\u200d♀", "🧎🏻\u200d♀\u200d➡", "🧎🏻\u200d♂", "🧎🏻\u200d♂\u200d➡", "🧎🏻\u200d➡", "🧎🏼\u200d♀", "🧎🏼\u200d♀\u200d➡", "🧎🏼\u200d♂", "🧎🏼\u200d♂\u200d➡", "🧎🏼\u200d➡", "🧎🏽\u200d♀", "🧎🏽\u200d♀\u200d➡", "🧎🏽\u200d♂", "🧎🏽\u200d♂\u200d➡", "🧎🏽\u200d➡", "🧎🏾\u200d♀", "🧎🏾\u200d♀\u200d➡", "🧎🏾\u200d♂", "🧎🏾\u200d♂\u200d➡", "🧎🏾\u200d➡", "🧎🏿\u200d♀", "🧎🏿\u200d♀\u200d➡", "🧎🏿\u200d♂", "🧎🏿\u200d♂\u200d➡", "🧎🏿\u200d➡", "🧏\u200d♀", "🧏\u200d♂", "🧏🏻\u200d♀", "🧏🏻\u200d♂", "🧏🏼\u200d♀", "🧏🏼\u200d♂", "🧏🏽\u200d♀", "🧏🏽\u200d♂", "🧏🏾\u200d♀", "🧏🏾\u200d♂", "🧏🏿\u200d♀", "🧏🏿\u200d♂", "🧑\u200d⚕", "🧑\u200d⚖", "🧑\u200d✈", "🧑\u200d🌾", "🧑\u200d🍳", "🧑\u200d🍼", "🧑\u200d🎄", "🧑\u200d🎓", "🧑\u200d🎤", "🧑\u200d🎨", "🧑\u200d🏫", "🧑\u200d🏭", "🧑\u200d💻", "🧑\u200d💼", "🧑\u200d🔧", "🧑\u200d🔬", "🧑\u200d🚀", "🧑\u200d🚒", "🧑\u200d🤝\u200d🧑", "🧑\u200d🦯", "🧑\u200d🦯\u200d➡", "🧑\u200d🦰", "🧑\u200d🦱", "🧑\u200d🦲", "🧑\u200d🦳", "🧑\u200d🦼", "🧑\u200d🦼\u200d➡", "🧑\u200d🦽", "🧑\u200d🦽\u200d➡", "🧑\u200d🧑\u200d🧒", "🧑\u200d🧑\u200d🧒\u200d🧒", "🧑\u200d🧒", "🧑\u200d🧒\u200d🧒", "🧑\u200d🩰", "🧑🏻\u200d⚕", "🧑🏻\u200d⚖", "🧑🏻\u200d✈", "🧑🏻\u200d❤\u200d💋\u200d🧑🏼", "🧑🏻\u200d❤\u200d💋\u200d🧑🏽", "🧑🏻\u200d❤\u200d💋\u200d🧑🏾", "🧑🏻\u200d❤\u200d💋\u200d🧑🏿", "🧑🏻\u200d❤\u200d🧑🏼", "🧑🏻\u200d❤\u200d🧑🏽", "🧑🏻\u200d❤\u200d🧑🏾", "🧑🏻\u200d❤\u200d🧑🏿", "🧑🏻\u200d🌾", "🧑🏻\u200d🍳", "🧑🏻\u200d🍼", "🧑🏻\u200d🎄", "🧑🏻\u200d🎓", "🧑🏻\u200d🎤", "🧑🏻\u200d🎨", "🧑🏻\u200d🏫", "🧑🏻\u200d🏭", "🧑🏻\u200d🐰\u200d🧑🏼", "🧑🏻\u200d🐰\u200d🧑🏽", "🧑🏻\u200d🐰\u200d🧑🏾", "🧑🏻\u200d🐰\u200d🧑🏿", "🧑🏻\u200d💻", "🧑🏻\u200d💼", "🧑🏻\u200d🔧", "🧑🏻\u200d🔬", "🧑🏻\u200d🚀", "🧑🏻\u200d🚒", "🧑🏻\u200d🤝\u200d🧑🏻", "🧑🏻\u200d🤝\u200d🧑🏼", "🧑🏻\u200d🤝\u200d🧑🏽", "🧑🏻\u200d🤝\u200d🧑🏾", "🧑🏻\u200d🤝\u200d🧑🏿", "🧑🏻\u200d🦯", "🧑🏻\u200d🦯\u200d➡", "🧑🏻\u200d🦰", "🧑🏻\u200d🦱", "🧑🏻\u200d🦲", "🧑🏻\u200d🦳", "🧑🏻\u200d🦼", "🧑🏻\u200d🦼\u200d➡", "🧑🏻\u200d🦽", "🧑🏻\u200d🦽\u200d➡", "🧑🏻\u200d🩰", "🧑🏻\u200d🫯\u200d🧑🏼", "🧑🏻\u200d🫯\u200d🧑🏽", "🧑🏻\u200d🫯\u200d🧑🏾", "🧑🏻\u200d🫯\u200d🧑🏿", "🧑🏼\u200d⚕", "🧑🏼\u200d⚖", "🧑🏼\u200d✈", "🧑🏼\u200d❤\u200d💋\u200d🧑🏻", "🧑🏼\u200d❤\u200d💋\u200d🧑🏽", "🧑🏼\u200d❤\u200d💋\u200d🧑🏾", "🧑🏼\u200d❤\u200d💋\u200d🧑🏿", "🧑🏼\u200d❤\u200d🧑🏻", "🧑🏼\u200d❤\u200d🧑🏽", "🧑🏼\u200d❤\u200d🧑🏾", "🧑🏼\u200d❤\u200d🧑🏿", "🧑🏼\u200d🌾", "🧑🏼\u200d🍳", "🧑🏼\u200d🍼", "🧑🏼\u200d🎄", "🧑🏼\u200d🎓", "🧑🏼\u200d🎤", "🧑🏼\u200d🎨", "🧑🏼\u200d🏫", "🧑🏼\u200d🏭", "🧑🏼\u200d🐰\u200d🧑🏻", "🧑🏼\u200d🐰\u200d🧑🏽", "🧑🏼\u200d🐰\u200d🧑🏾", "🧑🏼\u200d🐰\u200d🧑🏿", "🧑🏼\u200d💻", "🧑🏼\u200d💼", "🧑🏼\u200d🔧", "🧑🏼\u200d🔬", "🧑🏼\u200d🚀", "🧑🏼\u200d🚒", "🧑🏼\u200d🤝\u200d🧑🏻", "🧑🏼\u200d🤝\u200d🧑🏼", "🧑🏼\u200d🤝\u200d🧑🏽", "🧑🏼\u200d🤝\u200d🧑🏾", "🧑🏼\u200d🤝\u200d🧑🏿", "🧑🏼\u200d🦯", "🧑🏼\u200d🦯\u200d➡", "🧑🏼\u200d🦰", "🧑🏼\u200d🦱", "🧑🏼\u200d🦲", "🧑🏼\u200d🦳", "🧑🏼\u200d🦼", "🧑🏼\u200d🦼\u200d➡", "🧑🏼\u200d🦽", "🧑🏼\u200d🦽\u200d➡", "🧑🏼\u200d🩰", "🧑🏼\u200d🫯\u200d🧑🏻", "🧑🏼\u200d🫯\u200d🧑🏽", "🧑🏼\u200d🫯\u200d🧑🏾", "🧑🏼\u200d🫯\u200d🧑🏿", "🧑🏽\u200d⚕", "🧑🏽\u200d⚖", "🧑🏽\u200d✈", "🧑🏽\u200d❤\u200d💋\u200d🧑🏻", "🧑🏽\u200d❤\u200d💋\u200d🧑🏼", "🧑🏽\u200d❤\u200d💋\u200d🧑🏾", "🧑🏽\u200d❤\u200d💋\u200d🧑🏿", "🧑🏽\u200d❤\u200d🧑🏻", "🧑🏽\u200d❤\u200d🧑🏼", "🧑🏽\u200d❤\u200d🧑🏾", "🧑🏽\u200d❤\u200d🧑🏿", "🧑🏽\u200d🌾", "🧑🏽\u200d🍳", "🧑🏽\u200d🍼", "🧑🏽\u200d🎄", "🧑🏽\u200d🎓", "🧑🏽\u200d🎤", "🧑🏽\u200d🎨", "🧑🏽\u200d🏫", "🧑🏽\u200d🏭", "🧑🏽\u200d🐰\u200d🧑🏻", "🧑🏽\u200d🐰\u200d🧑🏼", "🧑🏽\u200d🐰\u200d🧑🏾", "🧑🏽\u200d🐰\u200d🧑🏿", "🧑🏽\u200d💻", "🧑🏽\u200d💼", "🧑🏽\u200d🔧", "🧑🏽\u200d🔬", "🧑🏽\u200d🚀", "🧑🏽\u200d🚒", "🧑🏽\u200d🤝\u200d🧑🏻", "🧑🏽\u200d🤝\u200d🧑🏼", "🧑🏽\u200d🤝\u200d🧑🏽", "🧑🏽\u200d🤝\u200d🧑🏾", "🧑🏽\u200d🤝\u200d🧑🏿", "🧑🏽\u200d🦯", "🧑🏽\u200d🦯\u200d➡", "🧑🏽\u200d🦰", "🧑🏽\u200d🦱", "🧑🏽\u200d🦲", "🧑🏽\u200d🦳", "🧑🏽\u200d🦼", "🧑🏽\u200d🦼\u200d➡", "🧑🏽\u200d🦽", "🧑🏽\u200d🦽\u200d➡", "🧑🏽\u200d🩰", "🧑🏽\u200d🫯\u200d🧑🏻", "🧑🏽\u200d🫯\u200d🧑🏼", "🧑🏽\u200d🫯\u200d🧑🏾", "🧑🏽\u200d🫯\u200d🧑🏿", "🧑🏾\u200d⚕", "🧑🏾\u200d⚖", "🧑🏾\u200d✈", "🧑🏾\u200d❤\u200d💋\u200d🧑🏻", "🧑🏾\u200d❤\u200d💋\u200d🧑🏼", "🧑🏾\u200d❤\u200d💋\u200d🧑🏽", "🧑🏾\u200d❤\u200d💋\u200d🧑🏿", "🧑🏾\u200d❤\u200d🧑🏻", "🧑🏾\u200d❤\u200d🧑🏼", "🧑🏾\u200d❤\u200d🧑🏽", "🧑🏾\u200d❤\u200d🧑🏿", "🧑🏾\u200d🌾", "🧑🏾\u200d🍳", "🧑🏾\u200d🍼", "🧑🏾\u200d🎄", "🧑🏾\u200d🎓", "🧑🏾\u200d🎤", "🧑🏾\u200d🎨", "🧑🏾\u200d🏫", "🧑🏾\u200d🏭", "🧑🏾\u200d🐰\u200d🧑🏻", "🧑🏾\u200d🐰\u200d🧑🏼", "🧑🏾\u200d🐰\u200d🧑🏽", "🧑🏾\u200d🐰\u200d🧑🏿", "🧑🏾\u200d💻", "🧑🏾\u200d💼", "🧑🏾\u200d🔧", "🧑🏾\u200d🔬", "🧑🏾\u200d🚀", "🧑🏾\u200d🚒", "🧑🏾\u200d🤝\u200d🧑🏻", "🧑🏾\u200d🤝\u200d🧑🏼", "🧑🏾\u200d🤝\u200d🧑🏽", "🧑🏾\u200d🤝\u200d🧑🏾", "🧑🏾\u200d🤝\u200d🧑🏿", "🧑🏾\u200d🦯", "🧑🏾\u200d🦯\u200d➡", "🧑🏾\u200d🦰", "🧑🏾\u200d🦱", "🧑🏾\u200d🦲", "🧑🏾\u200d🦳", "🧑🏾\u200d🦼", "🧑🏾\u200d🦼\u200d➡", "🧑🏾\u200d🦽", "🧑🏾\u200d🦽\u200d➡", "🧑🏾\u200d🩰", "🧑🏾\u200d🫯\u200d🧑🏻", "🧑🏾\u200d🫯\u200d🧑🏼", "🧑🏾\u200d🫯\u200d🧑🏽", "🧑🏾\u200d🫯\u200d🧑🏿", "🧑🏿\u200d⚕", "🧑🏿\u200d⚖", "🧑🏿\u200d✈", "🧑🏿\u200d❤\u200d💋\u200d🧑🏻", "🧑🏿\u200d❤\u200d💋\u200d🧑🏼", "🧑🏿\u200d❤\u200d💋\u200d🧑🏽", "🧑🏿\u200d❤\u200d💋\u200d🧑🏾", "🧑🏿\u200d❤\u200d🧑🏻", "🧑🏿\u200d❤\u200d🧑🏼", "🧑🏿\u200d❤\u200d🧑🏽", "🧑🏿\u200d❤\u200d🧑🏾", "🧑🏿\u200d🌾", "🧑🏿\u200d🍳", "🧑🏿\u200d🍼", "🧑🏿\u200d🎄", "🧑🏿\u200d🎓", "🧑🏿\u200d🎤", "🧑🏿\u200d🎨", "🧑🏿\u200d🏫", "🧑🏿\u200d🏭", "🧑🏿\u200d🐰\u200d🧑🏻", "🧑🏿\u200d🐰\u200d🧑🏼", "🧑🏿\u200d🐰\u200d🧑🏽", "🧑🏿\u200d🐰\u200d🧑🏾", "🧑🏿\u200d💻", "🧑🏿\u200d💼", "🧑🏿\u200d🔧", "🧑🏿\u200d🔬", "🧑🏿\u200d🚀", "🧑🏿\u200d🚒", "🧑🏿\u200d🤝\u200d🧑🏻", "🧑🏿\u200d🤝\u200d🧑🏼", "🧑🏿\u200d🤝\u200d🧑🏽", "🧑🏿\u200d🤝\u200d🧑🏾", "🧑🏿\u200d🤝\u200d🧑🏿", "🧑🏿\u200d🦯", "🧑🏿\u200d🦯\u200d➡", "🧑🏿\u200d🦰", "🧑🏿\u200d🦱", "🧑🏿\u200d🦲", "🧑🏿\u200d🦳", "🧑🏿\u200d🦼", "🧑🏿\u200d🦼\u200d➡", "🧑🏿\u200d🦽", "🧑🏿\u200d🦽\u200d➡", "🧑🏿\u200d🩰", "🧑🏿\u200d🫯\u200d🧑🏻", "🧑🏿\u200d🫯\u200d🧑🏼", "🧑🏿\u200d🫯\u200d🧑🏽", "🧑🏿\u200d🫯\u200d🧑🏾", "🧔\u200d♀", "🧔\u200d♂", "🧔🏻\u200d♀", "🧔🏻\u200d♂", "🧔🏼\u200d♀", "🧔🏼\u200d♂", "🧔🏽\u200d♀", "🧔🏽\u200d♂", "🧔🏾\u200d♀", "🧔🏾\u200d♂", "🧔🏿\u200d♀", "🧔🏿\u200d♂", "🧖\u200d♀", "🧖\u200d♂", "🧖🏻\u200d♀", "🧖🏻\u200d♂", "🧖🏼\u200d♀", "🧖🏼\u200d♂", "🧖🏽\u200d♀", "🧖🏽\u200d♂", "🧖🏾\u200d♀", "🧖🏾\u200d♂", "🧖🏿\u200d♀", "🧖🏿\u200d♂", "🧗\u200d♀", "🧗\u200d♂", "🧗🏻\u200d♀", "🧗🏻\u200d♂", "🧗🏼\u200d♀", "🧗🏼\u200d♂", "🧗🏽\u200d♀", "🧗🏽\u200d♂", "🧗🏾\u200d♀", "🧗🏾\u200d♂", "🧗🏿\u200d♀", "🧗🏿\u200d♂", "🧘\u200d♀", "🧘\u200d♂", "🧘🏻\u200d♀", "🧘🏻\u200d♂", "🧘🏼\u200d♀", "🧘🏼\u200d♂", "🧘🏽\u200d♀", "🧘🏽\u200d♂", "🧘🏾\u200d♀", "🧘🏾\u200d♂", "🧘🏿\u200d♀", "🧘🏿\u200d♂", "🧙\u200d♀", "🧙\u200d♂", "🧙🏻\u200d♀", "🧙🏻\u200d♂", "🧙🏼\u200d♀", "🧙🏼\u200d♂", "🧙🏽\u200d♀", "🧙🏽\u200d♂", "🧙🏾\u200d♀", "🧙🏾\u200d♂", "🧙🏿\u200d♀", "🧙🏿\u200d♂", "🧚\u200d♀", "🧚\u200d♂", "🧚🏻\u200d♀", "🧚🏻\u200d♂", "🧚🏼\u200d♀", "🧚🏼\u200d♂", "🧚🏽\u200d♀", "🧚🏽\u200d♂", "🧚🏾\u200d♀", "🧚🏾\u200d♂", "🧚🏿\u200d♀", "🧚🏿\u200d♂", "🧛\u200d♀", "🧛\u200d♂", "🧛🏻\u200d♀", "🧛🏻\u200d♂", "🧛🏼\u200d♀", "🧛🏼\u200d♂", "🧛🏽\u200d♀", "🧛🏽\u200d♂", "🧛🏾\u200d♀", "🧛🏾\u200d♂", "🧛🏿\u200d♀", "🧛🏿\u200d♂", "🧜\u200d♀", "🧜\u200d♂", "🧜🏻\u200d♀", "🧜🏻\u200d♂", "🧜🏼\u200d♀", "🧜🏼\u200d♂", "🧜🏽\u200d♀", "🧜🏽\u200d♂", "🧜🏾\u200d♀", "🧜🏾\u200d♂", "🧜🏿\u200d♀", "🧜🏿\u200d♂", "🧝\u200d♀", "🧝\u200d♂", "🧝🏻\u200d♀", "🧝🏻\u200d♂", "🧝🏼\u200d♀", "🧝🏼\u200d♂", "🧝🏽\u200d♀", "🧝🏽\u200d♂", "🧝🏾\u200d♀", "🧝🏾\u200d♂", "🧝🏿\u200d♀", "🧝🏿\u200d♂", "🧞\u200d♀", "🧞\u200d♂", "🧟\u200d♀", "🧟\u200d♂", "🫱🏻\u200d🫲🏼", "🫱🏻\u200d🫲🏽", "🫱🏻\u200d🫲🏾", "🫱🏻\u200d🫲🏿", "🫱🏼\u200d🫲🏻", "🫱🏼\u200d🫲🏽", "🫱🏼\u200d🫲🏾", "🫱🏼\u200d🫲🏿", "🫱🏽\u200d🫲🏻", "🫱🏽\u200d🫲🏼", "🫱🏽\u200d🫲🏾", "🫱🏽\u200d🫲🏿", "🫱🏾\u200d🫲🏻", "🫱🏾\u200d🫲🏼", "🫱🏾\u200d🫲🏽", "🫱🏾\u200d🫲🏿", "🫱🏿\u200d🫲🏻", "🫱🏿\u200d🫲🏼", "🫱🏿\u200d🫲🏽", "🫱🏿\u200d🫲🏾"}
+var tag_sequences = []string{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f"}
+var flag_sequences = []string{"🇦🇨", "🇦🇩", "🇦🇪", "🇦🇫", "🇦🇬", "🇦🇮", "🇦🇱", "🇦🇲", "🇦🇴", "🇦🇶", "🇦🇷", "🇦🇸", "🇦🇹", "🇦🇺", "🇦🇼", "🇦🇽", "🇦🇿", "🇧🇦", "🇧🇧", "🇧🇩", "🇧🇪", "🇧🇫", "🇧🇬", "🇧🇭", "🇧🇮", "🇧🇯", "🇧🇱", "🇧🇲", "🇧🇳", "🇧🇴", "🇧🇶", "🇧🇷", "🇧🇸", "🇧🇹", "🇧🇻", "🇧🇼", "🇧🇾", "🇧🇿", "🇨🇦", "🇨🇨", "🇨🇩", "🇨🇫", "🇨🇬", "🇨🇭", "🇨🇮", "🇨🇰", "🇨🇱", "🇨🇲", "🇨🇳", "🇨🇴", "🇨🇵", "🇨🇶", "🇨🇷", "🇨🇺", "🇨🇻", "🇨🇼", "🇨🇽", "🇨🇾", "🇨🇿", "🇩🇪", "🇩🇬", "🇩🇯", "🇩🇰", "🇩🇲", "🇩🇴", "🇩🇿", "🇪🇦", "🇪🇨", "🇪🇪", "🇪🇬", "🇪🇭", "🇪🇷", "🇪🇸", "🇪🇹", "🇪🇺", "🇫🇮", "🇫🇯", "🇫🇰", "🇫🇲", "🇫🇴", "🇫🇷", "🇬🇦", "🇬🇧", "🇬🇩", "🇬🇪", "🇬🇫", "🇬🇬", "🇬🇭", "🇬🇮", "🇬🇱", "🇬🇲", "🇬🇳", "🇬🇵", "🇬🇶", "🇬🇷", "🇬🇸", "🇬🇹", "🇬🇺", "🇬🇼", "🇬🇾", "🇭🇰", "🇭🇲", "🇭🇳", "🇭🇷", "🇭🇹", "🇭🇺", "🇮🇨", "🇮🇩", "🇮🇪", "🇮🇱", "🇮🇲", "🇮🇳", "🇮🇴", "🇮🇶", "🇮🇷", "🇮🇸", "🇮🇹", "🇯🇪", "🇯🇲", "🇯🇴", "🇯🇵", "🇰🇪", "🇰🇬", "🇰🇭", "🇰🇮", "🇰🇲", "🇰🇳", "🇰🇵", "🇰🇷", "🇰🇼", "🇰🇾", "🇰🇿", "🇱🇦", "🇱🇧", "🇱🇨", "🇱🇮", "🇱🇰", "🇱🇷", "🇱🇸", "🇱🇹", "🇱🇺", "🇱🇻", "🇱🇾", "🇲🇦", "🇲🇨", "🇲🇩", "🇲🇪", "🇲🇫", "🇲🇬", "🇲🇭", "🇲🇰", "🇲🇱", "🇲🇲", "🇲🇳", "🇲🇴", "🇲🇵", "🇲🇶", "🇲🇷", "🇲🇸", "🇲🇹", "🇲🇺", "🇲🇻", "🇲🇼", "🇲🇽", "🇲🇾", "🇲🇿", "🇳🇦", "🇳🇨", "🇳🇪", "🇳🇫", "🇳🇬", "🇳🇮", "🇳🇱", "🇳🇴", "🇳🇵", "🇳🇷", "🇳🇺", "🇳🇿", "🇴🇲", "🇵🇦", "🇵🇪", "🇵🇫", "🇵🇬", "🇵🇭", "🇵🇰", "🇵🇱", "🇵🇲", "🇵🇳", "🇵🇷", "🇵🇸", "🇵🇹", "🇵🇼", "🇵🇾", "🇶🇦", "🇷🇪", "🇷🇴", "🇷🇸", "🇷🇺", "🇷🇼", "🇸🇦", "🇸🇧", "🇸🇨", "🇸🇩", "🇸🇪", "🇸🇬", "🇸🇭", "🇸🇮", "🇸🇯", "🇸🇰", "🇸🇱", "🇸🇲", "🇸🇳", "🇸🇴", "🇸🇷", "🇸🇸", "🇸🇹", "🇸🇻", "🇸🇽", "🇸🇾", "🇸🇿", "🇹🇦", "🇹🇨", "🇹🇩", "🇹🇫", "🇹🇬", "🇹🇭", "🇹🇯", "🇹🇰", "🇹🇱", "🇹🇲", "🇹🇳", "🇹🇴", "🇹🇷", "🇹🇹", "🇹🇻", "🇹🇼", "🇹🇿", "🇺🇦", "🇺🇬", "🇺🇲", "🇺🇳", "🇺🇸", "🇺🇾", "🇺🇿", "🇻🇦", "🇻🇨", "🇻🇪", "🇻🇬", "🇻🇮", "🇻🇳", "🇻🇺", "🇼🇫", "🇼🇸", "🇽🇰", "🇾🇪", "🇾🇹", "🇿🇦", "🇿🇲", "🇿🇼"}
+var emoji_test = []emojiTest{
+	{"#⃣", "keycap: #", "Symbols", "keycap", E0_6, Unqualified},
+	{"#️⃣", "keycap: #", "Symbols", "keycap", E0_6, FullyQualified},
+	{"*⃣", "keycap: *", "Symbols", "keycap", E2_0, Unqualified},
+	{"*️⃣", "keycap: *", "Symbols", "keycap", E2_0, FullyQualified},
+	{"0⃣", "keycap: 0", "Symbols", "keycap", E0_6, Unqualified},
+	{"0️⃣", "keycap: 0", "Symbols", "keycap", E0_6, FullyQualified},
+	{"1⃣", "keycap: 1", "Symbols", "keycap", E0_6, Unqualified},
+	{"1️⃣", "keycap: 1", "Symbols", "keycap", E0_6, FullyQualified},
+	{"2⃣", "keycap: 2", "Symbols", "keycap", E0_6, Unqualified},
+	{"2️⃣", "keycap: 2", "Symbols", "keycap", E0_6, FullyQualified},
+	{"3⃣", "keycap: 3", "Symbols", "keycap", E0_6, Unqualified},
+	{"3️⃣", "keycap: 3", "Symbols", "keycap", E0_6, FullyQualified},
+	{"4⃣", "keycap: 4", "Symbols", "keycap", E0_6, Unqualified},
+	{"4️⃣", "keycap: 4", "Symbols", "keycap", E0_6, FullyQualified},
+	{"5⃣", "keycap: 5", "Symbols", "keycap", E0_6, Unqualified},
+	{"5️⃣", "keycap: 5", "Symbols", "keycap", E0_6, FullyQualified},
+	{"6⃣", "keycap: 6", "Symbols", "keycap", E0_6, Unqualified},
+	{"6️⃣", "keycap: 6", "Symbols", "keycap", E0_6, FullyQualified},
+	{"7⃣", "keycap: 7", "Symbols", "keycap", E0_6, Unqualified},
+	{"7️⃣", "keycap: 7", "Symbols", "keycap", E0_6, FullyQualified},
+	{"8⃣", "keycap: 8", "Symbols", "keycap", E0_6, Unqualified},
+	{"8️⃣", "keycap: 8", "Symbols", "keycap", E0_6, FullyQualified},
+	{"9⃣", "keycap: 9", "Symbols", "keycap", E0_6, Unqualified},
+	{"9️⃣", "keycap: 9", "Symbols", "keycap", E0_6, FullyQualified},
+	{"©", "copyright", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"©️", "copyright", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"®", "registered", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"®️", "registered", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"‼", "double exclamation mark", "Symbols", "punctuation", E0_6, Unqualified},
+	{"‼️", "double exclamation mark", "Symbols", "punctuation", E0_6, FullyQualified},
+	{"⁉", "exclamation question mark", "Symbols", "punctuation", E0_6, Unqualified},
+	{"⁉️", "exclamation question mark", "Symbols", "punctuation", E0_6, FullyQualified},
+	{"™", "trade mark", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"™️", "trade mark", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"ℹ", "information", "Symbols", "alphanum", E0_6, Unqualified},
+	{"ℹ️", "information", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"↔", "left-right arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"↔️", "left-right arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"↕", "up-down arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"↕️", "up-down arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"↖", "up-left arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"↖️", "up-left arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"↗", "up-right arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"↗️", "up-right arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"↘", "down-right arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"↘️", "down-right arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"↙", "down-left arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"↙️", "down-left arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"↩", "right arrow curving left", "Symbols", "arrow", E0_6, Unqualified},
+	{"↩️", "right arrow curving left", "Symbols", "arrow", E0_6, FullyQualified},
+	{"↪", "left arrow curving right", "Symbols", "arrow", E0_6, Unqualified},
+	{"↪️", "left arrow curving right", "Symbols", "arrow", E0_6, FullyQualified},
+	{"⌚", "watch", "Travel & Places", "time", E0_6, FullyQualified},
+	{"⌛", "hourglass done", "Travel & Places", "time", E0_6, FullyQualified},
+	{"⌨", "keyboard", "Objects", "computer", E1_0, Unqualified},
+	{"⌨️", "keyboard", "Objects", "computer", E1_0, FullyQualified},
+	{"⏏", "eject button", "Symbols", "av-symbol", E1_0, Unqualified},
+	{"⏏️", "eject button", "Symbols", "av-symbol", E1_0, FullyQualified},
+	{"⏩", "fast-forward button", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"⏪", "fast reverse button", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"⏫", "fast up button", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"⏬", "fast down button", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"⏭", "next track button", "Symbols", "av-symbol", E0_7, Unqualified},
+	{"⏭️", "next track button", "Symbols", "av-symbol", E0_7, FullyQualified},
+	{"⏮", "last track button", "Symbols", "av-symbol", E0_7, Unqualified},
+	{"⏮️", "last track button", "Symbols", "av-symbol", E0_7, FullyQualified},
+	{"⏯", "play or pause button", "Symbols", "av-symbol", E1_0, Unqualified},
+	{"⏯️", "play or pause button", "Symbols", "av-symbol", E1_0, FullyQualified},
+	{"⏰", "alarm clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"⏱", "stopwatch", "Travel & Places", "time", E1_0, Unqualified},
+	{"⏱️", "stopwatch", "Travel & Places", "time", E1_0, FullyQualified},
+	{"⏲", "timer clock", "Travel & Places", "time", E1_0, Unqualified},
+	{"⏲️", "timer clock", "Travel & Places", "time", E1_0, FullyQualified},
+	{"⏳", "hourglass not done", "Travel & Places", "time", E0_6, FullyQualified},
+	{"⏸", "pause button", "Symbols", "av-symbol", E0_7, Unqualified},
+	{"⏸️", "pause button", "Symbols", "av-symbol", E0_7, FullyQualified},
+	{"⏹", "stop button", "Symbols", "av-symbol", E0_7, Unqualified},
+	{"⏹️", "stop button", "Symbols", "av-symbol", E0_7, FullyQualified},
+	{"⏺", "record button", "Symbols", "av-symbol", E0_7, Unqualified},
+	{"⏺️", "record button", "Symbols", "av-symbol", E0_7, FullyQualified},
+	{"Ⓜ", "circled M", "Symbols", "alphanum", E0_6, Unqualified},
+	{"Ⓜ️", "circled M", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"▪", "black small square", "Symbols", "geometric", E0_6, Unqualified},
+	{"▪️", "black small square", "Symbols", "geometric", E0_6, FullyQualified},
+	{"▫", "white small square", "Symbols", "geometric", E0_6, Unqualified},
+	{"▫️", "white small square", "Symbols", "geometric", E0_6, FullyQualified},
+	{"▶", "play button", "Symbols", "av-symbol", E0_6, Unqualified},
+	{"▶️", "play button", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"◀", "reverse button", "Symbols", "av-symbol", E0_6, Unqualified},
+	{"◀️", "reverse button", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"◻", "white medium square", "Symbols", "geometric", E0_6, Unqualified},
+	{"◻️", "white medium square", "Symbols", "geometric", E0_6, FullyQualified},
+	{"◼", "black medium square", "Symbols", "geometric", E0_6, Unqualified},
+	{"◼️", "black medium square", "Symbols", "geometric", E0_6, FullyQualified},
+	{"◽", "white medium-small square", "Symbols", "geometric", E0_6, FullyQualified},
+	{"◾", "black medium-small square", "Symbols", "geometric", E0_6, FullyQualified},
+	{"☀", "sun", "Travel & Places", "sky & weather", E0_6, Unqualified},
+	{"☀️", "sun", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"☁", "cloud", "Travel & Places", "sky & weather", E0_6, Unqualified},
+	{"☁️", "cloud", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"☂", "umbrella", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"☂️", "umbrella", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"☃", "snowman", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"☃️", "snowman", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"☄", "comet", "Travel & Places", "sky & weather", E1_0, Unqualified},
+	{"☄️", "comet", "Travel & Places", "sky & weather", E1_0, FullyQualified},
+	{"☎", "telephone", "Objects", "phone", E0_6, Unqualified},
+	{"☎️", "telephone", "Objects", "phone", E0_6, FullyQualified},
+	{"☑", "check box with check", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"☑️", "check box with check", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"☔", "umbrella with rain drops", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"☕", "hot beverage", "Food & Drink", "drink", E0_6, FullyQualified},
+	{"☘", "shamrock", "Animals & Nature", "plant-other", E1_0, Unqualified},
+	{"☘️", "shamrock", "Animals & Nature", "plant-other", E1_0, FullyQualified},
+	{"☝", "index pointing up", "People & Body", "hand-single-finger", E0_6, Unqualified},
+	{"☝️", "index pointing up", "People & Body", "hand-single-finger", E0_6, FullyQualified},
+	{"☝🏻", "index pointing up: light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"☝🏼", "index pointing up: medium-light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"☝🏽", "index pointing up: medium skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"☝🏾", "index pointing up: medium-dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"☝🏿", "index pointing up: dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"☠", "skull and crossbones", "Smileys & Emotion", "face-negative", E1_0, Unqualified},
+	{"☠️", "skull and crossbones", "Smileys & Emotion", "face-negative", E1_0, FullyQualified},
+	{"☢", "radioactive", "Symbols", "warning", E1_0, Unqualified},
+	{"☢️", "radioactive", "Symbols", "warning", E1_0, FullyQualified},
+	{"☣", "biohazard", "Symbols", "warning", E1_0, Unqualified},
+	{"☣️", "biohazard", "Symbols", "warning", E1_0, FullyQualified},
+	{"☦", "orthodox cross", "Symbols", "religion", E1_0, Unqualified},
+	{"☦️", "orthodox cross", "Symbols", "religion", E1_0, FullyQualified},
+	{"☪", "star and crescent", "Symbols", "religion", E0_7, Unqualified},
+	{"☪️", "star and crescent", "Symbols", "religion", E0_7, FullyQualified},
+	{"☮", "peace symbol", "Symbols", "religion", E1_0, Unqualified},
+	{"☮️", "peace symbol", "Symbols", "religion", E1_0, FullyQualified},
+	{"☯", "yin yang", "Symbols", "religion", E0_7, Unqualified},
+	{"☯️", "yin yang", "Symbols", "religion", E0_7, FullyQualified},
+	{"☸", "wheel of dharma", "Symbols", "religion", E0_7, Unqualified},
+	{"☸️", "wheel of dharma", "Symbols", "religion", E0_7, FullyQualified},
+	{"☹", "frowning face", "Smileys & Emotion", "face-concerned", E0_7, Unqualified},
+	{"☹️", "frowning face", "Smileys & Emotion", "face-concerned", E0_7, FullyQualified},
+	{"☺", "smiling face", "Smileys & Emotion", "face-affection", E0_6, Unqualified},
+	{"☺️", "smiling face", "Smileys & Emotion", "face-affection", E0_6, FullyQualified},
+	{"♀", "female sign", "Symbols", "gender", E4_0, Unqualified},
+	{"♀️", "female sign", "Symbols", "gender", E4_0, FullyQualified},
+	{"♂", "male sign", "Symbols", "gender", E4_0, Unqualified},
+	{"♂️", "male sign", "Symbols", "gender", E4_0, FullyQualified},
+	{"♈", "Aries", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♉", "Taurus", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♊", "Gemini", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♋", "Cancer", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♌", "Leo", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♍", "Virgo", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♎", "Libra", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♏", "Scorpio", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♐", "Sagittarius", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♑", "Capricorn", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♒", "Aquarius", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♓", "Pisces", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"♟", "chess pawn", "Activities", "game", E11_0, Unqualified},
+	{"♟️", "chess pawn", "Activities", "game", E11_0, FullyQualified},
+	{"♠", "spade suit", "Activities", "game", E0_6, Unqualified},
+	{"♠️", "spade suit", "Activities", "game", E0_6, FullyQualified},
+	{"♣", "club suit", "Activities", "game", E0_6, Unqualified},
+	{"♣️", "club suit", "Activities", "game", E0_6, FullyQualified},
+	{"♥", "heart suit", "Activities", "game", E0_6, Unqualified},
+	{"♥️", "heart suit", "Activities", "game", E0_6, FullyQualified},
+	{"♦", "diamond suit", "Activities", "game", E0_6, Unqualified},
+	{"♦️", "diamond suit", "Activities", "game", E0_6, FullyQualified},
+	{"♨", "hot springs", "Travel & Places", "place-other", E0_6, Unqualified},
+	{"♨️", "hot springs", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"♻", "recycling symbol", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"♻️", "recycling symbol", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"♾", "infinity", "Symbols", "math", E11_0, Unqualified},
+	{"♾️", "infinity", "Symbols", "math", E11_0, FullyQualified},
+	{"♿", "wheelchair symbol", "Symbols", "transport-sign", E0_6, FullyQualified},
+	{"⚒", "hammer and pick", "Objects", "tool", E1_0, Unqualified},
+	{"⚒️", "hammer and pick", "Objects", "tool", E1_0, FullyQualified},
+	{"⚓", "anchor", "Travel & Places", "transport-water", E0_6, FullyQualified},
+	{"⚔", "crossed swords", "Objects", "tool", E1_0, Unqualified},
+	{"⚔️", "crossed swords", "Objects", "tool", E1_0, FullyQualified},
+	{"⚕", "medical symbol", "Symbols", "other-symbol", E4_0, Unqualified},
+	{"⚕️", "medical symbol", "Symbols", "other-symbol", E4_0, FullyQualified},
+	{"⚖", "balance scale", "Objects", "tool", E1_0, Unqualified},
+	{"⚖️", "balance scale", "Objects", "tool", E1_0, FullyQualified},
+	{"⚗", "alembic", "Objects", "science", E1_0, Unqualified},
+	{"⚗️", "alembic", "Objects", "science", E1_0, FullyQualified},
+	{"⚙", "gear", "Objects", "tool", E1_0, Unqualified},
+	{"⚙️", "gear", "Objects", "tool", E1_0, FullyQualified},
+	{"⚛", "atom symbol", "Symbols", "religion", E1_0, Unqualified},
+	{"⚛️", "atom symbol", "Symbols", "religion", E1_0, FullyQualified},
+	{"⚜", "fleur-de-lis", "Symbols", "other-symbol", E1_0, Unqualified},
+	{"⚜️", "fleur-de-lis", "Symbols", "other-symbol", E1_0, FullyQualified},
+	{"⚠", "warning", "Symbols", "warning", E0_6, Unqualified},
+	{"⚠️", "warning", "Symbols", "warning", E0_6, FullyQualified},
+	{"⚡", "high voltage", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"⚧", "transgender symbol", "Symbols", "gender", E13_0, Unqualified},
+	{"⚧️", "transgender symbol", "Symbols", "gender", E13_0, FullyQualified},
+	{"⚪", "white circle", "Symbols", "geometric", E0_6, FullyQualified},
+	{"⚫", "black circle", "Symbols", "geometric", E0_6, FullyQualified},
+	{"⚰", "coffin", "Objects", "other-object", E1_0, Unqualified},
+	{"⚰️", "coffin", "Objects", "other-object", E1_0, FullyQualified},
+	{"⚱", "funeral urn", "Objects", "other-object", E1_0, Unqualified},
+	{"⚱️", "funeral urn", "Objects", "other-object", E1_0, FullyQualified},
+	{"⚽", "soccer ball", "Activities", "sport", E0_6, FullyQualified},
+	{"⚾", "baseball", "Activities", "sport", E0_6, FullyQualified},
+	{"⛄", "snowman without snow", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"⛅", "sun behind cloud", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"⛈", "cloud with lightning and rain", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"⛈️", "cloud with lightning and rain", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"⛎", "Ophiuchus", "Symbols", "zodiac", E0_6, FullyQualified},
+	{"⛏", "pick", "Objects", "tool", E0_7, Unqualified},
+	{"⛏️", "pick", "Objects", "tool", E0_7, FullyQualified},
+	{"⛑", "rescue worker’s helmet", "Objects", "clothing", E0_7, Unqualified},
+	{"⛑️", "rescue worker’s helmet", "Objects", "clothing", E0_7, FullyQualified},
+	{"⛓", "chains", "Objects", "tool", E0_7, Unqualified},
+	{"⛓\u200d💥", "broken chain", "Objects", "tool", E15_1, Unqualified},
+	{"⛓️", "chains", "Objects", "tool", E0_7, FullyQualified},
+	{"⛓️\u200d💥", "broken chain", "Objects", "tool", E15_1, FullyQualified},
+	{"⛔", "no entry", "Symbols", "warning", E0_6, FullyQualified},
+	{"⛩", "shinto shrine", "Travel & Places", "place-religious", E0_7, Unqualified},
+	{"⛩️", "shinto shrine", "Travel & Places", "place-religious", E0_7, FullyQualified},
+	{"⛪", "church", "Travel & Places", "place-religious", E0_6, FullyQualified},
+	{"⛰", "mountain", "Travel & Places", "place-geographic", E0_7, Unqualified},
+	{"⛰️", "mountain", "Travel & Places", "place-geographic", E0_7, FullyQualified},
+	{"⛱", "umbrella on ground", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"⛱️", "umbrella on ground", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"⛲", "fountain", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"⛳", "flag in hole", "Activities", "sport", E0_6, FullyQualified},
+	{"⛴", "ferry", "Travel & Places", "transport-water", E0_7, Unqualified},
+	{"⛴️", "ferry", "Travel & Places", "transport-water", E0_7, FullyQualified},
+	{"⛵", "sailboat", "Travel & Places", "transport-water", E0_6, FullyQualified},
+	{"⛷", "skier", "People & Body", "person-sport", E0_7, Unqualified},
+	{"⛷️", "skier", "People & Body", "person-sport", E0_7, FullyQualified},
+	{"⛸", "ice skate", "Activities", "sport", E0_7, Unqualified},
+	{"⛸️", "ice skate", "Activities", "sport", E0_7, FullyQualified},
+	{"⛹", "person bouncing ball", "People & Body", "person-sport", E0_7, Unqualified},
+	{"⛹\u200d♀", "woman bouncing ball", "People & Body", "person-sport", E4_0, Unqualified},
+	{"⛹\u200d♀️", "woman bouncing ball", "People & Body", "person-sport", E4_0, Unqualified},
+	{"⛹\u200d♂", "man bouncing ball", "People & Body", "person-sport", E4_0, Unqualified},
+	{"⛹\u200d♂️", "man bouncing ball", "People & Body", "person-sport", E4_0, Unqualified},
+	{"⛹️", "person bouncing ball", "People & Body", "person-sport", E0_7, FullyQualified},
+	{"⛹️\u200d♀", "woman bouncing ball", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹️\u200d♀️", "woman bouncing ball", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹️\u200d♂", "man bouncing ball", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹️\u200d♂️", "man bouncing ball", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏻", "person bouncing ball: light skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"⛹🏻\u200d♀", "woman bouncing ball: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏻\u200d♀️", "woman bouncing ball: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏻\u200d♂", "man bouncing ball: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏻\u200d♂️", "man bouncing ball: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏼", "person bouncing ball: medium-light skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"⛹🏼\u200d♀", "woman bouncing ball: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏼\u200d♀️", "woman bouncing ball: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏼\u200d♂", "man bouncing ball: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏼\u200d♂️", "man bouncing ball: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏽", "person bouncing ball: medium skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"⛹🏽\u200d♀", "woman bouncing ball: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏽\u200d♀️", "woman bouncing ball: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏽\u200d♂", "man bouncing ball: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏽\u200d♂️", "man bouncing ball: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏾", "person bouncing ball: medium-dark skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"⛹🏾\u200d♀", "woman bouncing ball: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏾\u200d♀️", "woman bouncing ball: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏾\u200d♂", "man bouncing ball: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏾\u200d♂️", "man bouncing ball: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏿", "person bouncing ball: dark skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"⛹🏿\u200d♀", "woman bouncing ball: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏿\u200d♀️", "woman bouncing ball: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛹🏿\u200d♂", "man bouncing ball: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"⛹🏿\u200d♂️", "man bouncing ball: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"⛺", "tent", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"⛽", "fuel pump", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"✂", "scissors", "Objects", "office", E0_6, Unqualified},
+	{"✂️", "scissors", "Objects", "office", E0_6, FullyQualified},
+	{"✅", "check mark button", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"✈", "airplane", "Travel & Places", "transport-air", E0_6, Unqualified},
+	{"✈️", "airplane", "Travel & Places", "transport-air", E0_6, FullyQualified},
+	{"✉", "envelope", "Objects", "mail", E0_6, Unqualified},
+	{"✉️", "envelope", "Objects", "mail", E0_6, FullyQualified},
+	{"✊", "raised fist", "People & Body", "hand-fingers-closed", E0_6, FullyQualified},
+	{"✊🏻", "raised fist: light skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"✊🏼", "raised fist: medium-light skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"✊🏽", "raised fist: medium skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"✊🏾", "raised fist: medium-dark skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"✊🏿", "raised fist: dark skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"✋", "raised hand", "People & Body", "hand-fingers-open", E0_6, FullyQualified},
+	{"✋🏻", "raised hand: light skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"✋🏼", "raised hand: medium-light skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"✋🏽", "raised hand: medium skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"✋🏾", "raised hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"✋🏿", "raised hand: dark skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"✌", "victory hand", "People & Body", "hand-fingers-partial", E0_6, Unqualified},
+	{"✌️", "victory hand", "People & Body", "hand-fingers-partial", E0_6, FullyQualified},
+	{"✌🏻", "victory hand: light skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"✌🏼", "victory hand: medium-light skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"✌🏽", "victory hand: medium skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"✌🏾", "victory hand: medium-dark skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"✌🏿", "victory hand: dark skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"✍", "writing hand", "People & Body", "hand-prop", E0_7, Unqualified},
+	{"✍️", "writing hand", "People & Body", "hand-prop", E0_7, FullyQualified},
+	{"✍🏻", "writing hand: light skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"✍🏼", "writing hand: medium-light skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"✍🏽", "writing hand: medium skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"✍🏾", "writing hand: medium-dark skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"✍🏿", "writing hand: dark skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"✏", "pencil", "Objects", "writing", E0_6, Unqualified},
+	{"✏️", "pencil", "Objects", "writing", E0_6, FullyQualified},
+	{"✒", "black nib", "Objects", "writing", E0_6, Unqualified},
+	{"✒️", "black nib", "Objects", "writing", E0_6, FullyQualified},
+	{"✔", "check mark", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"✔️", "check mark", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"✖", "multiply", "Symbols", "math", E0_6, Unqualified},
+	{"✖️", "multiply", "Symbols", "math", E0_6, FullyQualified},
+	{"✝", "latin cross", "Symbols", "religion", E0_7, Unqualified},
+	{"✝️", "latin cross", "Symbols", "religion", E0_7, FullyQualified},
+	{"✡", "star of David", "Symbols", "religion", E0_7, Unqualified},
+	{"✡️", "star of David", "Symbols", "religion", E0_7, FullyQualified},
+	{"✨", "sparkles", "Activities", "event", E0_6, FullyQualified},
+	{"✳", "eight-spoked asterisk", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"✳️", "eight-spoked asterisk", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"✴", "eight-pointed star", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"✴️", "eight-pointed star", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"❄", "snowflake", "Travel & Places", "sky & weather", E0_6, Unqualified},
+	{"❄️", "snowflake", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"❇", "sparkle", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"❇️", "sparkle", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"❌", "cross mark", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"❎", "cross mark button", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"❓", "red question mark", "Symbols", "punctuation", E0_6, FullyQualified},
+	{"❔", "white question mark", "Symbols", "punctuation", E0_6, FullyQualified},
+	{"❕", "white exclamation mark", "Symbols", "punctuation", E0_6, FullyQualified},
+	{"❗", "red exclamation mark", "Symbols", "punctuation", E0_6, FullyQualified},
+	{"❣", "heart exclamation", "Smileys & Emotion", "heart", E1_0, Unqualified},
+	{"❣️", "heart exclamation", "Smileys & Emotion", "heart", E1_0, FullyQualified},
+	{"❤", "red heart", "Smileys & Emotion", "heart", E0_6, Unqualified},
+	{"❤\u200d🔥", "heart on fire", "Smileys & Emotion", "heart", E13_1, Unqualified},
+	{"❤\u200d🩹", "mending heart", "Smileys & Emotion", "heart", E13_1, Unqualified},
+	{"❤️", "red heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"❤️\u200d🔥", "heart on fire", "Smileys & Emotion", "heart", E13_1, FullyQualified},
+	{"❤️\u200d🩹", "mending heart", "Smileys & Emotion", "heart", E13_1, FullyQualified},
+	{"➕", "plus", "Symbols", "math", E0_6, FullyQualified},
+	{"➖", "minus", "Symbols", "math", E0_6, FullyQualified},
+	{"➗", "divide", "Symbols", "math", E0_6, FullyQualified},
+	{"➡", "right arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"➡️", "right arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"➰", "curly loop", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"➿", "double curly loop", "Symbols", "other-symbol", E1_0, FullyQualified},
+	{"⤴", "right arrow curving up", "Symbols", "arrow", E0_6, Unqualified},
+	{"⤴️", "right arrow curving up", "Symbols", "arrow", E0_6, FullyQualified},
+	{"⤵", "right arrow curving down", "Symbols", "arrow", E0_6, Unqualified},
+	{"⤵️", "right arrow curving down", "Symbols", "arrow", E0_6, FullyQualified},
+	{"⬅", "left arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"⬅️", "left arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"⬆", "up arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"⬆️", "up arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"⬇", "down arrow", "Symbols", "arrow", E0_6, Unqualified},
+	{"⬇️", "down arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"⬛", "black large square", "Symbols", "geometric", E0_6, FullyQualified},
+	{"⬜", "white large square", "Symbols", "geometric", E0_6, FullyQualified},
+	{"⭐", "star", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"⭕", "hollow red circle", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"〰", "wavy dash", "Symbols", "punctuation", E0_6, Unqualified},
+	{"〰️", "wavy dash", "Symbols", "punctuation", E0_6, FullyQualified},
+	{"〽", "part alternation mark", "Symbols", "other-symbol", E0_6, Unqualified},
+	{"〽️", "part alternation mark", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"㊗", "Japanese “congratulations” button", "Symbols", "alphanum", E0_6, Unqualified},
+	{"㊗️", "Japanese “congratulations” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"㊙", "Japanese “secret” button", "Symbols", "alphanum", E0_6, Unqualified},
+	{"㊙️", "Japanese “secret” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🀄", "mahjong red dragon", "Activities", "game", E0_6, FullyQualified},
+	{"🃏", "joker", "Activities", "game", E0_6, FullyQualified},
+	{"🅰", "A button (blood type)", "Symbols", "alphanum", E0_6, Unqualified},
+	{"🅰️", "A button (blood type)", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🅱", "B button (blood type)", "Symbols", "alphanum", E0_6, Unqualified},
+	{"🅱️", "B button (blood type)", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🅾", "O button (blood type)", "Symbols", "alphanum", E0_6, Unqualified},
+	{"🅾️", "O button (blood type)", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🅿", "P button", "Symbols", "alphanum", E0_6, Unqualified},
+	{"🅿️", "P button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆎", "AB button (blood type)", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆑", "CL button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆒", "COOL button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆓", "FREE button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆔", "ID button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆕", "NEW button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆖", "NG button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆗", "OK button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆘", "SOS button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆙", "UP! button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🆚", "VS button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🇦🇨", "flag: Ascension Island", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇩", "flag: Andorra", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇪", "flag: United Arab Emirates", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇫", "flag: Afghanistan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇬", "flag: Antigua & Barbuda", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇮", "flag: Anguilla", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇱", "flag: Albania", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇲", "flag: Armenia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇴", "flag: Angola", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇶", "flag: Antarctica", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇷", "flag: Argentina", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇸", "flag: American Samoa", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇹", "flag: Austria", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇺", "flag: Australia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇼", "flag: Aruba", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇽", "flag: Åland Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇦🇿", "flag: Azerbaijan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇦", "flag: Bosnia & Herzegovina", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇧", "flag: Barbados", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇩", "flag: Bangladesh", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇪", "flag: Belgium", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇫", "flag: Burkina Faso", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇬", "flag: Bulgaria", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇭", "flag: Bahrain", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇮", "flag: Burundi", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇯", "flag: Benin", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇱", "flag: St. Barthélemy", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇲", "flag: Bermuda", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇳", "flag: Brunei", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇴", "flag: Bolivia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇶", "flag: Caribbean Netherlands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇷", "flag: Brazil", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇸", "flag: Bahamas", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇹", "flag: Bhutan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇻", "flag: Bouvet Island", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇼", "flag: Botswana", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇾", "flag: Belarus", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇧🇿", "flag: Belize", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇦", "flag: Canada", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇨", "flag: Cocos (Keeling) Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇩", "flag: Congo - Kinshasa", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇫", "flag: Central African Republic", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇬", "flag: Congo - Brazzaville", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇭", "flag: Switzerland", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇮", "flag: Côte d’Ivoire", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇰", "flag: Cook Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇱", "flag: Chile", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇲", "flag: Cameroon", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇳", "flag: China", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇨🇴", "flag: Colombia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇵", "flag: Clipperton Island", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇶", "flag: Sark", "Flags", "country-flag", E16_0, FullyQualified},
+	{"🇨🇷", "flag: Costa Rica", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇺", "flag: Cuba", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇻", "flag: Cape Verde", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇼", "flag: Curaçao", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇽", "flag: Christmas Island", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇾", "flag: Cyprus", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇨🇿", "flag: Czechia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇩🇪", "flag: Germany", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇩🇬", "flag: Diego Garcia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇩🇯", "flag: Djibouti", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇩🇰", "flag: Denmark", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇩🇲", "flag: Dominica", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇩🇴", "flag: Dominican Republic", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇩🇿", "flag: Algeria", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇪🇦", "flag: Ceuta & Melilla", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇪🇨", "flag: Ecuador", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇪🇪", "flag: Estonia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇪🇬", "flag: Egypt", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇪🇭", "flag: Western Sahara", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇪🇷", "flag: Eritrea", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇪🇸", "flag: Spain", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇪🇹", "flag: Ethiopia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇪🇺", "flag: European Union", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇫🇮", "flag: Finland", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇫🇯", "flag: Fiji", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇫🇰", "flag: Falkland Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇫🇲", "flag: Micronesia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇫🇴", "flag: Faroe Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇫🇷", "flag: France", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇬🇦", "flag: Gabon", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇧", "flag: United Kingdom", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇬🇩", "flag: Grenada", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇪", "flag: Georgia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇫", "flag: French Guiana", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇬", "flag: Guernsey", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇭", "flag: Ghana", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇮", "flag: Gibraltar", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇱", "flag: Greenland", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇲", "flag: Gambia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇳", "flag: Guinea", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇵", "flag: Guadeloupe", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇶", "flag: Equatorial Guinea", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇷", "flag: Greece", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇸", "flag: South Georgia & South Sandwich Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇹", "flag: Guatemala", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇺", "flag: Guam", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇼", "flag: Guinea-Bissau", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇬🇾", "flag: Guyana", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇭🇰", "flag: Hong Kong SAR China", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇭🇲", "flag: Heard & McDonald Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇭🇳", "flag: Honduras", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇭🇷", "flag: Croatia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇭🇹", "flag: Haiti", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇭🇺", "flag: Hungary", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇨", "flag: Canary Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇩", "flag: Indonesia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇪", "flag: Ireland", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇱", "flag: Israel", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇲", "flag: Isle of Man", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇳", "flag: India", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇴", "flag: British Indian Ocean Territory", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇶", "flag: Iraq", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇷", "flag: Iran", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇸", "flag: Iceland", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇮🇹", "flag: Italy", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇯🇪", "flag: Jersey", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇯🇲", "flag: Jamaica", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇯🇴", "flag: Jordan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇯🇵", "flag: Japan", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇰🇪", "flag: Kenya", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇬", "flag: Kyrgyzstan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇭", "flag: Cambodia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇮", "flag: Kiribati", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇲", "flag: Comoros", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇳", "flag: St. Kitts & Nevis", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇵", "flag: North Korea", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇷", "flag: South Korea", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇰🇼", "flag: Kuwait", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇾", "flag: Cayman Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇰🇿", "flag: Kazakhstan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇦", "flag: Laos", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇧", "flag: Lebanon", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇨", "flag: St. Lucia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇮", "flag: Liechtenstein", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇰", "flag: Sri Lanka", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇷", "flag: Liberia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇸", "flag: Lesotho", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇹", "flag: Lithuania", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇺", "flag: Luxembourg", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇻", "flag: Latvia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇱🇾", "flag: Libya", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇦", "flag: Morocco", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇨", "flag: Monaco", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇩", "flag: Moldova", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇪", "flag: Montenegro", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇫", "flag: St. Martin", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇬", "flag: Madagascar", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇭", "flag: Marshall Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇰", "flag: North Macedonia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇱", "flag: Mali", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇲", "flag: Myanmar (Burma)", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇳", "flag: Mongolia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇴", "flag: Macao SAR China", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇵", "flag: Northern Mariana Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇶", "flag: Martinique", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇷", "flag: Mauritania", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇸", "flag: Montserrat", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇹", "flag: Malta", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇺", "flag: Mauritius", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇻", "flag: Maldives", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇼", "flag: Malawi", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇽", "flag: Mexico", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇾", "flag: Malaysia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇲🇿", "flag: Mozambique", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇦", "flag: Namibia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇨", "flag: New Caledonia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇪", "flag: Niger", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇫", "flag: Norfolk Island", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇬", "flag: Nigeria", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇮", "flag: Nicaragua", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇱", "flag: Netherlands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇴", "flag: Norway", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇵", "flag: Nepal", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇷", "flag: Nauru", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇺", "flag: Niue", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇳🇿", "flag: New Zealand", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇴🇲", "flag: Oman", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇦", "flag: Panama", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇪", "flag: Peru", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇫", "flag: French Polynesia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇬", "flag: Papua New Guinea", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇭", "flag: Philippines", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇰", "flag: Pakistan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇱", "flag: Poland", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇲", "flag: St. Pierre & Miquelon", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇳", "flag: Pitcairn Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇷", "flag: Puerto Rico", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇸", "flag: Palestinian Territories", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇹", "flag: Portugal", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇼", "flag: Palau", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇵🇾", "flag: Paraguay", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇶🇦", "flag: Qatar", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇷🇪", "flag: Réunion", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇷🇴", "flag: Romania", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇷🇸", "flag: Serbia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇷🇺", "flag: Russia", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇷🇼", "flag: Rwanda", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇦", "flag: Saudi Arabia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇧", "flag: Solomon Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇨", "flag: Seychelles", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇩", "flag: Sudan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇪", "flag: Sweden", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇬", "flag: Singapore", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇭", "flag: St. Helena", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇮", "flag: Slovenia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇯", "flag: Svalbard & Jan Mayen", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇰", "flag: Slovakia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇱", "flag: Sierra Leone", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇲", "flag: San Marino", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇳", "flag: Senegal", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇴", "flag: Somalia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇷", "flag: Suriname", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇸", "flag: South Sudan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇹", "flag: São Tomé & Príncipe", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇻", "flag: El Salvador", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇽", "flag: Sint Maarten", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇾", "flag: Syria", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇸🇿", "flag: Eswatini", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇦", "flag: Tristan da Cunha", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇨", "flag: Turks & Caicos Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇩", "flag: Chad", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇫", "flag: French Southern Territories", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇬", "flag: Togo", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇭", "flag: Thailand", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇯", "flag: Tajikistan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇰", "flag: Tokelau", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇱", "flag: Timor-Leste", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇲", "flag: Turkmenistan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇳", "flag: Tunisia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇴", "flag: Tonga", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇷", "flag: Türkiye", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇹", "flag: Trinidad & Tobago", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇻", "flag: Tuvalu", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇼", "flag: Taiwan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇹🇿", "flag: Tanzania", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇺🇦", "flag: Ukraine", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇺🇬", "flag: Uganda", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇺🇲", "flag: U.S. Outlying Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇺🇳", "flag: United Nations", "Flags", "country-flag", E4_0, FullyQualified},
+	{"🇺🇸", "flag: United States", "Flags", "country-flag", E0_6, FullyQualified},
+	{"🇺🇾", "flag: Uruguay", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇺🇿", "flag: Uzbekistan", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇻🇦", "flag: Vatican City", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇻🇨", "flag: St. Vincent & Grenadines", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇻🇪", "flag: Venezuela", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇻🇬", "flag: British Virgin Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇻🇮", "flag: U.S. Virgin Islands", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇻🇳", "flag: Vietnam", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇻🇺", "flag: Vanuatu", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇼🇫", "flag: Wallis & Futuna", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇼🇸", "flag: Samoa", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇽🇰", "flag: Kosovo", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇾🇪", "flag: Yemen", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇾🇹", "flag: Mayotte", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇿🇦", "flag: South Africa", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇿🇲", "flag: Zambia", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🇿🇼", "flag: Zimbabwe", "Flags", "country-flag", E2_0, FullyQualified},
+	{"🈁", "Japanese “here” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈂", "Japanese “service charge” button", "Symbols", "alphanum", E0_6, Unqualified},
+	{"🈂️", "Japanese “service charge” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈚", "Japanese “free of charge” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈯", "Japanese “reserved” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈲", "Japanese “prohibited” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈳", "Japanese “vacancy” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈴", "Japanese “passing grade” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈵", "Japanese “no vacancy” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈶", "Japanese “not free of charge” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈷", "Japanese “monthly amount” button", "Symbols", "alphanum", E0_6, Unqualified},
+	{"🈷️", "Japanese “monthly amount” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈸", "Japanese “application” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈹", "Japanese “discount” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🈺", "Japanese “open for business” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🉐", "Japanese “bargain” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🉑", "Japanese “acceptable” button", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🌀", "cyclone", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌁", "foggy", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🌂", "closed umbrella", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌃", "night with stars", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🌄", "sunrise over mountains", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🌅", "sunrise", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🌆", "cityscape at dusk", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🌇", "sunset", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🌈", "rainbow", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌉", "bridge at night", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🌊", "water wave", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌋", "volcano", "Travel & Places", "place-geographic", E0_6, FullyQualified},
+	{"🌌", "milky way", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌍", "globe showing Europe-Africa", "Travel & Places", "place-map", E0_7, FullyQualified},
+	{"🌎", "globe showing Americas", "Travel & Places", "place-map", E0_7, FullyQualified},
+	{"🌏", "globe showing Asia-Australia", "Travel & Places", "place-map", E0_6, FullyQualified},
+	{"🌐", "globe with meridians", "Travel & Places", "place-map", E1_0, FullyQualified},
+	{"🌑", "new moon", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌒", "waxing crescent moon", "Travel & Places", "sky & weather", E1_0, FullyQualified},
+	{"🌓", "first quarter moon", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌔", "waxing gibbous moon", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌕", "full moon", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌖", "waning gibbous moon", "Travel & Places", "sky & weather", E1_0, FullyQualified},
+	{"🌗", "last quarter moon", "Travel & Places", "sky & weather", E1_0, FullyQualified},
+	{"🌘", "waning crescent moon", "Travel & Places", "sky & weather", E1_0, FullyQualified},
+	{"🌙", "crescent moon", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌚", "new moon face", "Travel & Places", "sky & weather", E1_0, FullyQualified},
+	{"🌛", "first quarter moon face", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌜", "last quarter moon face", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌝", "full moon face", "Travel & Places", "sky & weather", E1_0, FullyQualified},
+	{"🌞", "sun with face", "Travel & Places", "sky & weather", E1_0, FullyQualified},
+	{"🌟", "glowing star", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌠", "shooting star", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🌡", "thermometer", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌡️", "thermometer", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌤", "sun behind small cloud", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌤️", "sun behind small cloud", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌥", "sun behind large cloud", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌥️", "sun behind large cloud", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌦", "sun behind rain cloud", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌦️", "sun behind rain cloud", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌧", "cloud with rain", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌧️", "cloud with rain", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌨", "cloud with snow", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌨️", "cloud with snow", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌩", "cloud with lightning", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌩️", "cloud with lightning", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌪", "tornado", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌪️", "tornado", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌫", "fog", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌫️", "fog", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌬", "wind face", "Travel & Places", "sky & weather", E0_7, Unqualified},
+	{"🌬️", "wind face", "Travel & Places", "sky & weather", E0_7, FullyQualified},
+	{"🌭", "hot dog", "Food & Drink", "food-prepared", E1_0, FullyQualified},
+	{"🌮", "taco", "Food & Drink", "food-prepared", E1_0, FullyQualified},
+	{"🌯", "burrito", "Food & Drink", "food-prepared", E1_0, FullyQualified},
+	{"🌰", "chestnut", "Food & Drink", "food-vegetable", E0_6, FullyQualified},
+	{"🌱", "seedling", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🌲", "evergreen tree", "Animals & Nature", "plant-other", E1_0, FullyQualified},
+	{"🌳", "deciduous tree", "Animals & Nature", "plant-other", E1_0, FullyQualified},
+	{"🌴", "palm tree", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🌵", "cactus", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🌶", "hot pepper", "Food & Drink", "food-vegetable", E0_7, Unqualified},
+	{"🌶️", "hot pepper", "Food & Drink", "food-vegetable", E0_7, FullyQualified},
+	{"🌷", "tulip", "Animals & Nature", "plant-flower", E0_6, FullyQualified},
+	{"🌸", "cherry blossom", "Animals & Nature", "plant-flower", E0_6, FullyQualified},
+	{"🌹", "rose", "Animals & Nature", "plant-flower", E0_6, FullyQualified},
+	{"🌺", "hibiscus", "Animals & Nature", "plant-flower", E0_6, FullyQualified},
+	{"🌻", "sunflower", "Animals & Nature", "plant-flower", E0_6, FullyQualified},
+	{"🌼", "blossom", "Animals & Nature", "plant-flower", E0_6, FullyQualified},
+	{"🌽", "ear of corn", "Food & Drink", "food-vegetable", E0_6, FullyQualified},
+	{"🌾", "sheaf of rice", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🌿", "herb", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🍀", "four leaf clover", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🍁", "maple leaf", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🍂", "fallen leaf", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🍃", "leaf fluttering in wind", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🍄", "mushroom", "Animals & Nature", "plant-other", E0_6, FullyQualified},
+	{"🍄\u200d🟫", "brown mushroom", "Food & Drink", "food-vegetable", E15_1, FullyQualified},
+	{"🍅", "tomato", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍆", "eggplant", "Food & Drink", "food-vegetable", E0_6, FullyQualified},
+	{"🍇", "grapes", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍈", "melon", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍉", "watermelon", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍊", "tangerine", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍋", "lemon", "Food & Drink", "food-fruit", E1_0, FullyQualified},
+	{"🍋\u200d🟩", "lime", "Food & Drink", "food-fruit", E15_1, FullyQualified},
+	{"🍌", "banana", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍍", "pineapple", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍎", "red apple", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍏", "green apple", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍐", "pear", "Food & Drink", "food-fruit", E1_0, FullyQualified},
+	{"🍑", "peach", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍒", "cherries", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍓", "strawberry", "Food & Drink", "food-fruit", E0_6, FullyQualified},
+	{"🍔", "hamburger", "Food & Drink", "food-prepared", E0_6, FullyQualified},
+	{"🍕", "pizza", "Food & Drink", "food-prepared", E0_6, FullyQualified},
+	{"🍖", "meat on bone", "Food & Drink", "food-prepared", E0_6, FullyQualified},
+	{"🍗", "poultry leg", "Food & Drink", "food-prepared", E0_6, FullyQualified},
+	{"🍘", "rice cracker", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍙", "rice ball", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍚", "cooked rice", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍛", "curry rice", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍜", "steaming bowl", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍝", "spaghetti", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍞", "bread", "Food & Drink", "food-prepared", E0_6, FullyQualified},
+	{"🍟", "french fries", "Food & Drink", "food-prepared", E0_6, FullyQualified},
+	{"🍠", "roasted sweet potato", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍡", "dango", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍢", "oden", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍣", "sushi", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍤", "fried shrimp", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍥", "fish cake with swirl", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍦", "soft ice cream", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍧", "shaved ice", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍨", "ice cream", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍩", "doughnut", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍪", "cookie", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍫", "chocolate bar", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍬", "candy", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍭", "lollipop", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍮", "custard", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍯", "honey pot", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍰", "shortcake", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🍱", "bento box", "Food & Drink", "food-asian", E0_6, FullyQualified},
+	{"🍲", "pot of food", "Food & Drink", "food-prepared", E0_6, FullyQualified},
+	{"🍳", "cooking", "Food & Drink", "food-prepared", E0_6, FullyQualified},
+	{"🍴", "fork and knife", "Food & Drink", "dishware", E0_6, FullyQualified},
+	{"🍵", "teacup without handle", "Food & Drink", "drink", E0_6, FullyQualified},
+	{"🍶", "sake", "Food & Drink", "drink", E0_6, FullyQualified},
+	{"🍷", "wine glass", "Food & Drink", "drink", E0_6, FullyQualified},
+	{"🍸", "cocktail glass", "Food & Drink", "drink", E0_6, FullyQualified},
+	{"🍹", "tropical drink", "Food & Drink", "drink", E0_6, FullyQualified},
+	{"🍺", "beer mug", "Food & Drink", "drink", E0_6, FullyQualified},
+	{"🍻", "clinking beer mugs", "Food & Drink", "drink", E0_6, FullyQualified},
+	{"🍼", "baby bottle", "Food & Drink", "drink", E1_0, FullyQualified},
+	{"🍽", "fork and knife with plate", "Food & Drink", "dishware", E0_7, Unqualified},
+	{"🍽️", "fork and knife with plate", "Food & Drink", "dishware", E0_7, FullyQualified},
+	{"🍾", "bottle with popping cork", "Food & Drink", "drink", E1_0, FullyQualified},
+	{"🍿", "popcorn", "Food & Drink", "food-prepared", E1_0, FullyQualified},
+	{"🎀", "ribbon", "Activities", "event", E0_6, FullyQualified},
+	{"🎁", "wrapped gift", "Activities", "event", E0_6, FullyQualified},
+	{"🎂", "birthday cake", "Food & Drink", "food-sweet", E0_6, FullyQualified},
+	{"🎃", "jack-o-lantern", "Activities", "event", E0_6, FullyQualified},
+	{"🎄", "Christmas tree", "Activities", "event", E0_6, FullyQualified},
+	{"🎅", "Santa Claus", "People & Body", "person-fantasy", E0_6, FullyQualified},
+	{"🎅🏻", "Santa Claus: light skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"🎅🏼", "Santa Claus: medium-light skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"🎅🏽", "Santa Claus: medium skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"🎅🏾", "Santa Claus: medium-dark skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"🎅🏿", "Santa Claus: dark skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"🎆", "fireworks", "Activities", "event", E0_6, FullyQualified},
+	{"🎇", "sparkler", "Activities", "event", E0_6, FullyQualified},
+	{"🎈", "balloon", "Activities", "event", E0_6, FullyQualified},
+	{"🎉", "party popper", "Activities", "event", E0_6, FullyQualified},
+	{"🎊", "confetti ball", "Activities", "event", E0_6, FullyQualified},
+	{"🎋", "tanabata tree", "Activities", "event", E0_6, FullyQualified},
+	{"🎌", "crossed flags", "Flags", "flag", E0_6, FullyQualified},
+	{"🎍", "pine decoration", "Activities", "event", E0_6, FullyQualified},
+	{"🎎", "Japanese dolls", "Activities", "event", E0_6, FullyQualified},
+	{"🎏", "carp streamer", "Activities", "event", E0_6, FullyQualified},
+	{"🎐", "wind chime", "Activities", "event", E0_6, FullyQualified},
+	{"🎑", "moon viewing ceremony", "Activities", "event", E0_6, FullyQualified},
+	{"🎒", "backpack", "Objects", "clothing", E0_6, FullyQualified},
+	{"🎓", "graduation cap", "Objects", "clothing", E0_6, FullyQualified},
+	{"🎖", "military medal", "Activities", "award-medal", E0_7, Unqualified},
+	{"🎖️", "military medal", "Activities", "award-medal", E0_7, FullyQualified},
+	{"🎗", "reminder ribbon", "Activities", "event", E0_7, Unqualified},
+	{"🎗️", "reminder ribbon", "Activities", "event", E0_7, FullyQualified},
+	{"🎙", "studio microphone", "Objects", "music", E0_7, Unqualified},
+	{"🎙️", "studio microphone", "Objects", "music", E0_7, FullyQualified},
+	{"🎚", "level slider", "Objects", "music", E0_7, Unqualified},
+	{"🎚️", "level slider", "Objects", "music", E0_7, FullyQualified},
+	{"🎛", "control knobs", "Objects", "music", E0_7, Unqualified},
+	{"🎛️", "control knobs", "Objects", "music", E0_7, FullyQualified},
+	{"🎞", "film frames", "Objects", "light & video", E0_7, Unqualified},
+	{"🎞️", "film frames", "Objects", "light & video", E0_7, FullyQualified},
+	{"🎟", "admission tickets", "Activities", "event", E0_7, Unqualified},
+	{"🎟️", "admission tickets", "Activities", "event", E0_7, FullyQualified},
+	{"🎠", "carousel horse", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🎡", "ferris wheel", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🎢", "roller coaster", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🎣", "fishing pole", "Activities", "sport", E0_6, FullyQualified},
+	{"🎤", "microphone", "Objects", "music", E0_6, FullyQualified},
+	{"🎥", "movie camera", "Objects", "light & video", E0_6, FullyQualified},
+	{"🎦", "cinema", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"🎧", "headphone", "Objects", "music", E0_6, FullyQualified},
+	{"🎨", "artist palette", "Activities", "arts & crafts", E0_6, FullyQualified},
+	{"🎩", "top hat", "Objects", "clothing", E0_6, FullyQualified},
+	{"🎪", "circus tent", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"🎫", "ticket", "Activities", "event", E0_6, FullyQualified},
+	{"🎬", "clapper board", "Objects", "light & video", E0_6, FullyQualified},
+	{"🎭", "performing arts", "Activities", "arts & crafts", E0_6, FullyQualified},
+	{"🎮", "video game", "Activities", "game", E0_6, FullyQualified},
+	{"🎯", "bullseye", "Activities", "game", E0_6, FullyQualified},
+	{"🎰", "slot machine", "Activities", "game", E0_6, FullyQualified},
+	{"🎱", "pool 8 ball", "Activities", "game", E0_6, FullyQualified},
+	{"🎲", "game die", "Activities", "game", E0_6, FullyQualified},
+	{"🎳", "bowling", "Activities", "sport", E0_6, FullyQualified},
+	{"🎴", "flower playing cards", "Activities", "game", E0_6, FullyQualified},
+	{"🎵", "musical note", "Objects", "music", E0_6, FullyQualified},
+	{"🎶", "musical notes", "Objects", "music", E0_6, FullyQualified},
+	{"🎷", "saxophone", "Objects", "musical-instrument", E0_6, FullyQualified},
+	{"🎸", "guitar", "Objects", "musical-instrument", E0_6, FullyQualified},
+	{"🎹", "musical keyboard", "Objects", "musical-instrument", E0_6, FullyQualified},
+	{"🎺", "trumpet", "Objects", "musical-instrument", E0_6, FullyQualified},
+	{"🎻", "violin", "Objects", "musical-instrument", E0_6, FullyQualified},
+	{"🎼", "musical score", "Objects", "music", E0_6, FullyQualified},
+	{"🎽", "running shirt", "Activities", "sport", E0_6, FullyQualified},
+	{"🎾", "tennis", "Activities", "sport", E0_6, FullyQualified},
+	{"🎿", "skis", "Activities", "sport", E0_6, FullyQualified},
+	{"🏀", "basketball", "Activities", "sport", E0_6, FullyQualified},
+	{"🏁", "chequered flag", "Flags", "flag", E0_6, FullyQualified},
+	{"🏂", "snowboarder", "People & Body", "person-sport", E0_6, FullyQualified},
+	{"🏂🏻", "snowboarder: light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏂🏼", "snowboarder: medium-light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏂🏽", "snowboarder: medium skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏂🏾", "snowboarder: medium-dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏂🏿", "snowboarder: dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏃", "person running", "People & Body", "person-activity", E0_6, FullyQualified},
+	{"🏃\u200d♀", "woman running", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃\u200d♀\u200d➡", "woman running facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃\u200d♀\u200d➡️", "woman running facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃\u200d♀️", "woman running", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃\u200d♀️\u200d➡", "woman running facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃\u200d♀️\u200d➡️", "woman running facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃\u200d♂", "man running", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃\u200d♂\u200d➡", "man running facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃\u200d♂\u200d➡️", "man running facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃\u200d♂️", "man running", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃\u200d♂️\u200d➡", "man running facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃\u200d♂️\u200d➡️", "man running facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃\u200d➡", "person running facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃\u200d➡️", "person running facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏻", "person running: light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🏃🏻\u200d♀", "woman running: light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏻\u200d♀\u200d➡", "woman running facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏻\u200d♀\u200d➡️", "woman running facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏻\u200d♀️", "woman running: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏻\u200d♀️\u200d➡", "woman running facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏻\u200d♀️\u200d➡️", "woman running facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏻\u200d♂", "man running: light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏻\u200d♂\u200d➡", "man running facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏻\u200d♂\u200d➡️", "man running facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏻\u200d♂️", "man running: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏻\u200d♂️\u200d➡", "man running facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏻\u200d♂️\u200d➡️", "man running facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏻\u200d➡", "person running facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏻\u200d➡️", "person running facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏼", "person running: medium-light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🏃🏼\u200d♀", "woman running: medium-light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏼\u200d♀\u200d➡", "woman running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏼\u200d♀\u200d➡️", "woman running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏼\u200d♀️", "woman running: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏼\u200d♀️\u200d➡", "woman running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏼\u200d♀️\u200d➡️", "woman running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏼\u200d♂", "man running: medium-light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏼\u200d♂\u200d➡", "man running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏼\u200d♂\u200d➡️", "man running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏼\u200d♂️", "man running: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏼\u200d♂️\u200d➡", "man running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏼\u200d♂️\u200d➡️", "man running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏼\u200d➡", "person running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏼\u200d➡️", "person running facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏽", "person running: medium skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🏃🏽\u200d♀", "woman running: medium skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏽\u200d♀\u200d➡", "woman running facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏽\u200d♀\u200d➡️", "woman running facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏽\u200d♀️", "woman running: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏽\u200d♀️\u200d➡", "woman running facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏽\u200d♀️\u200d➡️", "woman running facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏽\u200d♂", "man running: medium skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏽\u200d♂\u200d➡", "man running facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏽\u200d♂\u200d➡️", "man running facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏽\u200d♂️", "man running: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏽\u200d♂️\u200d➡", "man running facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏽\u200d♂️\u200d➡️", "man running facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏽\u200d➡", "person running facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏽\u200d➡️", "person running facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏾", "person running: medium-dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🏃🏾\u200d♀", "woman running: medium-dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏾\u200d♀\u200d➡", "woman running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏾\u200d♀\u200d➡️", "woman running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏾\u200d♀️", "woman running: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏾\u200d♀️\u200d➡", "woman running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏾\u200d♀️\u200d➡️", "woman running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏾\u200d♂", "man running: medium-dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏾\u200d♂\u200d➡", "man running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏾\u200d♂\u200d➡️", "man running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏾\u200d♂️", "man running: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏾\u200d♂️\u200d➡", "man running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏾\u200d♂️\u200d➡️", "man running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏾\u200d➡", "person running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏾\u200d➡️", "person running facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏿", "person running: dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🏃🏿\u200d♀", "woman running: dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏿\u200d♀\u200d➡", "woman running facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏿\u200d♀\u200d➡️", "woman running facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏿\u200d♀️", "woman running: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏿\u200d♀️\u200d➡", "woman running facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏿\u200d♀️\u200d➡️", "woman running facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏿\u200d♂", "man running: dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🏃🏿\u200d♂\u200d➡", "man running facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏿\u200d♂\u200d➡️", "man running facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏿\u200d♂️", "man running: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🏃🏿\u200d♂️\u200d➡", "man running facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏿\u200d♂️\u200d➡️", "man running facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏃🏿\u200d➡", "person running facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🏃🏿\u200d➡️", "person running facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🏄", "person surfing", "People & Body", "person-sport", E0_6, FullyQualified},
+	{"🏄\u200d♀", "woman surfing", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄\u200d♀️", "woman surfing", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄\u200d♂", "man surfing", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄\u200d♂️", "man surfing", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏻", "person surfing: light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏄🏻\u200d♀", "woman surfing: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏻\u200d♀️", "woman surfing: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏻\u200d♂", "man surfing: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏻\u200d♂️", "man surfing: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏼", "person surfing: medium-light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏄🏼\u200d♀", "woman surfing: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏼\u200d♀️", "woman surfing: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏼\u200d♂", "man surfing: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏼\u200d♂️", "man surfing: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏽", "person surfing: medium skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏄🏽\u200d♀", "woman surfing: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏽\u200d♀️", "woman surfing: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏽\u200d♂", "man surfing: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏽\u200d♂️", "man surfing: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏾", "person surfing: medium-dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏄🏾\u200d♀", "woman surfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏾\u200d♀️", "woman surfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏾\u200d♂", "man surfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏾\u200d♂️", "man surfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏿", "person surfing: dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏄🏿\u200d♀", "woman surfing: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏿\u200d♀️", "woman surfing: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏄🏿\u200d♂", "man surfing: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏄🏿\u200d♂️", "man surfing: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏅", "sports medal", "Activities", "award-medal", E1_0, FullyQualified},
+	{"🏆", "trophy", "Activities", "award-medal", E0_6, FullyQualified},
+	{"🏇", "horse racing", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏇🏻", "horse racing: light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏇🏼", "horse racing: medium-light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏇🏽", "horse racing: medium skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏇🏾", "horse racing: medium-dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏇🏿", "horse racing: dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏈", "american football", "Activities", "sport", E0_6, FullyQualified},
+	{"🏉", "rugby football", "Activities", "sport", E1_0, FullyQualified},
+	{"🏊", "person swimming", "People & Body", "person-sport", E0_6, FullyQualified},
+	{"🏊\u200d♀", "woman swimming", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊\u200d♀️", "woman swimming", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊\u200d♂", "man swimming", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊\u200d♂️", "man swimming", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏻", "person swimming: light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏊🏻\u200d♀", "woman swimming: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏻\u200d♀️", "woman swimming: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏻\u200d♂", "man swimming: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏻\u200d♂️", "man swimming: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏼", "person swimming: medium-light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏊🏼\u200d♀", "woman swimming: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏼\u200d♀️", "woman swimming: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏼\u200d♂", "man swimming: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏼\u200d♂️", "man swimming: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏽", "person swimming: medium skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏊🏽\u200d♀", "woman swimming: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏽\u200d♀️", "woman swimming: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏽\u200d♂", "man swimming: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏽\u200d♂️", "man swimming: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏾", "person swimming: medium-dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏊🏾\u200d♀", "woman swimming: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏾\u200d♀️", "woman swimming: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏾\u200d♂", "man swimming: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏾\u200d♂️", "man swimming: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏿", "person swimming: dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🏊🏿\u200d♀", "woman swimming: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏿\u200d♀️", "woman swimming: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏊🏿\u200d♂", "man swimming: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏊🏿\u200d♂️", "man swimming: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋", "person lifting weights", "People & Body", "person-sport", E0_7, Unqualified},
+	{"🏋\u200d♀", "woman lifting weights", "People & Body", "person-sport", E4_0, Unqualified},
+	{"🏋\u200d♀️", "woman lifting weights", "People & Body", "person-sport", E4_0, Unqualified},
+	{"🏋\u200d♂", "man lifting weights", "People & Body", "person-sport", E4_0, Unqualified},
+	{"🏋\u200d♂️", "man lifting weights", "People & Body", "person-sport", E4_0, Unqualified},
+	{"🏋️", "person lifting weights", "People & Body", "person-sport", E0_7, FullyQualified},
+	{"🏋️\u200d♀", "woman lifting weights", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋️\u200d♀️", "woman lifting weights", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋️\u200d♂", "man lifting weights", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋️\u200d♂️", "man lifting weights", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏻", "person lifting weights: light skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"🏋🏻\u200d♀", "woman lifting weights: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏻\u200d♀️", "woman lifting weights: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏻\u200d♂", "man lifting weights: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏻\u200d♂️", "man lifting weights: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏼", "person lifting weights: medium-light skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"🏋🏼\u200d♀", "woman lifting weights: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏼\u200d♀️", "woman lifting weights: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏼\u200d♂", "man lifting weights: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏼\u200d♂️", "man lifting weights: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏽", "person lifting weights: medium skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"🏋🏽\u200d♀", "woman lifting weights: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏽\u200d♀️", "woman lifting weights: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏽\u200d♂", "man lifting weights: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏽\u200d♂️", "man lifting weights: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏾", "person lifting weights: medium-dark skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"🏋🏾\u200d♀", "woman lifting weights: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏾\u200d♀️", "woman lifting weights: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏾\u200d♂", "man lifting weights: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏾\u200d♂️", "man lifting weights: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏿", "person lifting weights: dark skin tone", "People & Body", "person-sport", E2_0, FullyQualified},
+	{"🏋🏿\u200d♀", "woman lifting weights: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏿\u200d♀️", "woman lifting weights: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏋🏿\u200d♂", "man lifting weights: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏋🏿\u200d♂️", "man lifting weights: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌", "person golfing", "People & Body", "person-sport", E0_7, Unqualified},
+	{"🏌\u200d♀", "woman golfing", "People & Body", "person-sport", E4_0, Unqualified},
+	{"🏌\u200d♀️", "woman golfing", "People & Body", "person-sport", E4_0, Unqualified},
+	{"🏌\u200d♂", "man golfing", "People & Body", "person-sport", E4_0, Unqualified},
+	{"🏌\u200d♂️", "man golfing", "People & Body", "person-sport", E4_0, Unqualified},
+	{"🏌️", "person golfing", "People & Body", "person-sport", E0_7, FullyQualified},
+	{"🏌️\u200d♀", "woman golfing", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌️\u200d♀️", "woman golfing", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌️\u200d♂", "man golfing", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌️\u200d♂️", "man golfing", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏻", "person golfing: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏻\u200d♀", "woman golfing: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏻\u200d♀️", "woman golfing: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏻\u200d♂", "man golfing: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏻\u200d♂️", "man golfing: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏼", "person golfing: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏼\u200d♀", "woman golfing: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏼\u200d♀️", "woman golfing: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏼\u200d♂", "man golfing: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏼\u200d♂️", "man golfing: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏽", "person golfing: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏽\u200d♀", "woman golfing: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏽\u200d♀️", "woman golfing: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏽\u200d♂", "man golfing: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏽\u200d♂️", "man golfing: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏾", "person golfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏾\u200d♀", "woman golfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏾\u200d♀️", "woman golfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏾\u200d♂", "man golfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏾\u200d♂️", "man golfing: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏿", "person golfing: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏿\u200d♀", "woman golfing: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏿\u200d♀️", "woman golfing: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏌🏿\u200d♂", "man golfing: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🏌🏿\u200d♂️", "man golfing: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🏍", "motorcycle", "Travel & Places", "transport-ground", E0_7, Unqualified},
+	{"🏍️", "motorcycle", "Travel & Places", "transport-ground", E0_7, FullyQualified},
+	{"🏎", "racing car", "Travel & Places", "transport-ground", E0_7, Unqualified},
+	{"🏎️", "racing car", "Travel & Places", "transport-ground", E0_7, FullyQualified},
+	{"🏏", "cricket game", "Activities", "sport", E1_0, FullyQualified},
+	{"🏐", "volleyball", "Activities", "sport", E1_0, FullyQualified},
+	{"🏑", "field hockey", "Activities", "sport", E1_0, FullyQualified},
+	{"🏒", "ice hockey", "Activities", "sport", E1_0, FullyQualified},
+	{"🏓", "ping pong", "Activities", "sport", E1_0, FullyQualified},
+	{"🏔", "snow-capped mountain", "Travel & Places", "place-geographic", E0_7, Unqualified},
+	{"🏔️", "snow-capped mountain", "Travel & Places", "place-geographic", E0_7, FullyQualified},
+	{"🏕", "camping", "Travel & Places", "place-geographic", E0_7, Unqualified},
+	{"🏕️", "camping", "Travel & Places", "place-geographic", E0_7, FullyQualified},
+	{"🏖", "beach with umbrella", "Travel & Places", "place-geographic", E0_7, Unqualified},
+	{"🏖️", "beach with umbrella", "Travel & Places", "place-geographic", E0_7, FullyQualified},
+	{"🏗", "building construction", "Travel & Places", "place-building", E0_7, Unqualified},
+	{"🏗️", "building construction", "Travel & Places", "place-building", E0_7, FullyQualified},
+	{"🏘", "houses", "Travel & Places", "place-building", E0_7, Unqualified},
+	{"🏘️", "houses", "Travel & Places", "place-building", E0_7, FullyQualified},
+	{"🏙", "cityscape", "Travel & Places", "place-other", E0_7, Unqualified},
+	{"🏙️", "cityscape", "Travel & Places", "place-other", E0_7, FullyQualified},
+	{"🏚", "derelict house", "Travel & Places", "place-building", E0_7, Unqualified},
+	{"🏚️", "derelict house", "Travel & Places", "place-building", E0_7, FullyQualified},
+	{"🏛", "classical building", "Travel & Places", "place-building", E0_7, Unqualified},
+	{"🏛️", "classical building", "Travel & Places", "place-building", E0_7, FullyQualified},
+	{"🏜", "desert", "Travel & Places", "place-geographic", E0_7, Unqualified},
+	{"🏜️", "desert", "Travel & Places", "place-geographic", E0_7, FullyQualified},
+	{"🏝", "desert island", "Travel & Places", "place-geographic", E0_7, Unqualified},
+	{"🏝️", "desert island", "Travel & Places", "place-geographic", E0_7, FullyQualified},
+	{"🏞", "national park", "Travel & Places", "place-geographic", E0_7, Unqualified},
+	{"🏞️", "national park", "Travel & Places", "place-geographic", E0_7, FullyQualified},
+	{"🏟", "stadium", "Travel & Places", "place-building", E0_7, Unqualified},
+	{"🏟️", "stadium", "Travel & Places", "place-building", E0_7, FullyQualified},
+	{"🏠", "house", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏡", "house with garden", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏢", "office building", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏣", "Japanese post office", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏤", "post office", "Travel & Places", "place-building", E1_0, FullyQualified},
+	{"🏥", "hospital", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏦", "bank", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏧", "ATM sign", "Symbols", "transport-sign", E0_6, FullyQualified},
+	{"🏨", "hotel", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏩", "love hotel", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏪", "convenience store", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏫", "school", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏬", "department store", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏭", "factory", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏮", "red paper lantern", "Objects", "light & video", E0_6, FullyQualified},
+	{"🏯", "Japanese castle", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏰", "castle", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🏳", "white flag", "Flags", "flag", E0_7, Unqualified},
+	{"🏳\u200d⚧", "transgender flag", "Flags", "flag", E13_0, Unqualified},
+	{"🏳\u200d⚧️", "transgender flag", "Flags", "flag", E13_0, Unqualified},
+	{"🏳\u200d🌈", "rainbow flag", "Flags", "flag", E4_0, Unqualified},
+	{"🏳️", "white flag", "Flags", "flag", E0_7, FullyQualified},
+	{"🏳️\u200d⚧", "transgender flag", "Flags", "flag", E13_0, MinimallyQualified},
+	{"🏳️\u200d⚧️", "transgender flag", "Flags", "flag", E13_0, FullyQualified},
+	{"🏳️\u200d🌈", "rainbow flag", "Flags", "flag", E4_0, FullyQualified},
+	{"🏴", "black flag", "Flags", "flag", E1_0, FullyQualified},
+	{"🏴\u200d☠", "pirate flag", "Flags", "flag", E11_0, MinimallyQualified},
+	{"🏴\u200d☠️", "pirate flag", "Flags", "flag", E11_0, FullyQualified},
+	{"🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", "flag: England", "Flags", "subdivision-flag", E5_0, FullyQualified},
+	{"🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f", "flag: Scotland", "Flags", "subdivision-flag", E5_0, FullyQualified},
+	{"🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f", "flag: Wales", "Flags", "subdivision-flag", E5_0, FullyQualified},
+	{"🏵", "rosette", "Animals & Nature", "plant-flower", E0_7, Unqualified},
+	{"🏵️", "rosette", "Animals & Nature", "plant-flower", E0_7, FullyQualified},
+	{"🏷", "label", "Objects", "book-paper", E0_7, Unqualified},
+	{"🏷️", "label", "Objects", "book-paper", E0_7, FullyQualified},
+	{"🏸", "badminton", "Activities", "sport", E1_0, FullyQualified},
+	{"🏹", "bow and arrow", "Objects", "tool", E1_0, FullyQualified},
+	{"🏺", "amphora", "Food & Drink", "dishware", E1_0, FullyQualified},
+	{"🏻", "light skin tone", "Component", "skin-tone", E1_0, Component},
+	{"🏼", "medium-light skin tone", "Component", "skin-tone", E1_0, Component},
+	{"🏽", "medium skin tone", "Component", "skin-tone", E1_0, Component},
+	{"🏾", "medium-dark skin tone", "Component", "skin-tone", E1_0, Component},
+	{"🏿", "dark skin tone", "Component", "skin-tone", E1_0, Component},
+	{"🐀", "rat", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐁", "mouse", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐂", "ox", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐃", "water buffalo", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐄", "cow", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐅", "tiger", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐆", "leopard", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐇", "rabbit", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐈", "cat", "Animals & Nature", "animal-mammal", E0_7, FullyQualified},
+	{"🐈\u200d⬛", "black cat", "Animals & Nature", "animal-mammal", E13_0, FullyQualified},
+	{"🐉", "dragon", "Animals & Nature", "animal-reptile", E1_0, FullyQualified},
+	{"🐊", "crocodile", "Animals & Nature", "animal-reptile", E1_0, FullyQualified},
+	{"🐋", "whale", "Animals & Nature", "animal-marine", E1_0, FullyQualified},
+	{"🐌", "snail", "Animals & Nature", "animal-bug", E0_6, FullyQualified},
+	{"🐍", "snake", "Animals & Nature", "animal-reptile", E0_6, FullyQualified},
+	{"🐎", "horse", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐏", "ram", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐐", "goat", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐑", "ewe", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐒", "monkey", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐓", "rooster", "Animals & Nature", "animal-bird", E1_0, FullyQualified},
+	{"🐔", "chicken", "Animals & Nature", "animal-bird", E0_6, FullyQualified},
+	{"🐕", "dog", "Animals & Nature", "animal-mammal", E0_7, FullyQualified},
+	{"🐕\u200d🦺", "service dog", "Animals & Nature", "animal-mammal", E12_0, FullyQualified},
+	{"🐖", "pig", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐗", "boar", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐘", "elephant", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐙", "octopus", "Animals & Nature", "animal-marine", E0_6, FullyQualified},
+	{"🐚", "spiral shell", "Animals & Nature", "animal-marine", E0_6, FullyQualified},
+	{"🐛", "bug", "Animals & Nature", "animal-bug", E0_6, FullyQualified},
+	{"🐜", "ant", "Animals & Nature", "animal-bug", E0_6, FullyQualified},
+	{"🐝", "honeybee", "Animals & Nature", "animal-bug", E0_6, FullyQualified},
+	{"🐞", "lady beetle", "Animals & Nature", "animal-bug", E0_6, FullyQualified},
+	{"🐟", "fish", "Animals & Nature", "animal-marine", E0_6, FullyQualified},
+	{"🐠", "tropical fish", "Animals & Nature", "animal-marine", E0_6, FullyQualified},
+	{"🐡", "blowfish", "Animals & Nature", "animal-marine", E0_6, FullyQualified},
+	{"🐢", "turtle", "Animals & Nature", "animal-reptile", E0_6, FullyQualified},
+	{"🐣", "hatching chick", "Animals & Nature", "animal-bird", E0_6, FullyQualified},
+	{"🐤", "baby chick", "Animals & Nature", "animal-bird", E0_6, FullyQualified},
+	{"🐥", "front-facing baby chick", "Animals & Nature", "animal-bird", E0_6, FullyQualified},
+	{"🐦", "bird", "Animals & Nature", "animal-bird", E0_6, FullyQualified},
+	{"🐦\u200d⬛", "black bird", "Animals & Nature", "animal-bird", E15_0, FullyQualified},
+	{"🐦\u200d🔥", "phoenix", "Animals & Nature", "animal-bird", E15_1, FullyQualified},
+	{"🐧", "penguin", "Animals & Nature", "animal-bird", E0_6, FullyQualified},
+	{"🐨", "koala", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐩", "poodle", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐪", "camel", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🐫", "two-hump camel", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐬", "dolphin", "Animals & Nature", "animal-marine", E0_6, FullyQualified},
+	{"🐭", "mouse face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐮", "cow face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐯", "tiger face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐰", "rabbit face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐱", "cat face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐲", "dragon face", "Animals & Nature", "animal-reptile", E0_6, FullyQualified},
+	{"🐳", "spouting whale", "Animals & Nature", "animal-marine", E0_6, FullyQualified},
+	{"🐴", "horse face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐵", "monkey face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐶", "dog face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐷", "pig face", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐸", "frog", "Animals & Nature", "animal-amphibian", E0_6, FullyQualified},
+	{"🐹", "hamster", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐺", "wolf", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐻", "bear", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐻\u200d❄", "polar bear", "Animals & Nature", "animal-mammal", E13_0, MinimallyQualified},
+	{"🐻\u200d❄️", "polar bear", "Animals & Nature", "animal-mammal", E13_0, FullyQualified},
+	{"🐼", "panda", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐽", "pig nose", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐾", "paw prints", "Animals & Nature", "animal-mammal", E0_6, FullyQualified},
+	{"🐿", "chipmunk", "Animals & Nature", "animal-mammal", E0_7, Unqualified},
+	{"🐿️", "chipmunk", "Animals & Nature", "animal-mammal", E0_7, FullyQualified},
+	{"👀", "eyes", "People & Body", "body-parts", E0_6, FullyQualified},
+	{"👁", "eye", "People & Body", "body-parts", E0_7, Unqualified},
+	{"👁\u200d🗨", "eye in speech bubble", "Smileys & Emotion", "emotion", E2_0, Unqualified},
+	{"👁\u200d🗨️", "eye in speech bubble", "Smileys & Emotion", "emotion", E2_0, Unqualified},
+	{"👁️", "eye", "People & Body", "body-parts", E0_7, FullyQualified},
+	{"👁️\u200d🗨", "eye in speech bubble", "Smileys & Emotion", "emotion", E2_0, MinimallyQualified},
+	{"👁️\u200d🗨️", "eye in speech bubble", "Smileys & Emotion", "emotion", E2_0, FullyQualified},
+	{"👂", "ear", "People & Body", "body-parts", E0_6, FullyQualified},
+	{"👂🏻", "ear: light skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👂🏼", "ear: medium-light skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👂🏽", "ear: medium skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👂🏾", "ear: medium-dark skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👂🏿", "ear: dark skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👃", "nose", "People & Body", "body-parts", E0_6, FullyQualified},
+	{"👃🏻", "nose: light skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👃🏼", "nose: medium-light skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👃🏽", "nose: medium skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👃🏾", "nose: medium-dark skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👃🏿", "nose: dark skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"👄", "mouth", "People & Body", "body-parts", E0_6, FullyQualified},
+	{"👅", "tongue", "People & Body", "body-parts", E0_6, FullyQualified},
+	{"👆", "backhand index pointing up", "People & Body", "hand-single-finger", E0_6, FullyQualified},
+	{"👆🏻", "backhand index pointing up: light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👆🏼", "backhand index pointing up: medium-light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👆🏽", "backhand index pointing up: medium skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👆🏾", "backhand index pointing up: medium-dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👆🏿", "backhand index pointing up: dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👇", "backhand index pointing down", "People & Body", "hand-single-finger", E0_6, FullyQualified},
+	{"👇🏻", "backhand index pointing down: light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👇🏼", "backhand index pointing down: medium-light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👇🏽", "backhand index pointing down: medium skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👇🏾", "backhand index pointing down: medium-dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👇🏿", "backhand index pointing down: dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👈", "backhand index pointing left", "People & Body", "hand-single-finger", E0_6, FullyQualified},
+	{"👈🏻", "backhand index pointing left: light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👈🏼", "backhand index pointing left: medium-light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👈🏽", "backhand index pointing left: medium skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👈🏾", "backhand index pointing left: medium-dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👈🏿", "backhand index pointing left: dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👉", "backhand index pointing right", "People & Body", "hand-single-finger", E0_6, FullyQualified},
+	{"👉🏻", "backhand index pointing right: light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👉🏼", "backhand index pointing right: medium-light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👉🏽", "backhand index pointing right: medium skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👉🏾", "backhand index pointing right: medium-dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👉🏿", "backhand index pointing right: dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"👊", "oncoming fist", "People & Body", "hand-fingers-closed", E0_6, FullyQualified},
+	{"👊🏻", "oncoming fist: light skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👊🏼", "oncoming fist: medium-light skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👊🏽", "oncoming fist: medium skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👊🏾", "oncoming fist: medium-dark skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👊🏿", "oncoming fist: dark skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👋", "waving hand", "People & Body", "hand-fingers-open", E0_6, FullyQualified},
+	{"👋🏻", "waving hand: light skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"👋🏼", "waving hand: medium-light skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"👋🏽", "waving hand: medium skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"👋🏾", "waving hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"👋🏿", "waving hand: dark skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"👌", "OK hand", "People & Body", "hand-fingers-partial", E0_6, FullyQualified},
+	{"👌🏻", "OK hand: light skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"👌🏼", "OK hand: medium-light skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"👌🏽", "OK hand: medium skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"👌🏾", "OK hand: medium-dark skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"👌🏿", "OK hand: dark skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"👍", "thumbs up", "People & Body", "hand-fingers-closed", E0_6, FullyQualified},
+	{"👍🏻", "thumbs up: light skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👍🏼", "thumbs up: medium-light skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👍🏽", "thumbs up: medium skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👍🏾", "thumbs up: medium-dark skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👍🏿", "thumbs up: dark skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👎", "thumbs down", "People & Body", "hand-fingers-closed", E0_6, FullyQualified},
+	{"👎🏻", "thumbs down: light skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👎🏼", "thumbs down: medium-light skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👎🏽", "thumbs down: medium skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👎🏾", "thumbs down: medium-dark skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👎🏿", "thumbs down: dark skin tone", "People & Body", "hand-fingers-closed", E1_0, FullyQualified},
+	{"👏", "clapping hands", "People & Body", "hands", E0_6, FullyQualified},
+	{"👏🏻", "clapping hands: light skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👏🏼", "clapping hands: medium-light skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👏🏽", "clapping hands: medium skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👏🏾", "clapping hands: medium-dark skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👏🏿", "clapping hands: dark skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👐", "open hands", "People & Body", "hands", E0_6, FullyQualified},
+	{"👐🏻", "open hands: light skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👐🏼", "open hands: medium-light skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👐🏽", "open hands: medium skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👐🏾", "open hands: medium-dark skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👐🏿", "open hands: dark skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"👑", "crown", "Objects", "clothing", E0_6, FullyQualified},
+	{"👒", "woman’s hat", "Objects", "clothing", E0_6, FullyQualified},
+	{"👓", "glasses", "Objects", "clothing", E0_6, FullyQualified},
+	{"👔", "necktie", "Objects", "clothing", E0_6, FullyQualified},
+	{"👕", "t-shirt", "Objects", "clothing", E0_6, FullyQualified},
+	{"👖", "jeans", "Objects", "clothing", E0_6, FullyQualified},
+	{"👗", "dress", "Objects", "clothing", E0_6, FullyQualified},
+	{"👘", "kimono", "Objects", "clothing", E0_6, FullyQualified},
+	{"👙", "bikini", "Objects", "clothing", E0_6, FullyQualified},
+	{"👚", "woman’s clothes", "Objects", "clothing", E0_6, FullyQualified},
+	{"👛", "purse", "Objects", "clothing", E0_6, FullyQualified},
+	{"👜", "handbag", "Objects", "clothing", E0_6, FullyQualified},
+	{"👝", "clutch bag", "Objects", "clothing", E0_6, FullyQualified},
+	{"👞", "man’s shoe", "Objects", "clothing", E0_6, FullyQualified},
+	{"👟", "running shoe", "Objects", "clothing", E0_6, FullyQualified},
+	{"👠", "high-heeled shoe", "Objects", "clothing", E0_6, FullyQualified},
+	{"👡", "woman’s sandal", "Objects", "clothing", E0_6, FullyQualified},
+	{"👢", "woman’s boot", "Objects", "clothing", E0_6, FullyQualified},
+	{"👣", "footprints", "People & Body", "person-symbol", E0_6, FullyQualified},
+	{"👤", "bust in silhouette", "People & Body", "person-symbol", E0_6, FullyQualified},
+	{"👥", "busts in silhouette", "People & Body", "person-symbol", E1_0, FullyQualified},
+	{"👦", "boy", "People & Body", "person", E0_6, FullyQualified},
+	{"👦🏻", "boy: light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👦🏼", "boy: medium-light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👦🏽", "boy: medium skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👦🏾", "boy: medium-dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👦🏿", "boy: dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👧", "girl", "People & Body", "person", E0_6, FullyQualified},
+	{"👧🏻", "girl: light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👧🏼", "girl: medium-light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👧🏽", "girl: medium skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👧🏾", "girl: medium-dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👧🏿", "girl: dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👨", "man", "People & Body", "person", E0_6, FullyQualified},
+	{"👨\u200d⚕", "man health worker", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨\u200d⚕️", "man health worker", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d⚖", "man judge", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨\u200d⚖️", "man judge", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d✈", "man pilot", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨\u200d✈️", "man pilot", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d❤\u200d👨", "couple with heart: man, man", "People & Body", "family", E2_0, MinimallyQualified},
+	{"👨\u200d❤\u200d💋\u200d👨", "kiss: man, man", "People & Body", "family", E2_0, MinimallyQualified},
+	{"👨\u200d❤️\u200d👨", "couple with heart: man, man", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d❤️\u200d💋\u200d👨", "kiss: man, man", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d🌾", "man farmer", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🍳", "man cook", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🍼", "man feeding baby", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👨\u200d🎓", "man student", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🎤", "man singer", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🎨", "man artist", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🏫", "man teacher", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🏭", "man factory worker", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d👦", "family: man, boy", "People & Body", "family", E4_0, FullyQualified},
+	{"👨\u200d👦\u200d👦", "family: man, boy, boy", "People & Body", "family", E4_0, FullyQualified},
+	{"👨\u200d👧", "family: man, girl", "People & Body", "family", E4_0, FullyQualified},
+	{"👨\u200d👧\u200d👦", "family: man, girl, boy", "People & Body", "family", E4_0, FullyQualified},
+	{"👨\u200d👧\u200d👧", "family: man, girl, girl", "People & Body", "family", E4_0, FullyQualified},
+	{"👨\u200d👨\u200d👦", "family: man, man, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👨\u200d👦\u200d👦", "family: man, man, boy, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👨\u200d👧", "family: man, man, girl", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👨\u200d👧\u200d👦", "family: man, man, girl, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👨\u200d👧\u200d👧", "family: man, man, girl, girl", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👩\u200d👦", "family: man, woman, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👩\u200d👦\u200d👦", "family: man, woman, boy, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👩\u200d👧", "family: man, woman, girl", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👩\u200d👧\u200d👦", "family: man, woman, girl, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d👩\u200d👧\u200d👧", "family: man, woman, girl, girl", "People & Body", "family", E2_0, FullyQualified},
+	{"👨\u200d💻", "man technologist", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d💼", "man office worker", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🔧", "man mechanic", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🔬", "man scientist", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🚀", "man astronaut", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🚒", "man firefighter", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨\u200d🦯", "man with white cane", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨\u200d🦯\u200d➡", "man with white cane facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨\u200d🦯\u200d➡️", "man with white cane facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨\u200d🦰", "man: red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨\u200d🦱", "man: curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨\u200d🦲", "man: bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👨\u200d🦳", "man: white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨\u200d🦼", "man in motorized wheelchair", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨\u200d🦼\u200d➡", "man in motorized wheelchair facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨\u200d🦼\u200d➡️", "man in motorized wheelchair facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨\u200d🦽", "man in manual wheelchair", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨\u200d🦽\u200d➡", "man in manual wheelchair facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨\u200d🦽\u200d➡️", "man in manual wheelchair facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏻", "man: light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👨🏻\u200d⚕", "man health worker: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏻\u200d⚕️", "man health worker: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d⚖", "man judge: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏻\u200d⚖️", "man judge: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d✈", "man pilot: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏻\u200d✈️", "man pilot: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d❤\u200d👨🏻", "couple with heart: man, man, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d👨🏼", "couple with heart: man, man, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d👨🏽", "couple with heart: man, man, light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d👨🏾", "couple with heart: man, man, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d👨🏿", "couple with heart: man, man, light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏻", "kiss: man, man, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏼", "kiss: man, man, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏽", "kiss: man, man, light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏾", "kiss: man, man, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤\u200d💋\u200d👨🏿", "kiss: man, man, light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏻\u200d❤️\u200d👨🏻", "couple with heart: man, man, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d👨🏼", "couple with heart: man, man, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d👨🏽", "couple with heart: man, man, light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d👨🏾", "couple with heart: man, man, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d👨🏿", "couple with heart: man, man, light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏻\u200d🌾", "man farmer: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🍳", "man cook: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🍼", "man feeding baby: light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👨🏻\u200d🎓", "man student: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🎤", "man singer: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🎨", "man artist: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🏫", "man teacher: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🏭", "man factory worker: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🐰\u200d👨🏼", "men with bunny ears: light skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏻\u200d🐰\u200d👨🏽", "men with bunny ears: light skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏻\u200d🐰\u200d👨🏾", "men with bunny ears: light skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏻\u200d🐰\u200d👨🏿", "men with bunny ears: light skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏻\u200d💻", "man technologist: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d💼", "man office worker: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🔧", "man mechanic: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🔬", "man scientist: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🚀", "man astronaut: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🚒", "man firefighter: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏻\u200d🤝\u200d👨🏼", "men holding hands: light skin tone, medium-light skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏻\u200d🤝\u200d👨🏽", "men holding hands: light skin tone, medium skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏻\u200d🤝\u200d👨🏾", "men holding hands: light skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏻\u200d🤝\u200d👨🏿", "men holding hands: light skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏻\u200d🦯", "man with white cane: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏻\u200d🦯\u200d➡", "man with white cane facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏻\u200d🦯\u200d➡️", "man with white cane facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏻\u200d🦰", "man: light skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏻\u200d🦱", "man: light skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏻\u200d🦲", "man: light skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏻\u200d🦳", "man: light skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏻\u200d🦼", "man in motorized wheelchair: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏻\u200d🦼\u200d➡", "man in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏻\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏻\u200d🦽", "man in manual wheelchair: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏻\u200d🦽\u200d➡", "man in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏻\u200d🦽\u200d➡️", "man in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏻\u200d🫯\u200d👨🏼", "men wrestling: light skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏻\u200d🫯\u200d👨🏽", "men wrestling: light skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏻\u200d🫯\u200d👨🏾", "men wrestling: light skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏻\u200d🫯\u200d👨🏿", "men wrestling: light skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏼", "man: medium-light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👨🏼\u200d⚕", "man health worker: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏼\u200d⚕️", "man health worker: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d⚖", "man judge: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏼\u200d⚖️", "man judge: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d✈", "man pilot: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏼\u200d✈️", "man pilot: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d❤\u200d👨🏻", "couple with heart: man, man, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d👨🏼", "couple with heart: man, man, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d👨🏽", "couple with heart: man, man, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d👨🏾", "couple with heart: man, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d👨🏿", "couple with heart: man, man, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏻", "kiss: man, man, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏼", "kiss: man, man, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏽", "kiss: man, man, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏾", "kiss: man, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤\u200d💋\u200d👨🏿", "kiss: man, man, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏼\u200d❤️\u200d👨🏻", "couple with heart: man, man, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d👨🏼", "couple with heart: man, man, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d👨🏽", "couple with heart: man, man, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d👨🏾", "couple with heart: man, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d👨🏿", "couple with heart: man, man, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏼\u200d🌾", "man farmer: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🍳", "man cook: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🍼", "man feeding baby: medium-light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👨🏼\u200d🎓", "man student: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🎤", "man singer: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🎨", "man artist: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🏫", "man teacher: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🏭", "man factory worker: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🐰\u200d👨🏻", "men with bunny ears: medium-light skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏼\u200d🐰\u200d👨🏽", "men with bunny ears: medium-light skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏼\u200d🐰\u200d👨🏾", "men with bunny ears: medium-light skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏼\u200d🐰\u200d👨🏿", "men with bunny ears: medium-light skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏼\u200d💻", "man technologist: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d💼", "man office worker: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🔧", "man mechanic: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🔬", "man scientist: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🚀", "man astronaut: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🚒", "man firefighter: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏼\u200d🤝\u200d👨🏻", "men holding hands: medium-light skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏼\u200d🤝\u200d👨🏽", "men holding hands: medium-light skin tone, medium skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏼\u200d🤝\u200d👨🏾", "men holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏼\u200d🤝\u200d👨🏿", "men holding hands: medium-light skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏼\u200d🦯", "man with white cane: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏼\u200d🦯\u200d➡", "man with white cane facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏼\u200d🦯\u200d➡️", "man with white cane facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏼\u200d🦰", "man: medium-light skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏼\u200d🦱", "man: medium-light skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏼\u200d🦲", "man: medium-light skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏼\u200d🦳", "man: medium-light skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏼\u200d🦼", "man in motorized wheelchair: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏼\u200d🦼\u200d➡", "man in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏼\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏼\u200d🦽", "man in manual wheelchair: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏼\u200d🦽\u200d➡", "man in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏼\u200d🦽\u200d➡️", "man in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏼\u200d🫯\u200d👨🏻", "men wrestling: medium-light skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏼\u200d🫯\u200d👨🏽", "men wrestling: medium-light skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏼\u200d🫯\u200d👨🏾", "men wrestling: medium-light skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏼\u200d🫯\u200d👨🏿", "men wrestling: medium-light skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏽", "man: medium skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👨🏽\u200d⚕", "man health worker: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏽\u200d⚕️", "man health worker: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d⚖", "man judge: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏽\u200d⚖️", "man judge: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d✈", "man pilot: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏽\u200d✈️", "man pilot: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d❤\u200d👨🏻", "couple with heart: man, man, medium skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d👨🏼", "couple with heart: man, man, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d👨🏽", "couple with heart: man, man, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d👨🏾", "couple with heart: man, man, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d👨🏿", "couple with heart: man, man, medium skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏻", "kiss: man, man, medium skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏼", "kiss: man, man, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏽", "kiss: man, man, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏾", "kiss: man, man, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤\u200d💋\u200d👨🏿", "kiss: man, man, medium skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏽\u200d❤️\u200d👨🏻", "couple with heart: man, man, medium skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d👨🏼", "couple with heart: man, man, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d👨🏽", "couple with heart: man, man, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d👨🏾", "couple with heart: man, man, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d👨🏿", "couple with heart: man, man, medium skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, medium skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, medium skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏽\u200d🌾", "man farmer: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🍳", "man cook: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🍼", "man feeding baby: medium skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👨🏽\u200d🎓", "man student: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🎤", "man singer: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🎨", "man artist: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🏫", "man teacher: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🏭", "man factory worker: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🐰\u200d👨🏻", "men with bunny ears: medium skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏽\u200d🐰\u200d👨🏼", "men with bunny ears: medium skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏽\u200d🐰\u200d👨🏾", "men with bunny ears: medium skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏽\u200d🐰\u200d👨🏿", "men with bunny ears: medium skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏽\u200d💻", "man technologist: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d💼", "man office worker: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🔧", "man mechanic: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🔬", "man scientist: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🚀", "man astronaut: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🚒", "man firefighter: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏽\u200d🤝\u200d👨🏻", "men holding hands: medium skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏽\u200d🤝\u200d👨🏼", "men holding hands: medium skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏽\u200d🤝\u200d👨🏾", "men holding hands: medium skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏽\u200d🤝\u200d👨🏿", "men holding hands: medium skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏽\u200d🦯", "man with white cane: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏽\u200d🦯\u200d➡", "man with white cane facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏽\u200d🦯\u200d➡️", "man with white cane facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏽\u200d🦰", "man: medium skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏽\u200d🦱", "man: medium skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏽\u200d🦲", "man: medium skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏽\u200d🦳", "man: medium skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏽\u200d🦼", "man in motorized wheelchair: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏽\u200d🦼\u200d➡", "man in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏽\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏽\u200d🦽", "man in manual wheelchair: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏽\u200d🦽\u200d➡", "man in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏽\u200d🦽\u200d➡️", "man in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏽\u200d🫯\u200d👨🏻", "men wrestling: medium skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏽\u200d🫯\u200d👨🏼", "men wrestling: medium skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏽\u200d🫯\u200d👨🏾", "men wrestling: medium skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏽\u200d🫯\u200d👨🏿", "men wrestling: medium skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏾", "man: medium-dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👨🏾\u200d⚕", "man health worker: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏾\u200d⚕️", "man health worker: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d⚖", "man judge: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏾\u200d⚖️", "man judge: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d✈", "man pilot: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏾\u200d✈️", "man pilot: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d❤\u200d👨🏻", "couple with heart: man, man, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d👨🏼", "couple with heart: man, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d👨🏽", "couple with heart: man, man, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d👨🏾", "couple with heart: man, man, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d👨🏿", "couple with heart: man, man, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏻", "kiss: man, man, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏼", "kiss: man, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏽", "kiss: man, man, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏾", "kiss: man, man, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤\u200d💋\u200d👨🏿", "kiss: man, man, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏾\u200d❤️\u200d👨🏻", "couple with heart: man, man, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d👨🏼", "couple with heart: man, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d👨🏽", "couple with heart: man, man, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d👨🏾", "couple with heart: man, man, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d👨🏿", "couple with heart: man, man, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏾\u200d🌾", "man farmer: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🍳", "man cook: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🍼", "man feeding baby: medium-dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👨🏾\u200d🎓", "man student: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🎤", "man singer: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🎨", "man artist: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🏫", "man teacher: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🏭", "man factory worker: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🐰\u200d👨🏻", "men with bunny ears: medium-dark skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏾\u200d🐰\u200d👨🏼", "men with bunny ears: medium-dark skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏾\u200d🐰\u200d👨🏽", "men with bunny ears: medium-dark skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏾\u200d🐰\u200d👨🏿", "men with bunny ears: medium-dark skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏾\u200d💻", "man technologist: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d💼", "man office worker: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🔧", "man mechanic: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🔬", "man scientist: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🚀", "man astronaut: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🚒", "man firefighter: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏾\u200d🤝\u200d👨🏻", "men holding hands: medium-dark skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏾\u200d🤝\u200d👨🏼", "men holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏾\u200d🤝\u200d👨🏽", "men holding hands: medium-dark skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏾\u200d🤝\u200d👨🏿", "men holding hands: medium-dark skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👨🏾\u200d🦯", "man with white cane: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏾\u200d🦯\u200d➡", "man with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏾\u200d🦯\u200d➡️", "man with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏾\u200d🦰", "man: medium-dark skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏾\u200d🦱", "man: medium-dark skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏾\u200d🦲", "man: medium-dark skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏾\u200d🦳", "man: medium-dark skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏾\u200d🦼", "man in motorized wheelchair: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏾\u200d🦼\u200d➡", "man in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏾\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏾\u200d🦽", "man in manual wheelchair: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏾\u200d🦽\u200d➡", "man in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏾\u200d🦽\u200d➡️", "man in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏾\u200d🫯\u200d👨🏻", "men wrestling: medium-dark skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏾\u200d🫯\u200d👨🏼", "men wrestling: medium-dark skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏾\u200d🫯\u200d👨🏽", "men wrestling: medium-dark skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏾\u200d🫯\u200d👨🏿", "men wrestling: medium-dark skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏿", "man: dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👨🏿\u200d⚕", "man health worker: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏿\u200d⚕️", "man health worker: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d⚖", "man judge: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏿\u200d⚖️", "man judge: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d✈", "man pilot: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👨🏿\u200d✈️", "man pilot: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d❤\u200d👨🏻", "couple with heart: man, man, dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d👨🏼", "couple with heart: man, man, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d👨🏽", "couple with heart: man, man, dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d👨🏾", "couple with heart: man, man, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d👨🏿", "couple with heart: man, man, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏻", "kiss: man, man, dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏼", "kiss: man, man, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏽", "kiss: man, man, dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏾", "kiss: man, man, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤\u200d💋\u200d👨🏿", "kiss: man, man, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👨🏿\u200d❤️\u200d👨🏻", "couple with heart: man, man, dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d👨🏼", "couple with heart: man, man, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d👨🏽", "couple with heart: man, man, dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d👨🏾", "couple with heart: man, man, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d👨🏿", "couple with heart: man, man, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏻", "kiss: man, man, dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏼", "kiss: man, man, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏽", "kiss: man, man, dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏾", "kiss: man, man, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d❤️\u200d💋\u200d👨🏿", "kiss: man, man, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👨🏿\u200d🌾", "man farmer: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🍳", "man cook: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🍼", "man feeding baby: dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👨🏿\u200d🎓", "man student: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🎤", "man singer: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🎨", "man artist: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🏫", "man teacher: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🏭", "man factory worker: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🐰\u200d👨🏻", "men with bunny ears: dark skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏿\u200d🐰\u200d👨🏼", "men with bunny ears: dark skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏿\u200d🐰\u200d👨🏽", "men with bunny ears: dark skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏿\u200d🐰\u200d👨🏾", "men with bunny ears: dark skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👨🏿\u200d💻", "man technologist: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d💼", "man office worker: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🔧", "man mechanic: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🔬", "man scientist: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🚀", "man astronaut: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🚒", "man firefighter: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👨🏿\u200d🤝\u200d👨🏻", "men holding hands: dark skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏿\u200d🤝\u200d👨🏼", "men holding hands: dark skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏿\u200d🤝\u200d👨🏽", "men holding hands: dark skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏿\u200d🤝\u200d👨🏾", "men holding hands: dark skin tone, medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👨🏿\u200d🦯", "man with white cane: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏿\u200d🦯\u200d➡", "man with white cane facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏿\u200d🦯\u200d➡️", "man with white cane facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏿\u200d🦰", "man: dark skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏿\u200d🦱", "man: dark skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏿\u200d🦲", "man: dark skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏿\u200d🦳", "man: dark skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👨🏿\u200d🦼", "man in motorized wheelchair: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏿\u200d🦼\u200d➡", "man in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏿\u200d🦼\u200d➡️", "man in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏿\u200d🦽", "man in manual wheelchair: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👨🏿\u200d🦽\u200d➡", "man in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👨🏿\u200d🦽\u200d➡️", "man in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👨🏿\u200d🫯\u200d👨🏻", "men wrestling: dark skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏿\u200d🫯\u200d👨🏼", "men wrestling: dark skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏿\u200d🫯\u200d👨🏽", "men wrestling: dark skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👨🏿\u200d🫯\u200d👨🏾", "men wrestling: dark skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩", "woman", "People & Body", "person", E0_6, FullyQualified},
+	{"👩\u200d⚕", "woman health worker", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩\u200d⚕️", "woman health worker", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d⚖", "woman judge", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩\u200d⚖️", "woman judge", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d✈", "woman pilot", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩\u200d✈️", "woman pilot", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d❤\u200d👨", "couple with heart: woman, man", "People & Body", "family", E2_0, MinimallyQualified},
+	{"👩\u200d❤\u200d👩", "couple with heart: woman, woman", "People & Body", "family", E2_0, MinimallyQualified},
+	{"👩\u200d❤\u200d💋\u200d👨", "kiss: woman, man", "People & Body", "family", E2_0, MinimallyQualified},
+	{"👩\u200d❤\u200d💋\u200d👩", "kiss: woman, woman", "People & Body", "family", E2_0, MinimallyQualified},
+	{"👩\u200d❤️\u200d👨", "couple with heart: woman, man", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d❤️\u200d👩", "couple with heart: woman, woman", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d❤️\u200d💋\u200d👨", "kiss: woman, man", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d❤️\u200d💋\u200d👩", "kiss: woman, woman", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d🌾", "woman farmer", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🍳", "woman cook", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🍼", "woman feeding baby", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👩\u200d🎓", "woman student", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🎤", "woman singer", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🎨", "woman artist", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🏫", "woman teacher", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🏭", "woman factory worker", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d👦", "family: woman, boy", "People & Body", "family", E4_0, FullyQualified},
+	{"👩\u200d👦\u200d👦", "family: woman, boy, boy", "People & Body", "family", E4_0, FullyQualified},
+	{"👩\u200d👧", "family: woman, girl", "People & Body", "family", E4_0, FullyQualified},
+	{"👩\u200d👧\u200d👦", "family: woman, girl, boy", "People & Body", "family", E4_0, FullyQualified},
+	{"👩\u200d👧\u200d👧", "family: woman, girl, girl", "People & Body", "family", E4_0, FullyQualified},
+	{"👩\u200d👩\u200d👦", "family: woman, woman, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d👩\u200d👦\u200d👦", "family: woman, woman, boy, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d👩\u200d👧", "family: woman, woman, girl", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d👩\u200d👧\u200d👦", "family: woman, woman, girl, boy", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d👩\u200d👧\u200d👧", "family: woman, woman, girl, girl", "People & Body", "family", E2_0, FullyQualified},
+	{"👩\u200d💻", "woman technologist", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d💼", "woman office worker", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🔧", "woman mechanic", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🔬", "woman scientist", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🚀", "woman astronaut", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🚒", "woman firefighter", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩\u200d🦯", "woman with white cane", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩\u200d🦯\u200d➡", "woman with white cane facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩\u200d🦯\u200d➡️", "woman with white cane facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩\u200d🦰", "woman: red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩\u200d🦱", "woman: curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩\u200d🦲", "woman: bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👩\u200d🦳", "woman: white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩\u200d🦼", "woman in motorized wheelchair", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩\u200d🦼\u200d➡", "woman in motorized wheelchair facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩\u200d🦽", "woman in manual wheelchair", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩\u200d🦽\u200d➡", "woman in manual wheelchair facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩\u200d🦽\u200d➡️", "woman in manual wheelchair facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏻", "woman: light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👩🏻\u200d⚕", "woman health worker: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏻\u200d⚕️", "woman health worker: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d⚖", "woman judge: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏻\u200d⚖️", "woman judge: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d✈", "woman pilot: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏻\u200d✈️", "woman pilot: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d❤\u200d👨🏻", "couple with heart: woman, man, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👨🏼", "couple with heart: woman, man, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👨🏽", "couple with heart: woman, man, light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👨🏾", "couple with heart: woman, man, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👨🏿", "couple with heart: woman, man, light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👩🏻", "couple with heart: woman, woman, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👩🏼", "couple with heart: woman, woman, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👩🏽", "couple with heart: woman, woman, light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👩🏾", "couple with heart: woman, woman, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d👩🏿", "couple with heart: woman, woman, light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏻", "kiss: woman, man, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏼", "kiss: woman, man, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏽", "kiss: woman, man, light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏾", "kiss: woman, man, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👨🏿", "kiss: woman, man, light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏻", "kiss: woman, woman, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏼", "kiss: woman, woman, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏽", "kiss: woman, woman, light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏾", "kiss: woman, woman, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤\u200d💋\u200d👩🏿", "kiss: woman, woman, light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏻\u200d❤️\u200d👨🏻", "couple with heart: woman, man, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👨🏼", "couple with heart: woman, man, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👨🏽", "couple with heart: woman, man, light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👨🏾", "couple with heart: woman, man, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👨🏿", "couple with heart: woman, man, light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏻\u200d🌾", "woman farmer: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🍳", "woman cook: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🍼", "woman feeding baby: light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👩🏻\u200d🎓", "woman student: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🎤", "woman singer: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🎨", "woman artist: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🏫", "woman teacher: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🏭", "woman factory worker: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🐰\u200d👩🏼", "women with bunny ears: light skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏻\u200d🐰\u200d👩🏽", "women with bunny ears: light skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏻\u200d🐰\u200d👩🏾", "women with bunny ears: light skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏻\u200d🐰\u200d👩🏿", "women with bunny ears: light skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏻\u200d💻", "woman technologist: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d💼", "woman office worker: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🔧", "woman mechanic: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🔬", "woman scientist: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🚀", "woman astronaut: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🚒", "woman firefighter: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏻\u200d🤝\u200d👨🏼", "woman and man holding hands: light skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏻\u200d🤝\u200d👨🏽", "woman and man holding hands: light skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏻\u200d🤝\u200d👨🏾", "woman and man holding hands: light skin tone, medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏻\u200d🤝\u200d👨🏿", "woman and man holding hands: light skin tone, dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏻\u200d🤝\u200d👩🏼", "women holding hands: light skin tone, medium-light skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏻\u200d🤝\u200d👩🏽", "women holding hands: light skin tone, medium skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏻\u200d🤝\u200d👩🏾", "women holding hands: light skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏻\u200d🤝\u200d👩🏿", "women holding hands: light skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏻\u200d🦯", "woman with white cane: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏻\u200d🦯\u200d➡", "woman with white cane facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏻\u200d🦯\u200d➡️", "woman with white cane facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏻\u200d🦰", "woman: light skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏻\u200d🦱", "woman: light skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏻\u200d🦲", "woman: light skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏻\u200d🦳", "woman: light skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏻\u200d🦼", "woman in motorized wheelchair: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏻\u200d🦼\u200d➡", "woman in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏻\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏻\u200d🦽", "woman in manual wheelchair: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏻\u200d🦽\u200d➡", "woman in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏻\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏻\u200d🫯\u200d👩🏼", "women wrestling: light skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏻\u200d🫯\u200d👩🏽", "women wrestling: light skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏻\u200d🫯\u200d👩🏾", "women wrestling: light skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏻\u200d🫯\u200d👩🏿", "women wrestling: light skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏼", "woman: medium-light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👩🏼\u200d⚕", "woman health worker: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏼\u200d⚕️", "woman health worker: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d⚖", "woman judge: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏼\u200d⚖️", "woman judge: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d✈", "woman pilot: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏼\u200d✈️", "woman pilot: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d❤\u200d👨🏻", "couple with heart: woman, man, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👨🏼", "couple with heart: woman, man, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👨🏽", "couple with heart: woman, man, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👨🏾", "couple with heart: woman, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👨🏿", "couple with heart: woman, man, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👩🏻", "couple with heart: woman, woman, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👩🏼", "couple with heart: woman, woman, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👩🏽", "couple with heart: woman, woman, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👩🏾", "couple with heart: woman, woman, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d👩🏿", "couple with heart: woman, woman, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏻", "kiss: woman, man, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏼", "kiss: woman, man, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏽", "kiss: woman, man, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏾", "kiss: woman, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👨🏿", "kiss: woman, man, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏻", "kiss: woman, woman, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏼", "kiss: woman, woman, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏽", "kiss: woman, woman, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏾", "kiss: woman, woman, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤\u200d💋\u200d👩🏿", "kiss: woman, woman, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏼\u200d❤️\u200d👨🏻", "couple with heart: woman, man, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👨🏼", "couple with heart: woman, man, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👨🏽", "couple with heart: woman, man, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👨🏾", "couple with heart: woman, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👨🏿", "couple with heart: woman, man, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏼\u200d🌾", "woman farmer: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🍳", "woman cook: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🍼", "woman feeding baby: medium-light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👩🏼\u200d🎓", "woman student: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🎤", "woman singer: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🎨", "woman artist: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🏫", "woman teacher: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🏭", "woman factory worker: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🐰\u200d👩🏻", "women with bunny ears: medium-light skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏼\u200d🐰\u200d👩🏽", "women with bunny ears: medium-light skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏼\u200d🐰\u200d👩🏾", "women with bunny ears: medium-light skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏼\u200d🐰\u200d👩🏿", "women with bunny ears: medium-light skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏼\u200d💻", "woman technologist: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d💼", "woman office worker: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🔧", "woman mechanic: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🔬", "woman scientist: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🚀", "woman astronaut: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🚒", "woman firefighter: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏼\u200d🤝\u200d👨🏻", "woman and man holding hands: medium-light skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏼\u200d🤝\u200d👨🏽", "woman and man holding hands: medium-light skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏼\u200d🤝\u200d👨🏾", "woman and man holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏼\u200d🤝\u200d👨🏿", "woman and man holding hands: medium-light skin tone, dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏼\u200d🤝\u200d👩🏻", "women holding hands: medium-light skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏼\u200d🤝\u200d👩🏽", "women holding hands: medium-light skin tone, medium skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏼\u200d🤝\u200d👩🏾", "women holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏼\u200d🤝\u200d👩🏿", "women holding hands: medium-light skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏼\u200d🦯", "woman with white cane: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏼\u200d🦯\u200d➡", "woman with white cane facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏼\u200d🦯\u200d➡️", "woman with white cane facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏼\u200d🦰", "woman: medium-light skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏼\u200d🦱", "woman: medium-light skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏼\u200d🦲", "woman: medium-light skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏼\u200d🦳", "woman: medium-light skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏼\u200d🦼", "woman in motorized wheelchair: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏼\u200d🦼\u200d➡", "woman in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏼\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏼\u200d🦽", "woman in manual wheelchair: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏼\u200d🦽\u200d➡", "woman in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏼\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏼\u200d🫯\u200d👩🏻", "women wrestling: medium-light skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏼\u200d🫯\u200d👩🏽", "women wrestling: medium-light skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏼\u200d🫯\u200d👩🏾", "women wrestling: medium-light skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏼\u200d🫯\u200d👩🏿", "women wrestling: medium-light skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏽", "woman: medium skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👩🏽\u200d⚕", "woman health worker: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏽\u200d⚕️", "woman health worker: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d⚖", "woman judge: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏽\u200d⚖️", "woman judge: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d✈", "woman pilot: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏽\u200d✈️", "woman pilot: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d❤\u200d👨🏻", "couple with heart: woman, man, medium skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👨🏼", "couple with heart: woman, man, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👨🏽", "couple with heart: woman, man, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👨🏾", "couple with heart: woman, man, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👨🏿", "couple with heart: woman, man, medium skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👩🏻", "couple with heart: woman, woman, medium skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👩🏼", "couple with heart: woman, woman, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👩🏽", "couple with heart: woman, woman, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👩🏾", "couple with heart: woman, woman, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d👩🏿", "couple with heart: woman, woman, medium skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏻", "kiss: woman, man, medium skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏼", "kiss: woman, man, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏽", "kiss: woman, man, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏾", "kiss: woman, man, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👨🏿", "kiss: woman, man, medium skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏻", "kiss: woman, woman, medium skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏼", "kiss: woman, woman, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏽", "kiss: woman, woman, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏾", "kiss: woman, woman, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤\u200d💋\u200d👩🏿", "kiss: woman, woman, medium skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏽\u200d❤️\u200d👨🏻", "couple with heart: woman, man, medium skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👨🏼", "couple with heart: woman, man, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👨🏽", "couple with heart: woman, man, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👨🏾", "couple with heart: woman, man, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👨🏿", "couple with heart: woman, man, medium skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, medium skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, medium skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, medium skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, medium skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, medium skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, medium skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏽\u200d🌾", "woman farmer: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🍳", "woman cook: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🍼", "woman feeding baby: medium skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👩🏽\u200d🎓", "woman student: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🎤", "woman singer: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🎨", "woman artist: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🏫", "woman teacher: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🏭", "woman factory worker: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🐰\u200d👩🏻", "women with bunny ears: medium skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏽\u200d🐰\u200d👩🏼", "women with bunny ears: medium skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏽\u200d🐰\u200d👩🏾", "women with bunny ears: medium skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏽\u200d🐰\u200d👩🏿", "women with bunny ears: medium skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏽\u200d💻", "woman technologist: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d💼", "woman office worker: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🔧", "woman mechanic: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🔬", "woman scientist: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🚀", "woman astronaut: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🚒", "woman firefighter: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏽\u200d🤝\u200d👨🏻", "woman and man holding hands: medium skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏽\u200d🤝\u200d👨🏼", "woman and man holding hands: medium skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏽\u200d🤝\u200d👨🏾", "woman and man holding hands: medium skin tone, medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏽\u200d🤝\u200d👨🏿", "woman and man holding hands: medium skin tone, dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏽\u200d🤝\u200d👩🏻", "women holding hands: medium skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏽\u200d🤝\u200d👩🏼", "women holding hands: medium skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏽\u200d🤝\u200d👩🏾", "women holding hands: medium skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏽\u200d🤝\u200d👩🏿", "women holding hands: medium skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏽\u200d🦯", "woman with white cane: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏽\u200d🦯\u200d➡", "woman with white cane facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏽\u200d🦯\u200d➡️", "woman with white cane facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏽\u200d🦰", "woman: medium skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏽\u200d🦱", "woman: medium skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏽\u200d🦲", "woman: medium skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏽\u200d🦳", "woman: medium skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏽\u200d🦼", "woman in motorized wheelchair: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏽\u200d🦼\u200d➡", "woman in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏽\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏽\u200d🦽", "woman in manual wheelchair: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏽\u200d🦽\u200d➡", "woman in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏽\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏽\u200d🫯\u200d👩🏻", "women wrestling: medium skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏽\u200d🫯\u200d👩🏼", "women wrestling: medium skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏽\u200d🫯\u200d👩🏾", "women wrestling: medium skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏽\u200d🫯\u200d👩🏿", "women wrestling: medium skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏾", "woman: medium-dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👩🏾\u200d⚕", "woman health worker: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏾\u200d⚕️", "woman health worker: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d⚖", "woman judge: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏾\u200d⚖️", "woman judge: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d✈", "woman pilot: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏾\u200d✈️", "woman pilot: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d❤\u200d👨🏻", "couple with heart: woman, man, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👨🏼", "couple with heart: woman, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👨🏽", "couple with heart: woman, man, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👨🏾", "couple with heart: woman, man, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👨🏿", "couple with heart: woman, man, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👩🏻", "couple with heart: woman, woman, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👩🏼", "couple with heart: woman, woman, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👩🏽", "couple with heart: woman, woman, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👩🏾", "couple with heart: woman, woman, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d👩🏿", "couple with heart: woman, woman, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏻", "kiss: woman, man, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏼", "kiss: woman, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏽", "kiss: woman, man, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏾", "kiss: woman, man, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👨🏿", "kiss: woman, man, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏻", "kiss: woman, woman, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏼", "kiss: woman, woman, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏽", "kiss: woman, woman, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏾", "kiss: woman, woman, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤\u200d💋\u200d👩🏿", "kiss: woman, woman, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏾\u200d❤️\u200d👨🏻", "couple with heart: woman, man, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👨🏼", "couple with heart: woman, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👨🏽", "couple with heart: woman, man, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👨🏾", "couple with heart: woman, man, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👨🏿", "couple with heart: woman, man, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏾\u200d🌾", "woman farmer: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🍳", "woman cook: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🍼", "woman feeding baby: medium-dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👩🏾\u200d🎓", "woman student: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🎤", "woman singer: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🎨", "woman artist: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🏫", "woman teacher: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🏭", "woman factory worker: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🐰\u200d👩🏻", "women with bunny ears: medium-dark skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏾\u200d🐰\u200d👩🏼", "women with bunny ears: medium-dark skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏾\u200d🐰\u200d👩🏽", "women with bunny ears: medium-dark skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏾\u200d🐰\u200d👩🏿", "women with bunny ears: medium-dark skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏾\u200d💻", "woman technologist: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d💼", "woman office worker: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🔧", "woman mechanic: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🔬", "woman scientist: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🚀", "woman astronaut: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🚒", "woman firefighter: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏾\u200d🤝\u200d👨🏻", "woman and man holding hands: medium-dark skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏾\u200d🤝\u200d👨🏼", "woman and man holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏾\u200d🤝\u200d👨🏽", "woman and man holding hands: medium-dark skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏾\u200d🤝\u200d👨🏿", "woman and man holding hands: medium-dark skin tone, dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏾\u200d🤝\u200d👩🏻", "women holding hands: medium-dark skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏾\u200d🤝\u200d👩🏼", "women holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏾\u200d🤝\u200d👩🏽", "women holding hands: medium-dark skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏾\u200d🤝\u200d👩🏿", "women holding hands: medium-dark skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"👩🏾\u200d🦯", "woman with white cane: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏾\u200d🦯\u200d➡", "woman with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏾\u200d🦯\u200d➡️", "woman with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏾\u200d🦰", "woman: medium-dark skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏾\u200d🦱", "woman: medium-dark skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏾\u200d🦲", "woman: medium-dark skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏾\u200d🦳", "woman: medium-dark skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏾\u200d🦼", "woman in motorized wheelchair: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏾\u200d🦼\u200d➡", "woman in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏾\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏾\u200d🦽", "woman in manual wheelchair: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏾\u200d🦽\u200d➡", "woman in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏾\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏾\u200d🫯\u200d👩🏻", "women wrestling: medium-dark skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏾\u200d🫯\u200d👩🏼", "women wrestling: medium-dark skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏾\u200d🫯\u200d👩🏽", "women wrestling: medium-dark skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏾\u200d🫯\u200d👩🏿", "women wrestling: medium-dark skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏿", "woman: dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👩🏿\u200d⚕", "woman health worker: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏿\u200d⚕️", "woman health worker: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d⚖", "woman judge: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏿\u200d⚖️", "woman judge: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d✈", "woman pilot: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👩🏿\u200d✈️", "woman pilot: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d❤\u200d👨🏻", "couple with heart: woman, man, dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👨🏼", "couple with heart: woman, man, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👨🏽", "couple with heart: woman, man, dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👨🏾", "couple with heart: woman, man, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👨🏿", "couple with heart: woman, man, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👩🏻", "couple with heart: woman, woman, dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👩🏼", "couple with heart: woman, woman, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👩🏽", "couple with heart: woman, woman, dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👩🏾", "couple with heart: woman, woman, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d👩🏿", "couple with heart: woman, woman, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏻", "kiss: woman, man, dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏼", "kiss: woman, man, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏽", "kiss: woman, man, dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏾", "kiss: woman, man, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👨🏿", "kiss: woman, man, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏻", "kiss: woman, woman, dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏼", "kiss: woman, woman, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏽", "kiss: woman, woman, dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏾", "kiss: woman, woman, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤\u200d💋\u200d👩🏿", "kiss: woman, woman, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"👩🏿\u200d❤️\u200d👨🏻", "couple with heart: woman, man, dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👨🏼", "couple with heart: woman, man, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👨🏽", "couple with heart: woman, man, dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👨🏾", "couple with heart: woman, man, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👨🏿", "couple with heart: woman, man, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👩🏻", "couple with heart: woman, woman, dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👩🏼", "couple with heart: woman, woman, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👩🏽", "couple with heart: woman, woman, dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👩🏾", "couple with heart: woman, woman, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d👩🏿", "couple with heart: woman, woman, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏻", "kiss: woman, man, dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏼", "kiss: woman, man, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏽", "kiss: woman, man, dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏾", "kiss: woman, man, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👨🏿", "kiss: woman, man, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏻", "kiss: woman, woman, dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏼", "kiss: woman, woman, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏽", "kiss: woman, woman, dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏾", "kiss: woman, woman, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d❤️\u200d💋\u200d👩🏿", "kiss: woman, woman, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"👩🏿\u200d🌾", "woman farmer: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🍳", "woman cook: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🍼", "woman feeding baby: dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👩🏿\u200d🎓", "woman student: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🎤", "woman singer: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🎨", "woman artist: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🏫", "woman teacher: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🏭", "woman factory worker: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🐰\u200d👩🏻", "women with bunny ears: dark skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏿\u200d🐰\u200d👩🏼", "women with bunny ears: dark skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏿\u200d🐰\u200d👩🏽", "women with bunny ears: dark skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏿\u200d🐰\u200d👩🏾", "women with bunny ears: dark skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👩🏿\u200d💻", "woman technologist: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d💼", "woman office worker: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🔧", "woman mechanic: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🔬", "woman scientist: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🚀", "woman astronaut: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🚒", "woman firefighter: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👩🏿\u200d🤝\u200d👨🏻", "woman and man holding hands: dark skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏿\u200d🤝\u200d👨🏼", "woman and man holding hands: dark skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏿\u200d🤝\u200d👨🏽", "woman and man holding hands: dark skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏿\u200d🤝\u200d👨🏾", "woman and man holding hands: dark skin tone, medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏿\u200d🤝\u200d👩🏻", "women holding hands: dark skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏿\u200d🤝\u200d👩🏼", "women holding hands: dark skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏿\u200d🤝\u200d👩🏽", "women holding hands: dark skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏿\u200d🤝\u200d👩🏾", "women holding hands: dark skin tone, medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👩🏿\u200d🦯", "woman with white cane: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏿\u200d🦯\u200d➡", "woman with white cane facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏿\u200d🦯\u200d➡️", "woman with white cane facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏿\u200d🦰", "woman: dark skin tone, red hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏿\u200d🦱", "woman: dark skin tone, curly hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏿\u200d🦲", "woman: dark skin tone, bald", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏿\u200d🦳", "woman: dark skin tone, white hair", "People & Body", "person", E11_0, FullyQualified},
+	{"👩🏿\u200d🦼", "woman in motorized wheelchair: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏿\u200d🦼\u200d➡", "woman in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏿\u200d🦼\u200d➡️", "woman in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏿\u200d🦽", "woman in manual wheelchair: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"👩🏿\u200d🦽\u200d➡", "woman in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"👩🏿\u200d🦽\u200d➡️", "woman in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"👩🏿\u200d🫯\u200d👩🏻", "women wrestling: dark skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏿\u200d🫯\u200d👩🏼", "women wrestling: dark skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏿\u200d🫯\u200d👩🏽", "women wrestling: dark skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👩🏿\u200d🫯\u200d👩🏾", "women wrestling: dark skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"👪", "family", "People & Body", "person-symbol", E0_6, FullyQualified},
+	{"👫", "woman and man holding hands", "People & Body", "family", E0_6, FullyQualified},
+	{"👫🏻", "woman and man holding hands: light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👫🏼", "woman and man holding hands: medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👫🏽", "woman and man holding hands: medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👫🏾", "woman and man holding hands: medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👫🏿", "woman and man holding hands: dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👬", "men holding hands", "People & Body", "family", E1_0, FullyQualified},
+	{"👬🏻", "men holding hands: light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👬🏼", "men holding hands: medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👬🏽", "men holding hands: medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👬🏾", "men holding hands: medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👬🏿", "men holding hands: dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👭", "women holding hands", "People & Body", "family", E1_0, FullyQualified},
+	{"👭🏻", "women holding hands: light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👭🏼", "women holding hands: medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👭🏽", "women holding hands: medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👭🏾", "women holding hands: medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👭🏿", "women holding hands: dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"👮", "police officer", "People & Body", "person-role", E0_6, FullyQualified},
+	{"👮\u200d♀", "woman police officer", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮\u200d♀️", "woman police officer", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮\u200d♂", "man police officer", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮\u200d♂️", "man police officer", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏻", "police officer: light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👮🏻\u200d♀", "woman police officer: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏻\u200d♀️", "woman police officer: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏻\u200d♂", "man police officer: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏻\u200d♂️", "man police officer: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏼", "police officer: medium-light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👮🏼\u200d♀", "woman police officer: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏼\u200d♀️", "woman police officer: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏼\u200d♂", "man police officer: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏼\u200d♂️", "man police officer: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏽", "police officer: medium skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👮🏽\u200d♀", "woman police officer: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏽\u200d♀️", "woman police officer: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏽\u200d♂", "man police officer: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏽\u200d♂️", "man police officer: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏾", "police officer: medium-dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👮🏾\u200d♀", "woman police officer: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏾\u200d♀️", "woman police officer: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏾\u200d♂", "man police officer: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏾\u200d♂️", "man police officer: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏿", "police officer: dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👮🏿\u200d♀", "woman police officer: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏿\u200d♀️", "woman police officer: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👮🏿\u200d♂", "man police officer: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👮🏿\u200d♂️", "man police officer: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👯", "people with bunny ears", "People & Body", "person-activity", E0_6, FullyQualified},
+	{"👯\u200d♀", "women with bunny ears", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"👯\u200d♀️", "women with bunny ears", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"👯\u200d♂", "men with bunny ears", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"👯\u200d♂️", "men with bunny ears", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"👯🏻", "people with bunny ears: light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏻\u200d♀", "women with bunny ears: light skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏻\u200d♀️", "women with bunny ears: light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏻\u200d♂", "men with bunny ears: light skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏻\u200d♂️", "men with bunny ears: light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏼", "people with bunny ears: medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏼\u200d♀", "women with bunny ears: medium-light skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏼\u200d♀️", "women with bunny ears: medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏼\u200d♂", "men with bunny ears: medium-light skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏼\u200d♂️", "men with bunny ears: medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏽", "people with bunny ears: medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏽\u200d♀", "women with bunny ears: medium skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏽\u200d♀️", "women with bunny ears: medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏽\u200d♂", "men with bunny ears: medium skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏽\u200d♂️", "men with bunny ears: medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏾", "people with bunny ears: medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏾\u200d♀", "women with bunny ears: medium-dark skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏾\u200d♀️", "women with bunny ears: medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏾\u200d♂", "men with bunny ears: medium-dark skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏾\u200d♂️", "men with bunny ears: medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏿", "people with bunny ears: dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏿\u200d♀", "women with bunny ears: dark skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏿\u200d♀️", "women with bunny ears: dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👯🏿\u200d♂", "men with bunny ears: dark skin tone", "People & Body", "person-activity", E17_0, MinimallyQualified},
+	{"👯🏿\u200d♂️", "men with bunny ears: dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"👰", "person with veil", "People & Body", "person-role", E0_6, FullyQualified},
+	{"👰\u200d♀", "woman with veil", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰\u200d♀️", "woman with veil", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰\u200d♂", "man with veil", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰\u200d♂️", "man with veil", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏻", "person with veil: light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👰🏻\u200d♀", "woman with veil: light skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏻\u200d♀️", "woman with veil: light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏻\u200d♂", "man with veil: light skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏻\u200d♂️", "man with veil: light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏼", "person with veil: medium-light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👰🏼\u200d♀", "woman with veil: medium-light skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏼\u200d♀️", "woman with veil: medium-light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏼\u200d♂", "man with veil: medium-light skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏼\u200d♂️", "man with veil: medium-light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏽", "person with veil: medium skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👰🏽\u200d♀", "woman with veil: medium skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏽\u200d♀️", "woman with veil: medium skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏽\u200d♂", "man with veil: medium skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏽\u200d♂️", "man with veil: medium skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏾", "person with veil: medium-dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👰🏾\u200d♀", "woman with veil: medium-dark skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏾\u200d♀️", "woman with veil: medium-dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏾\u200d♂", "man with veil: medium-dark skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏾\u200d♂️", "man with veil: medium-dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏿", "person with veil: dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👰🏿\u200d♀", "woman with veil: dark skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏿\u200d♀️", "woman with veil: dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👰🏿\u200d♂", "man with veil: dark skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"👰🏿\u200d♂️", "man with veil: dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"👱", "person: blond hair", "People & Body", "person", E0_6, FullyQualified},
+	{"👱\u200d♀", "woman: blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱\u200d♀️", "woman: blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱\u200d♂", "man: blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱\u200d♂️", "man: blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏻", "person: light skin tone, blond hair", "People & Body", "person", E1_0, FullyQualified},
+	{"👱🏻\u200d♀", "woman: light skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏻\u200d♀️", "woman: light skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏻\u200d♂", "man: light skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏻\u200d♂️", "man: light skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏼", "person: medium-light skin tone, blond hair", "People & Body", "person", E1_0, FullyQualified},
+	{"👱🏼\u200d♀", "woman: medium-light skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏼\u200d♀️", "woman: medium-light skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏼\u200d♂", "man: medium-light skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏼\u200d♂️", "man: medium-light skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏽", "person: medium skin tone, blond hair", "People & Body", "person", E1_0, FullyQualified},
+	{"👱🏽\u200d♀", "woman: medium skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏽\u200d♀️", "woman: medium skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏽\u200d♂", "man: medium skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏽\u200d♂️", "man: medium skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏾", "person: medium-dark skin tone, blond hair", "People & Body", "person", E1_0, FullyQualified},
+	{"👱🏾\u200d♀", "woman: medium-dark skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏾\u200d♀️", "woman: medium-dark skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏾\u200d♂", "man: medium-dark skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏾\u200d♂️", "man: medium-dark skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏿", "person: dark skin tone, blond hair", "People & Body", "person", E1_0, FullyQualified},
+	{"👱🏿\u200d♀", "woman: dark skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏿\u200d♀️", "woman: dark skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👱🏿\u200d♂", "man: dark skin tone, blond hair", "People & Body", "person", E4_0, MinimallyQualified},
+	{"👱🏿\u200d♂️", "man: dark skin tone, blond hair", "People & Body", "person", E4_0, FullyQualified},
+	{"👲", "person with skullcap", "People & Body", "person-role", E0_6, FullyQualified},
+	{"👲🏻", "person with skullcap: light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👲🏼", "person with skullcap: medium-light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👲🏽", "person with skullcap: medium skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👲🏾", "person with skullcap: medium-dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👲🏿", "person with skullcap: dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👳", "person wearing turban", "People & Body", "person-role", E0_6, FullyQualified},
+	{"👳\u200d♀", "woman wearing turban", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳\u200d♀️", "woman wearing turban", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳\u200d♂", "man wearing turban", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳\u200d♂️", "man wearing turban", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏻", "person wearing turban: light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👳🏻\u200d♀", "woman wearing turban: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏻\u200d♀️", "woman wearing turban: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏻\u200d♂", "man wearing turban: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏻\u200d♂️", "man wearing turban: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏼", "person wearing turban: medium-light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👳🏼\u200d♀", "woman wearing turban: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏼\u200d♀️", "woman wearing turban: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏼\u200d♂", "man wearing turban: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏼\u200d♂️", "man wearing turban: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏽", "person wearing turban: medium skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👳🏽\u200d♀", "woman wearing turban: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏽\u200d♀️", "woman wearing turban: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏽\u200d♂", "man wearing turban: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏽\u200d♂️", "man wearing turban: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏾", "person wearing turban: medium-dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👳🏾\u200d♀", "woman wearing turban: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏾\u200d♀️", "woman wearing turban: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏾\u200d♂", "man wearing turban: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏾\u200d♂️", "man wearing turban: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏿", "person wearing turban: dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👳🏿\u200d♀", "woman wearing turban: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏿\u200d♀️", "woman wearing turban: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👳🏿\u200d♂", "man wearing turban: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👳🏿\u200d♂️", "man wearing turban: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👴", "old man", "People & Body", "person", E0_6, FullyQualified},
+	{"👴🏻", "old man: light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👴🏼", "old man: medium-light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👴🏽", "old man: medium skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👴🏾", "old man: medium-dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👴🏿", "old man: dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👵", "old woman", "People & Body", "person", E0_6, FullyQualified},
+	{"👵🏻", "old woman: light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👵🏼", "old woman: medium-light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👵🏽", "old woman: medium skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👵🏾", "old woman: medium-dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👵🏿", "old woman: dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👶", "baby", "People & Body", "person", E0_6, FullyQualified},
+	{"👶🏻", "baby: light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👶🏼", "baby: medium-light skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👶🏽", "baby: medium skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👶🏾", "baby: medium-dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👶🏿", "baby: dark skin tone", "People & Body", "person", E1_0, FullyQualified},
+	{"👷", "construction worker", "People & Body", "person-role", E0_6, FullyQualified},
+	{"👷\u200d♀", "woman construction worker", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷\u200d♀️", "woman construction worker", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷\u200d♂", "man construction worker", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷\u200d♂️", "man construction worker", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏻", "construction worker: light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👷🏻\u200d♀", "woman construction worker: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏻\u200d♀️", "woman construction worker: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏻\u200d♂", "man construction worker: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏻\u200d♂️", "man construction worker: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏼", "construction worker: medium-light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👷🏼\u200d♀", "woman construction worker: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏼\u200d♀️", "woman construction worker: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏼\u200d♂", "man construction worker: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏼\u200d♂️", "man construction worker: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏽", "construction worker: medium skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👷🏽\u200d♀", "woman construction worker: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏽\u200d♀️", "woman construction worker: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏽\u200d♂", "man construction worker: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏽\u200d♂️", "man construction worker: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏾", "construction worker: medium-dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👷🏾\u200d♀", "woman construction worker: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏾\u200d♀️", "woman construction worker: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏾\u200d♂", "man construction worker: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏾\u200d♂️", "man construction worker: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏿", "construction worker: dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👷🏿\u200d♀", "woman construction worker: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏿\u200d♀️", "woman construction worker: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👷🏿\u200d♂", "man construction worker: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"👷🏿\u200d♂️", "man construction worker: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"👸", "princess", "People & Body", "person-role", E0_6, FullyQualified},
+	{"👸🏻", "princess: light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👸🏼", "princess: medium-light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👸🏽", "princess: medium skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👸🏾", "princess: medium-dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👸🏿", "princess: dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"👹", "ogre", "Smileys & Emotion", "face-costume", E0_6, FullyQualified},
+	{"👺", "goblin", "Smileys & Emotion", "face-costume", E0_6, FullyQualified},
+	{"👻", "ghost", "Smileys & Emotion", "face-costume", E0_6, FullyQualified},
+	{"👼", "baby angel", "People & Body", "person-fantasy", E0_6, FullyQualified},
+	{"👼🏻", "baby angel: light skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"👼🏼", "baby angel: medium-light skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"👼🏽", "baby angel: medium skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"👼🏾", "baby angel: medium-dark skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"👼🏿", "baby angel: dark skin tone", "People & Body", "person-fantasy", E1_0, FullyQualified},
+	{"👽", "alien", "Smileys & Emotion", "face-costume", E0_6, FullyQualified},
+	{"👾", "alien monster", "Smileys & Emotion", "face-costume", E0_6, FullyQualified},
+	{"👿", "angry face with horns", "Smileys & Emotion", "face-negative", E0_6, FullyQualified},
+	{"💀", "skull", "Smileys & Emotion", "face-negative", E0_6, FullyQualified},
+	{"💁", "person tipping hand", "People & Body", "person-gesture", E0_6, FullyQualified},
+	{"💁\u200d♀", "woman tipping hand", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁\u200d♀️", "woman tipping hand", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁\u200d♂", "man tipping hand", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁\u200d♂️", "man tipping hand", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏻", "person tipping hand: light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"💁🏻\u200d♀", "woman tipping hand: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏻\u200d♀️", "woman tipping hand: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏻\u200d♂", "man tipping hand: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏻\u200d♂️", "man tipping hand: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏼", "person tipping hand: medium-light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"💁🏼\u200d♀", "woman tipping hand: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏼\u200d♀️", "woman tipping hand: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏼\u200d♂", "man tipping hand: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏼\u200d♂️", "man tipping hand: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏽", "person tipping hand: medium skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"💁🏽\u200d♀", "woman tipping hand: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏽\u200d♀️", "woman tipping hand: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏽\u200d♂", "man tipping hand: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏽\u200d♂️", "man tipping hand: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏾", "person tipping hand: medium-dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"💁🏾\u200d♀", "woman tipping hand: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏾\u200d♀️", "woman tipping hand: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏾\u200d♂", "man tipping hand: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏾\u200d♂️", "man tipping hand: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏿", "person tipping hand: dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"💁🏿\u200d♀", "woman tipping hand: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏿\u200d♀️", "woman tipping hand: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💁🏿\u200d♂", "man tipping hand: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"💁🏿\u200d♂️", "man tipping hand: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"💂", "guard", "People & Body", "person-role", E0_6, FullyQualified},
+	{"💂\u200d♀", "woman guard", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂\u200d♀️", "woman guard", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂\u200d♂", "man guard", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂\u200d♂️", "man guard", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏻", "guard: light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"💂🏻\u200d♀", "woman guard: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏻\u200d♀️", "woman guard: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏻\u200d♂", "man guard: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏻\u200d♂️", "man guard: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏼", "guard: medium-light skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"💂🏼\u200d♀", "woman guard: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏼\u200d♀️", "woman guard: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏼\u200d♂", "man guard: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏼\u200d♂️", "man guard: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏽", "guard: medium skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"💂🏽\u200d♀", "woman guard: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏽\u200d♀️", "woman guard: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏽\u200d♂", "man guard: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏽\u200d♂️", "man guard: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏾", "guard: medium-dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"💂🏾\u200d♀", "woman guard: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏾\u200d♀️", "woman guard: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏾\u200d♂", "man guard: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏾\u200d♂️", "man guard: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏿", "guard: dark skin tone", "People & Body", "person-role", E1_0, FullyQualified},
+	{"💂🏿\u200d♀", "woman guard: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏿\u200d♀️", "woman guard: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💂🏿\u200d♂", "man guard: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"💂🏿\u200d♂️", "man guard: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"💃", "woman dancing", "People & Body", "person-activity", E0_6, FullyQualified},
+	{"💃🏻", "woman dancing: light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💃🏼", "woman dancing: medium-light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💃🏽", "woman dancing: medium skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💃🏾", "woman dancing: medium-dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💃🏿", "woman dancing: dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💄", "lipstick", "Objects", "clothing", E0_6, FullyQualified},
+	{"💅", "nail polish", "People & Body", "hand-prop", E0_6, FullyQualified},
+	{"💅🏻", "nail polish: light skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"💅🏼", "nail polish: medium-light skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"💅🏽", "nail polish: medium skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"💅🏾", "nail polish: medium-dark skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"💅🏿", "nail polish: dark skin tone", "People & Body", "hand-prop", E1_0, FullyQualified},
+	{"💆", "person getting massage", "People & Body", "person-activity", E0_6, FullyQualified},
+	{"💆\u200d♀", "woman getting massage", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆\u200d♀️", "woman getting massage", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆\u200d♂", "man getting massage", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆\u200d♂️", "man getting massage", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏻", "person getting massage: light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💆🏻\u200d♀", "woman getting massage: light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏻\u200d♀️", "woman getting massage: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏻\u200d♂", "man getting massage: light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏻\u200d♂️", "man getting massage: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏼", "person getting massage: medium-light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💆🏼\u200d♀", "woman getting massage: medium-light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏼\u200d♀️", "woman getting massage: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏼\u200d♂", "man getting massage: medium-light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏼\u200d♂️", "man getting massage: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏽", "person getting massage: medium skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💆🏽\u200d♀", "woman getting massage: medium skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏽\u200d♀️", "woman getting massage: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏽\u200d♂", "man getting massage: medium skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏽\u200d♂️", "man getting massage: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏾", "person getting massage: medium-dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💆🏾\u200d♀", "woman getting massage: medium-dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏾\u200d♀️", "woman getting massage: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏾\u200d♂", "man getting massage: medium-dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏾\u200d♂️", "man getting massage: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏿", "person getting massage: dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💆🏿\u200d♀", "woman getting massage: dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏿\u200d♀️", "woman getting massage: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💆🏿\u200d♂", "man getting massage: dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💆🏿\u200d♂️", "man getting massage: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇", "person getting haircut", "People & Body", "person-activity", E0_6, FullyQualified},
+	{"💇\u200d♀", "woman getting haircut", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇\u200d♀️", "woman getting haircut", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇\u200d♂", "man getting haircut", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇\u200d♂️", "man getting haircut", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏻", "person getting haircut: light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💇🏻\u200d♀", "woman getting haircut: light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏻\u200d♀️", "woman getting haircut: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏻\u200d♂", "man getting haircut: light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏻\u200d♂️", "man getting haircut: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏼", "person getting haircut: medium-light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💇🏼\u200d♀", "woman getting haircut: medium-light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏼\u200d♀️", "woman getting haircut: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏼\u200d♂", "man getting haircut: medium-light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏼\u200d♂️", "man getting haircut: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏽", "person getting haircut: medium skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💇🏽\u200d♀", "woman getting haircut: medium skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏽\u200d♀️", "woman getting haircut: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏽\u200d♂", "man getting haircut: medium skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏽\u200d♂️", "man getting haircut: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏾", "person getting haircut: medium-dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💇🏾\u200d♀", "woman getting haircut: medium-dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏾\u200d♀️", "woman getting haircut: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏾\u200d♂", "man getting haircut: medium-dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏾\u200d♂️", "man getting haircut: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏿", "person getting haircut: dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"💇🏿\u200d♀", "woman getting haircut: dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏿\u200d♀️", "woman getting haircut: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💇🏿\u200d♂", "man getting haircut: dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"💇🏿\u200d♂️", "man getting haircut: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"💈", "barber pole", "Travel & Places", "place-other", E0_6, FullyQualified},
+	{"💉", "syringe", "Objects", "medical", E0_6, FullyQualified},
+	{"💊", "pill", "Objects", "medical", E0_6, FullyQualified},
+	{"💋", "kiss mark", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💌", "love letter", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💍", "ring", "Objects", "clothing", E0_6, FullyQualified},
+	{"💎", "gem stone", "Objects", "clothing", E0_6, FullyQualified},
+	{"💏", "kiss", "People & Body", "family", E0_6, FullyQualified},
+	{"💏🏻", "kiss: light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💏🏼", "kiss: medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💏🏽", "kiss: medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💏🏾", "kiss: medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💏🏿", "kiss: dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💐", "bouquet", "Animals & Nature", "plant-flower", E0_6, FullyQualified},
+	{"💑", "couple with heart", "People & Body", "family", E0_6, FullyQualified},
+	{"💑🏻", "couple with heart: light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💑🏼", "couple with heart: medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💑🏽", "couple with heart: medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💑🏾", "couple with heart: medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💑🏿", "couple with heart: dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"💒", "wedding", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"💓", "beating heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💔", "broken heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💕", "two hearts", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💖", "sparkling heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💗", "growing heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💘", "heart with arrow", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💙", "blue heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💚", "green heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💛", "yellow heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💜", "purple heart", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💝", "heart with ribbon", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💞", "revolving hearts", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💟", "heart decoration", "Smileys & Emotion", "heart", E0_6, FullyQualified},
+	{"💠", "diamond with a dot", "Symbols", "geometric", E0_6, FullyQualified},
+	{"💡", "light bulb", "Objects", "light & video", E0_6, FullyQualified},
+	{"💢", "anger symbol", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💣", "bomb", "Objects", "tool", E0_6, FullyQualified},
+	{"💤", "ZZZ", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💥", "collision", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💦", "sweat droplets", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💧", "droplet", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"💨", "dashing away", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💩", "pile of poo", "Smileys & Emotion", "face-costume", E0_6, FullyQualified},
+	{"💪", "flexed biceps", "People & Body", "body-parts", E0_6, FullyQualified},
+	{"💪🏻", "flexed biceps: light skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"💪🏼", "flexed biceps: medium-light skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"💪🏽", "flexed biceps: medium skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"💪🏾", "flexed biceps: medium-dark skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"💪🏿", "flexed biceps: dark skin tone", "People & Body", "body-parts", E1_0, FullyQualified},
+	{"💫", "dizzy", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💬", "speech balloon", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💭", "thought balloon", "Smileys & Emotion", "emotion", E1_0, FullyQualified},
+	{"💮", "white flower", "Animals & Nature", "plant-flower", E0_6, FullyQualified},
+	{"💯", "hundred points", "Smileys & Emotion", "emotion", E0_6, FullyQualified},
+	{"💰", "money bag", "Objects", "money", E0_6, FullyQualified},
+	{"💱", "currency exchange", "Symbols", "currency", E0_6, FullyQualified},
+	{"💲", "heavy dollar sign", "Symbols", "currency", E0_6, FullyQualified},
+	{"💳", "credit card", "Objects", "money", E0_6, FullyQualified},
+	{"💴", "yen banknote", "Objects", "money", E0_6, FullyQualified},
+	{"💵", "dollar banknote", "Objects", "money", E0_6, FullyQualified},
+	{"💶", "euro banknote", "Objects", "money", E1_0, FullyQualified},
+	{"💷", "pound banknote", "Objects", "money", E1_0, FullyQualified},
+	{"💸", "money with wings", "Objects", "money", E0_6, FullyQualified},
+	{"💹", "chart increasing with yen", "Objects", "money", E0_6, FullyQualified},
+	{"💺", "seat", "Travel & Places", "transport-air", E0_6, FullyQualified},
+	{"💻", "laptop", "Objects", "computer", E0_6, FullyQualified},
+	{"💼", "briefcase", "Objects", "office", E0_6, FullyQualified},
+	{"💽", "computer disk", "Objects", "computer", E0_6, FullyQualified},
+	{"💾", "floppy disk", "Objects", "computer", E0_6, FullyQualified},
+	{"💿", "optical disk", "Objects", "computer", E0_6, FullyQualified},
+	{"📀", "dvd", "Objects", "computer", E0_6, FullyQualified},
+	{"📁", "file folder", "Objects", "office", E0_6, FullyQualified},
+	{"📂", "open file folder", "Objects", "office", E0_6, FullyQualified},
+	{"📃", "page with curl", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📄", "page facing up", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📅", "calendar", "Objects", "office", E0_6, FullyQualified},
+	{"📆", "tear-off calendar", "Objects", "office", E0_6, FullyQualified},
+	{"📇", "card index", "Objects", "office", E0_6, FullyQualified},
+	{"📈", "chart increasing", "Objects", "office", E0_6, FullyQualified},
+	{"📉", "chart decreasing", "Objects", "office", E0_6, FullyQualified},
+	{"📊", "bar chart", "Objects", "office", E0_6, FullyQualified},
+	{"📋", "clipboard", "Objects", "office", E0_6, FullyQualified},
+	{"📌", "pushpin", "Objects", "office", E0_6, FullyQualified},
+	{"📍", "round pushpin", "Objects", "office", E0_6, FullyQualified},
+	{"📎", "paperclip", "Objects", "office", E0_6, FullyQualified},
+	{"📏", "straight ruler", "Objects", "office", E0_6, FullyQualified},
+	{"📐", "triangular ruler", "Objects", "office", E0_6, FullyQualified},
+	{"📑", "bookmark tabs", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📒", "ledger", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📓", "notebook", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📔", "notebook with decorative cover", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📕", "closed book", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📖", "open book", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📗", "green book", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📘", "blue book", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📙", "orange book", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📚", "books", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📛", "name badge", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"📜", "scroll", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📝", "memo", "Objects", "writing", E0_6, FullyQualified},
+	{"📞", "telephone receiver", "Objects", "phone", E0_6, FullyQualified},
+	{"📟", "pager", "Objects", "phone", E0_6, FullyQualified},
+	{"📠", "fax machine", "Objects", "phone", E0_6, FullyQualified},
+	{"📡", "satellite antenna", "Objects", "science", E0_6, FullyQualified},
+	{"📢", "loudspeaker", "Objects", "sound", E0_6, FullyQualified},
+	{"📣", "megaphone", "Objects", "sound", E0_6, FullyQualified},
+	{"📤", "outbox tray", "Objects", "mail", E0_6, FullyQualified},
+	{"📥", "inbox tray", "Objects", "mail", E0_6, FullyQualified},
+	{"📦", "package", "Objects", "mail", E0_6, FullyQualified},
+	{"📧", "e-mail", "Objects", "mail", E0_6, FullyQualified},
+	{"📨", "incoming envelope", "Objects", "mail", E0_6, FullyQualified},
+	{"📩", "envelope with arrow", "Objects", "mail", E0_6, FullyQualified},
+	{"📪", "closed mailbox with lowered flag", "Objects", "mail", E0_6, FullyQualified},
+	{"📫", "closed mailbox with raised flag", "Objects", "mail", E0_6, FullyQualified},
+	{"📬", "open mailbox with raised flag", "Objects", "mail", E0_7, FullyQualified},
+	{"📭", "open mailbox with lowered flag", "Objects", "mail", E0_7, FullyQualified},
+	{"📮", "postbox", "Objects", "mail", E0_6, FullyQualified},
+	{"📯", "postal horn", "Objects", "sound", E1_0, FullyQualified},
+	{"📰", "newspaper", "Objects", "book-paper", E0_6, FullyQualified},
+	{"📱", "mobile phone", "Objects", "phone", E0_6, FullyQualified},
+	{"📲", "mobile phone with arrow", "Objects", "phone", E0_6, FullyQualified},
+	{"📳", "vibration mode", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"📴", "mobile phone off", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"📵", "no mobile phones", "Symbols", "warning", E1_0, FullyQualified},
+	{"📶", "antenna bars", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"📷", "camera", "Objects", "light & video", E0_6, FullyQualified},
+	{"📸", "camera with flash", "Objects", "light & video", E1_0, FullyQualified},
+	{"📹", "video camera", "Objects", "light & video", E0_6, FullyQualified},
+	{"📺", "television", "Objects", "light & video", E0_6, FullyQualified},
+	{"📻", "radio", "Objects", "music", E0_6, FullyQualified},
+	{"📼", "videocassette", "Objects", "light & video", E0_6, FullyQualified},
+	{"📽", "film projector", "Objects", "light & video", E0_7, Unqualified},
+	{"📽️", "film projector", "Objects", "light & video", E0_7, FullyQualified},
+	{"📿", "prayer beads", "Objects", "clothing", E1_0, FullyQualified},
+	{"🔀", "shuffle tracks button", "Symbols", "av-symbol", E1_0, FullyQualified},
+	{"🔁", "repeat button", "Symbols", "av-symbol", E1_0, FullyQualified},
+	{"🔂", "repeat single button", "Symbols", "av-symbol", E1_0, FullyQualified},
+	{"🔃", "clockwise vertical arrows", "Symbols", "arrow", E0_6, FullyQualified},
+	{"🔄", "counterclockwise arrows button", "Symbols", "arrow", E1_0, FullyQualified},
+	{"🔅", "dim button", "Symbols", "av-symbol", E1_0, FullyQualified},
+	{"🔆", "bright button", "Symbols", "av-symbol", E1_0, FullyQualified},
+	{"🔇", "muted speaker", "Objects", "sound", E1_0, FullyQualified},
+	{"🔈", "speaker low volume", "Objects", "sound", E0_7, FullyQualified},
+	{"🔉", "speaker medium volume", "Objects", "sound", E1_0, FullyQualified},
+	{"🔊", "speaker high volume", "Objects", "sound", E0_6, FullyQualified},
+	{"🔋", "battery", "Objects", "computer", E0_6, FullyQualified},
+	{"🔌", "electric plug", "Objects", "computer", E0_6, FullyQualified},
+	{"🔍", "magnifying glass tilted left", "Objects", "light & video", E0_6, FullyQualified},
+	{"🔎", "magnifying glass tilted right", "Objects", "light & video", E0_6, FullyQualified},
+	{"🔏", "locked with pen", "Objects", "lock", E0_6, FullyQualified},
+	{"🔐", "locked with key", "Objects", "lock", E0_6, FullyQualified},
+	{"🔑", "key", "Objects", "lock", E0_6, FullyQualified},
+	{"🔒", "locked", "Objects", "lock", E0_6, FullyQualified},
+	{"🔓", "unlocked", "Objects", "lock", E0_6, FullyQualified},
+	{"🔔", "bell", "Objects", "sound", E0_6, FullyQualified},
+	{"🔕", "bell with slash", "Objects", "sound", E1_0, FullyQualified},
+	{"🔖", "bookmark", "Objects", "book-paper", E0_6, FullyQualified},
+	{"🔗", "link", "Objects", "tool", E0_6, FullyQualified},
+	{"🔘", "radio button", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔙", "BACK arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"🔚", "END arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"🔛", "ON! arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"🔜", "SOON arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"🔝", "TOP arrow", "Symbols", "arrow", E0_6, FullyQualified},
+	{"🔞", "no one under eighteen", "Symbols", "warning", E0_6, FullyQualified},
+	{"🔟", "keycap: 10", "Symbols", "keycap", E0_6, FullyQualified},
+	{"🔠", "input latin uppercase", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🔡", "input latin lowercase", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🔢", "input numbers", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🔣", "input symbols", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🔤", "input latin letters", "Symbols", "alphanum", E0_6, FullyQualified},
+	{"🔥", "fire", "Travel & Places", "sky & weather", E0_6, FullyQualified},
+	{"🔦", "flashlight", "Objects", "light & video", E0_6, FullyQualified},
+	{"🔧", "wrench", "Objects", "tool", E0_6, FullyQualified},
+	{"🔨", "hammer", "Objects", "tool", E0_6, FullyQualified},
+	{"🔩", "nut and bolt", "Objects", "tool", E0_6, FullyQualified},
+	{"🔪", "kitchen knife", "Food & Drink", "dishware", E0_6, FullyQualified},
+	{"🔫", "water pistol", "Activities", "game", E0_6, FullyQualified},
+	{"🔬", "microscope", "Objects", "science", E1_0, FullyQualified},
+	{"🔭", "telescope", "Objects", "science", E1_0, FullyQualified},
+	{"🔮", "crystal ball", "Activities", "game", E0_6, FullyQualified},
+	{"🔯", "dotted six-pointed star", "Symbols", "religion", E0_6, FullyQualified},
+	{"🔰", "Japanese symbol for beginner", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"🔱", "trident emblem", "Symbols", "other-symbol", E0_6, FullyQualified},
+	{"🔲", "black square button", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔳", "white square button", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔴", "red circle", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔵", "blue circle", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔶", "large orange diamond", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔷", "large blue diamond", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔸", "small orange diamond", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔹", "small blue diamond", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔺", "red triangle pointed up", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔻", "red triangle pointed down", "Symbols", "geometric", E0_6, FullyQualified},
+	{"🔼", "upwards button", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"🔽", "downwards button", "Symbols", "av-symbol", E0_6, FullyQualified},
+	{"🕉", "om", "Symbols", "religion", E0_7, Unqualified},
+	{"🕉️", "om", "Symbols", "religion", E0_7, FullyQualified},
+	{"🕊", "dove", "Animals & Nature", "animal-bird", E0_7, Unqualified},
+	{"🕊️", "dove", "Animals & Nature", "animal-bird", E0_7, FullyQualified},
+	{"🕋", "kaaba", "Travel & Places", "place-religious", E1_0, FullyQualified},
+	{"🕌", "mosque", "Travel & Places", "place-religious", E1_0, FullyQualified},
+	{"🕍", "synagogue", "Travel & Places", "place-religious", E1_0, FullyQualified},
+	{"🕎", "menorah", "Symbols", "religion", E1_0, FullyQualified},
+	{"🕐", "one o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕑", "two o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕒", "three o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕓", "four o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕔", "five o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕕", "six o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕖", "seven o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕗", "eight o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕘", "nine o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕙", "ten o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕚", "eleven o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕛", "twelve o’clock", "Travel & Places", "time", E0_6, FullyQualified},
+	{"🕜", "one-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕝", "two-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕞", "three-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕟", "four-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕠", "five-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕡", "six-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕢", "seven-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕣", "eight-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕤", "nine-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕥", "ten-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕦", "eleven-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕧", "twelve-thirty", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕯", "candle", "Objects", "light & video", E0_7, Unqualified},
+	{"🕯️", "candle", "Objects", "light & video", E0_7, FullyQualified},
+	{"🕰", "mantelpiece clock", "Travel & Places", "time", E0_7, Unqualified},
+	{"🕰️", "mantelpiece clock", "Travel & Places", "time", E0_7, FullyQualified},
+	{"🕳", "hole", "Smileys & Emotion", "emotion", E0_7, Unqualified},
+	{"🕳️", "hole", "Smileys & Emotion", "emotion", E0_7, FullyQualified},
+	{"🕴", "person in suit levitating", "People & Body", "person-activity", E0_7, Unqualified},
+	{"🕴️", "person in suit levitating", "People & Body", "person-activity", E0_7, FullyQualified},
+	{"🕴🏻", "person in suit levitating: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🕴🏼", "person in suit levitating: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🕴🏽", "person in suit levitating: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🕴🏾", "person in suit levitating: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🕴🏿", "person in suit levitating: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🕵", "detective", "People & Body", "person-role", E0_7, Unqualified},
+	{"🕵\u200d♀", "woman detective", "People & Body", "person-role", E4_0, Unqualified},
+	{"🕵\u200d♀️", "woman detective", "People & Body", "person-role", E4_0, Unqualified},
+	{"🕵\u200d♂", "man detective", "People & Body", "person-role", E4_0, Unqualified},
+	{"🕵\u200d♂️", "man detective", "People & Body", "person-role", E4_0, Unqualified},
+	{"🕵️", "detective", "People & Body", "person-role", E0_7, FullyQualified},
+	{"🕵️\u200d♀", "woman detective", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵️\u200d♀️", "woman detective", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵️\u200d♂", "man detective", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵️\u200d♂️", "man detective", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏻", "detective: light skin tone", "People & Body", "person-role", E2_0, FullyQualified},
+	{"🕵🏻\u200d♀", "woman detective: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏻\u200d♀️", "woman detective: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏻\u200d♂", "man detective: light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏻\u200d♂️", "man detective: light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏼", "detective: medium-light skin tone", "People & Body", "person-role", E2_0, FullyQualified},
+	{"🕵🏼\u200d♀", "woman detective: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏼\u200d♀️", "woman detective: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏼\u200d♂", "man detective: medium-light skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏼\u200d♂️", "man detective: medium-light skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏽", "detective: medium skin tone", "People & Body", "person-role", E2_0, FullyQualified},
+	{"🕵🏽\u200d♀", "woman detective: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏽\u200d♀️", "woman detective: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏽\u200d♂", "man detective: medium skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏽\u200d♂️", "man detective: medium skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏾", "detective: medium-dark skin tone", "People & Body", "person-role", E2_0, FullyQualified},
+	{"🕵🏾\u200d♀", "woman detective: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏾\u200d♀️", "woman detective: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏾\u200d♂", "man detective: medium-dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏾\u200d♂️", "man detective: medium-dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏿", "detective: dark skin tone", "People & Body", "person-role", E2_0, FullyQualified},
+	{"🕵🏿\u200d♀", "woman detective: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏿\u200d♀️", "woman detective: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕵🏿\u200d♂", "man detective: dark skin tone", "People & Body", "person-role", E4_0, MinimallyQualified},
+	{"🕵🏿\u200d♂️", "man detective: dark skin tone", "People & Body", "person-role", E4_0, FullyQualified},
+	{"🕶", "sunglasses", "Objects", "clothing", E0_7, Unqualified},
+	{"🕶️", "sunglasses", "Objects", "clothing", E0_7, FullyQualified},
+	{"🕷", "spider", "Animals & Nature", "animal-bug", E0_7, Unqualified},
+	{"🕷️", "spider", "Animals & Nature", "animal-bug", E0_7, FullyQualified},
+	{"🕸", "spider web", "Animals & Nature", "animal-bug", E0_7, Unqualified},
+	{"🕸️", "spider web", "Animals & Nature", "animal-bug", E0_7, FullyQualified},
+	{"🕹", "joystick", "Activities", "game", E0_7, Unqualified},
+	{"🕹️", "joystick", "Activities", "game", E0_7, FullyQualified},
+	{"🕺", "man dancing", "People & Body", "person-activity", E3_0, FullyQualified},
+	{"🕺🏻", "man dancing: light skin tone", "People & Body", "person-activity", E3_0, FullyQualified},
+	{"🕺🏼", "man dancing: medium-light skin tone", "People & Body", "person-activity", E3_0, FullyQualified},
+	{"🕺🏽", "man dancing: medium skin tone", "People & Body", "person-activity", E3_0, FullyQualified},
+	{"🕺🏾", "man dancing: medium-dark skin tone", "People & Body", "person-activity", E3_0, FullyQualified},
+	{"🕺🏿", "man dancing: dark skin tone", "People & Body", "person-activity", E3_0, FullyQualified},
+	{"🖇", "linked paperclips", "Objects", "office", E0_7, Unqualified},
+	{"🖇️", "linked paperclips", "Objects", "office", E0_7, FullyQualified},
+	{"🖊", "pen", "Objects", "writing", E0_7, Unqualified},
+	{"🖊️", "pen", "Objects", "writing", E0_7, FullyQualified},
+	{"🖋", "fountain pen", "Objects", "writing", E0_7, Unqualified},
+	{"🖋️", "fountain pen", "Objects", "writing", E0_7, FullyQualified},
+	{"🖌", "paintbrush", "Objects", "writing", E0_7, Unqualified},
+	{"🖌️", "paintbrush", "Objects", "writing", E0_7, FullyQualified},
+	{"🖍", "crayon", "Objects", "writing", E0_7, Unqualified},
+	{"🖍️", "crayon", "Objects", "writing", E0_7, FullyQualified},
+	{"🖐", "hand with fingers splayed", "People & Body", "hand-fingers-open", E0_7, Unqualified},
+	{"🖐️", "hand with fingers splayed", "People & Body", "hand-fingers-open", E0_7, FullyQualified},
+	{"🖐🏻", "hand with fingers splayed: light skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖐🏼", "hand with fingers splayed: medium-light skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖐🏽", "hand with fingers splayed: medium skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖐🏾", "hand with fingers splayed: medium-dark skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖐🏿", "hand with fingers splayed: dark skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖕", "middle finger", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"🖕🏻", "middle finger: light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"🖕🏼", "middle finger: medium-light skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"🖕🏽", "middle finger: medium skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"🖕🏾", "middle finger: medium-dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"🖕🏿", "middle finger: dark skin tone", "People & Body", "hand-single-finger", E1_0, FullyQualified},
+	{"🖖", "vulcan salute", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖖🏻", "vulcan salute: light skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖖🏼", "vulcan salute: medium-light skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖖🏽", "vulcan salute: medium skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖖🏾", "vulcan salute: medium-dark skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖖🏿", "vulcan salute: dark skin tone", "People & Body", "hand-fingers-open", E1_0, FullyQualified},
+	{"🖤", "black heart", "Smileys & Emotion", "heart", E3_0, FullyQualified},
+	{"🖥", "desktop computer", "Objects", "computer", E0_7, Unqualified},
+	{"🖥️", "desktop computer", "Objects", "computer", E0_7, FullyQualified},
+	{"🖨", "printer", "Objects", "computer", E0_7, Unqualified},
+	{"🖨️", "printer", "Objects", "computer", E0_7, FullyQualified},
+	{"🖱", "computer mouse", "Objects", "computer", E0_7, Unqualified},
+	{"🖱️", "computer mouse", "Objects", "computer", E0_7, FullyQualified},
+	{"🖲", "trackball", "Objects", "computer", E0_7, Unqualified},
+	{"🖲️", "trackball", "Objects", "computer", E0_7, FullyQualified},
+	{"🖼", "framed picture", "Activities", "arts & crafts", E0_7, Unqualified},
+	{"🖼️", "framed picture", "Activities", "arts & crafts", E0_7, FullyQualified},
+	{"🗂", "card index dividers", "Objects", "office", E0_7, Unqualified},
+	{"🗂️", "card index dividers", "Objects", "office", E0_7, FullyQualified},
+	{"🗃", "card file box", "Objects", "office", E0_7, Unqualified},
+	{"🗃️", "card file box", "Objects", "office", E0_7, FullyQualified},
+	{"🗄", "file cabinet", "Objects", "office", E0_7, Unqualified},
+	{"🗄️", "file cabinet", "Objects", "office", E0_7, FullyQualified},
+	{"🗑", "wastebasket", "Objects", "office", E0_7, Unqualified},
+	{"🗑️", "wastebasket", "Objects", "office", E0_7, FullyQualified},
+	{"🗒", "spiral notepad", "Objects", "office", E0_7, Unqualified},
+	{"🗒️", "spiral notepad", "Objects", "office", E0_7, FullyQualified},
+	{"🗓", "spiral calendar", "Objects", "office", E0_7, Unqualified},
+	{"🗓️", "spiral calendar", "Objects", "office", E0_7, FullyQualified},
+	{"🗜", "clamp", "Objects", "tool", E0_7, Unqualified},
+	{"🗜️", "clamp", "Objects", "tool", E0_7, FullyQualified},
+	{"🗝", "old key", "Objects", "lock", E0_7, Unqualified},
+	{"🗝️", "old key", "Objects", "lock", E0_7, FullyQualified},
+	{"🗞", "rolled-up newspaper", "Objects", "book-paper", E0_7, Unqualified},
+	{"🗞️", "rolled-up newspaper", "Objects", "book-paper", E0_7, FullyQualified},
+	{"🗡", "dagger", "Objects", "tool", E0_7, Unqualified},
+	{"🗡️", "dagger", "Objects", "tool", E0_7, FullyQualified},
+	{"🗣", "speaking head", "People & Body", "person-symbol", E0_7, Unqualified},
+	{"🗣️", "speaking head", "People & Body", "person-symbol", E0_7, FullyQualified},
+	{"🗨", "left speech bubble", "Smileys & Emotion", "emotion", E2_0, Unqualified},
+	{"🗨️", "left speech bubble", "Smileys & Emotion", "emotion", E2_0, FullyQualified},
+	{"🗯", "right anger bubble", "Smileys & Emotion", "emotion", E0_7, Unqualified},
+	{"🗯️", "right anger bubble", "Smileys & Emotion", "emotion", E0_7, FullyQualified},
+	{"🗳", "ballot box with ballot", "Objects", "mail", E0_7, Unqualified},
+	{"🗳️", "ballot box with ballot", "Objects", "mail", E0_7, FullyQualified},
+	{"🗺", "world map", "Travel & Places", "place-map", E0_7, Unqualified},
+	{"🗺️", "world map", "Travel & Places", "place-map", E0_7, FullyQualified},
+	{"🗻", "mount fuji", "Travel & Places", "place-geographic", E0_6, FullyQualified},
+	{"🗼", "Tokyo tower", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🗽", "Statue of Liberty", "Travel & Places", "place-building", E0_6, FullyQualified},
+	{"🗾", "map of Japan", "Travel & Places", "place-map", E0_6, FullyQualified},
+	{"🗿", "moai", "Objects", "other-object", E0_6, FullyQualified},
+	{"😀", "grinning face", "Smileys & Emotion", "face-smiling", E1_0, FullyQualified},
+	{"😁", "beaming face with smiling eyes", "Smileys & Emotion", "face-smiling", E0_6, FullyQualified},
+	{"😂", "face with tears of joy", "Smileys & Emotion", "face-smiling", E0_6, FullyQualified},
+	{"😃", "grinning face with big eyes", "Smileys & Emotion", "face-smiling", E0_6, FullyQualified},
+	{"😄", "grinning face with smiling eyes", "Smileys & Emotion", "face-smiling", E0_6, FullyQualified},
+	{"😅", "grinning face with sweat", "Smileys & Emotion", "face-smiling", E0_6, FullyQualified},
+	{"😆", "grinning squinting face", "Smileys & Emotion", "face-smiling", E0_6, FullyQualified},
+	{"😇", "smiling face with halo", "Smileys & Emotion", "face-smiling", E1_0, FullyQualified},
+	{"😈", "smiling face with horns", "Smileys & Emotion", "face-negative", E1_0, FullyQualified},
+	{"😉", "winking face", "Smileys & Emotion", "face-smiling", E0_6, FullyQualified},
+	{"😊", "smiling face with smiling eyes", "Smileys & Emotion", "face-smiling", E0_6, FullyQualified},
+	{"😋", "face savoring food", "Smileys & Emotion", "face-tongue", E0_6, FullyQualified},
+	{"😌", "relieved face", "Smileys & Emotion", "face-sleepy", E0_6, FullyQualified},
+	{"😍", "smiling face with heart-eyes", "Smileys & Emotion", "face-affection", E0_6, FullyQualified},
+	{"😎", "smiling face with sunglasses", "Smileys & Emotion", "face-glasses", E1_0, FullyQualified},
+	{"😏", "smirking face", "Smileys & Emotion", "face-neutral-skeptical", E0_6, FullyQualified},
+	{"😐", "neutral face", "Smileys & Emotion", "face-neutral-skeptical", E0_7, FullyQualified},
+	{"😑", "expressionless face", "Smileys & Emotion", "face-neutral-skeptical", E1_0, FullyQualified},
+	{"😒", "unamused face", "Smileys & Emotion", "face-neutral-skeptical", E0_6, FullyQualified},
+	{"😓", "downcast face with sweat", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😔", "pensive face", "Smileys & Emotion", "face-sleepy", E0_6, FullyQualified},
+	{"😕", "confused face", "Smileys & Emotion", "face-concerned", E1_0, FullyQualified},
+	{"😖", "confounded face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😗", "kissing face", "Smileys & Emotion", "face-affection", E1_0, FullyQualified},
+	{"😘", "face blowing a kiss", "Smileys & Emotion", "face-affection", E0_6, FullyQualified},
+	{"😙", "kissing face with smiling eyes", "Smileys & Emotion", "face-affection", E1_0, FullyQualified},
+	{"😚", "kissing face with closed eyes", "Smileys & Emotion", "face-affection", E0_6, FullyQualified},
+	{"😛", "face with tongue", "Smileys & Emotion", "face-tongue", E1_0, FullyQualified},
+	{"😜", "winking face with tongue", "Smileys & Emotion", "face-tongue", E0_6, FullyQualified},
+	{"😝", "squinting face with tongue", "Smileys & Emotion", "face-tongue", E0_6, FullyQualified},
+	{"😞", "disappointed face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😟", "worried face", "Smileys & Emotion", "face-concerned", E1_0, FullyQualified},
+	{"😠", "angry face", "Smileys & Emotion", "face-negative", E0_6, FullyQualified},
+	{"😡", "enraged face", "Smileys & Emotion", "face-negative", E0_6, FullyQualified},
+	{"😢", "crying face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😣", "persevering face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😤", "face with steam from nose", "Smileys & Emotion", "face-negative", E0_6, FullyQualified},
+	{"😥", "sad but relieved face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😦", "frowning face with open mouth", "Smileys & Emotion", "face-concerned", E1_0, FullyQualified},
+	{"😧", "anguished face", "Smileys & Emotion", "face-concerned", E1_0, FullyQualified},
+	{"😨", "fearful face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😩", "weary face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😪", "sleepy face", "Smileys & Emotion", "face-sleepy", E0_6, FullyQualified},
+	{"😫", "tired face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😬", "grimacing face", "Smileys & Emotion", "face-neutral-skeptical", E1_0, FullyQualified},
+	{"😭", "loudly crying face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😮", "face with open mouth", "Smileys & Emotion", "face-concerned", E1_0, FullyQualified},
+	{"😮\u200d💨", "face exhaling", "Smileys & Emotion", "face-neutral-skeptical", E13_1, FullyQualified},
+	{"😯", "hushed face", "Smileys & Emotion", "face-concerned", E1_0, FullyQualified},
+	{"😰", "anxious face with sweat", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😱", "face screaming in fear", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😲", "astonished face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😳", "flushed face", "Smileys & Emotion", "face-concerned", E0_6, FullyQualified},
+	{"😴", "sleeping face", "Smileys & Emotion", "face-sleepy", E1_0, FullyQualified},
+	{"😵", "face with crossed-out eyes", "Smileys & Emotion", "face-unwell", E0_6, FullyQualified},
+	{"😵\u200d💫", "face with spiral eyes", "Smileys & Emotion", "face-unwell", E13_1, FullyQualified},
+	{"😶", "face without mouth", "Smileys & Emotion", "face-neutral-skeptical", E1_0, FullyQualified},
+	{"😶\u200d🌫", "face in clouds", "Smileys & Emotion", "face-neutral-skeptical", E13_1, MinimallyQualified},
+	{"😶\u200d🌫️", "face in clouds", "Smileys & Emotion", "face-neutral-skeptical", E13_1, FullyQualified},
+	{"😷", "face with medical mask", "Smileys & Emotion", "face-unwell", E0_6, FullyQualified},
+	{"😸", "grinning cat with smiling eyes", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"😹", "cat with tears of joy", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"😺", "grinning cat", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"😻", "smiling cat with heart-eyes", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"😼", "cat with wry smile", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"😽", "kissing cat", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"😾", "pouting cat", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"😿", "crying cat", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"🙀", "weary cat", "Smileys & Emotion", "cat-face", E0_6, FullyQualified},
+	{"🙁", "slightly frowning face", "Smileys & Emotion", "face-concerned", E1_0, FullyQualified},
+	{"🙂", "slightly smiling face", "Smileys & Emotion", "face-smiling", E1_0, FullyQualified},
+	{"🙂\u200d↔", "head shaking horizontally", "Smileys & Emotion", "face-neutral-skeptical", E15_1, MinimallyQualified},
+	{"🙂\u200d↔️", "head shaking horizontally", "Smileys & Emotion", "face-neutral-skeptical", E15_1, FullyQualified},
+	{"🙂\u200d↕", "head shaking vertically", "Smileys & Emotion", "face-neutral-skeptical", E15_1, MinimallyQualified},
+	{"🙂\u200d↕️", "head shaking vertically", "Smileys & Emotion", "face-neutral-skeptical", E15_1, FullyQualified},
+	{"🙃", "upside-down face", "Smileys & Emotion", "face-smiling", E1_0, FullyQualified},
+	{"🙄", "face with rolling eyes", "Smileys & Emotion", "face-neutral-skeptical", E1_0, FullyQualified},
+	{"🙅", "person gesturing NO", "People & Body", "person-gesture", E0_6, FullyQualified},
+	{"🙅\u200d♀", "woman gesturing NO", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅\u200d♀️", "woman gesturing NO", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅\u200d♂", "man gesturing NO", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅\u200d♂️", "man gesturing NO", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏻", "person gesturing NO: light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙅🏻\u200d♀", "woman gesturing NO: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏻\u200d♀️", "woman gesturing NO: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏻\u200d♂", "man gesturing NO: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏻\u200d♂️", "man gesturing NO: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏼", "person gesturing NO: medium-light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙅🏼\u200d♀", "woman gesturing NO: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏼\u200d♀️", "woman gesturing NO: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏼\u200d♂", "man gesturing NO: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏼\u200d♂️", "man gesturing NO: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏽", "person gesturing NO: medium skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙅🏽\u200d♀", "woman gesturing NO: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏽\u200d♀️", "woman gesturing NO: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏽\u200d♂", "man gesturing NO: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏽\u200d♂️", "man gesturing NO: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏾", "person gesturing NO: medium-dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙅🏾\u200d♀", "woman gesturing NO: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏾\u200d♀️", "woman gesturing NO: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏾\u200d♂", "man gesturing NO: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏾\u200d♂️", "man gesturing NO: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏿", "person gesturing NO: dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙅🏿\u200d♀", "woman gesturing NO: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏿\u200d♀️", "woman gesturing NO: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙅🏿\u200d♂", "man gesturing NO: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙅🏿\u200d♂️", "man gesturing NO: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆", "person gesturing OK", "People & Body", "person-gesture", E0_6, FullyQualified},
+	{"🙆\u200d♀", "woman gesturing OK", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆\u200d♀️", "woman gesturing OK", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆\u200d♂", "man gesturing OK", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆\u200d♂️", "man gesturing OK", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏻", "person gesturing OK: light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙆🏻\u200d♀", "woman gesturing OK: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏻\u200d♀️", "woman gesturing OK: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏻\u200d♂", "man gesturing OK: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏻\u200d♂️", "man gesturing OK: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏼", "person gesturing OK: medium-light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙆🏼\u200d♀", "woman gesturing OK: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏼\u200d♀️", "woman gesturing OK: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏼\u200d♂", "man gesturing OK: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏼\u200d♂️", "man gesturing OK: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏽", "person gesturing OK: medium skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙆🏽\u200d♀", "woman gesturing OK: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏽\u200d♀️", "woman gesturing OK: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏽\u200d♂", "man gesturing OK: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏽\u200d♂️", "man gesturing OK: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏾", "person gesturing OK: medium-dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙆🏾\u200d♀", "woman gesturing OK: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏾\u200d♀️", "woman gesturing OK: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏾\u200d♂", "man gesturing OK: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏾\u200d♂️", "man gesturing OK: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏿", "person gesturing OK: dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙆🏿\u200d♀", "woman gesturing OK: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏿\u200d♀️", "woman gesturing OK: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙆🏿\u200d♂", "man gesturing OK: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙆🏿\u200d♂️", "man gesturing OK: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇", "person bowing", "People & Body", "person-gesture", E0_6, FullyQualified},
+	{"🙇\u200d♀", "woman bowing", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇\u200d♀️", "woman bowing", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇\u200d♂", "man bowing", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇\u200d♂️", "man bowing", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏻", "person bowing: light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙇🏻\u200d♀", "woman bowing: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏻\u200d♀️", "woman bowing: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏻\u200d♂", "man bowing: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏻\u200d♂️", "man bowing: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏼", "person bowing: medium-light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙇🏼\u200d♀", "woman bowing: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏼\u200d♀️", "woman bowing: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏼\u200d♂", "man bowing: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏼\u200d♂️", "man bowing: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏽", "person bowing: medium skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙇🏽\u200d♀", "woman bowing: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏽\u200d♀️", "woman bowing: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏽\u200d♂", "man bowing: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏽\u200d♂️", "man bowing: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏾", "person bowing: medium-dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙇🏾\u200d♀", "woman bowing: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏾\u200d♀️", "woman bowing: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏾\u200d♂", "man bowing: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏾\u200d♂️", "man bowing: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏿", "person bowing: dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙇🏿\u200d♀", "woman bowing: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏿\u200d♀️", "woman bowing: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙇🏿\u200d♂", "man bowing: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙇🏿\u200d♂️", "man bowing: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙈", "see-no-evil monkey", "Smileys & Emotion", "monkey-face", E0_6, FullyQualified},
+	{"🙉", "hear-no-evil monkey", "Smileys & Emotion", "monkey-face", E0_6, FullyQualified},
+	{"🙊", "speak-no-evil monkey", "Smileys & Emotion", "monkey-face", E0_6, FullyQualified},
+	{"🙋", "person raising hand", "People & Body", "person-gesture", E0_6, FullyQualified},
+	{"🙋\u200d♀", "woman raising hand", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋\u200d♀️", "woman raising hand", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋\u200d♂", "man raising hand", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋\u200d♂️", "man raising hand", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏻", "person raising hand: light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙋🏻\u200d♀", "woman raising hand: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏻\u200d♀️", "woman raising hand: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏻\u200d♂", "man raising hand: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏻\u200d♂️", "man raising hand: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏼", "person raising hand: medium-light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙋🏼\u200d♀", "woman raising hand: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏼\u200d♀️", "woman raising hand: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏼\u200d♂", "man raising hand: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏼\u200d♂️", "man raising hand: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏽", "person raising hand: medium skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙋🏽\u200d♀", "woman raising hand: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏽\u200d♀️", "woman raising hand: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏽\u200d♂", "man raising hand: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏽\u200d♂️", "man raising hand: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏾", "person raising hand: medium-dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙋🏾\u200d♀", "woman raising hand: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏾\u200d♀️", "woman raising hand: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏾\u200d♂", "man raising hand: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏾\u200d♂️", "man raising hand: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏿", "person raising hand: dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙋🏿\u200d♀", "woman raising hand: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏿\u200d♀️", "woman raising hand: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙋🏿\u200d♂", "man raising hand: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙋🏿\u200d♂️", "man raising hand: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙌", "raising hands", "People & Body", "hands", E0_6, FullyQualified},
+	{"🙌🏻", "raising hands: light skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙌🏼", "raising hands: medium-light skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙌🏽", "raising hands: medium skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙌🏾", "raising hands: medium-dark skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙌🏿", "raising hands: dark skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙍", "person frowning", "People & Body", "person-gesture", E0_6, FullyQualified},
+	{"🙍\u200d♀", "woman frowning", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍\u200d♀️", "woman frowning", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍\u200d♂", "man frowning", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍\u200d♂️", "man frowning", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏻", "person frowning: light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙍🏻\u200d♀", "woman frowning: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏻\u200d♀️", "woman frowning: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏻\u200d♂", "man frowning: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏻\u200d♂️", "man frowning: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏼", "person frowning: medium-light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙍🏼\u200d♀", "woman frowning: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏼\u200d♀️", "woman frowning: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏼\u200d♂", "man frowning: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏼\u200d♂️", "man frowning: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏽", "person frowning: medium skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙍🏽\u200d♀", "woman frowning: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏽\u200d♀️", "woman frowning: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏽\u200d♂", "man frowning: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏽\u200d♂️", "man frowning: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏾", "person frowning: medium-dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙍🏾\u200d♀", "woman frowning: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏾\u200d♀️", "woman frowning: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏾\u200d♂", "man frowning: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏾\u200d♂️", "man frowning: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏿", "person frowning: dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙍🏿\u200d♀", "woman frowning: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏿\u200d♀️", "woman frowning: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙍🏿\u200d♂", "man frowning: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙍🏿\u200d♂️", "man frowning: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎", "person pouting", "People & Body", "person-gesture", E0_6, FullyQualified},
+	{"🙎\u200d♀", "woman pouting", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎\u200d♀️", "woman pouting", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎\u200d♂", "man pouting", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎\u200d♂️", "man pouting", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏻", "person pouting: light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙎🏻\u200d♀", "woman pouting: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏻\u200d♀️", "woman pouting: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏻\u200d♂", "man pouting: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏻\u200d♂️", "man pouting: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏼", "person pouting: medium-light skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙎🏼\u200d♀", "woman pouting: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏼\u200d♀️", "woman pouting: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏼\u200d♂", "man pouting: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏼\u200d♂️", "man pouting: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏽", "person pouting: medium skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙎🏽\u200d♀", "woman pouting: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏽\u200d♀️", "woman pouting: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏽\u200d♂", "man pouting: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏽\u200d♂️", "man pouting: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏾", "person pouting: medium-dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙎🏾\u200d♀", "woman pouting: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏾\u200d♀️", "woman pouting: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏾\u200d♂", "man pouting: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏾\u200d♂️", "man pouting: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏿", "person pouting: dark skin tone", "People & Body", "person-gesture", E1_0, FullyQualified},
+	{"🙎🏿\u200d♀", "woman pouting: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏿\u200d♀️", "woman pouting: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙎🏿\u200d♂", "man pouting: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🙎🏿\u200d♂️", "man pouting: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🙏", "folded hands", "People & Body", "hands", E0_6, FullyQualified},
+	{"🙏🏻", "folded hands: light skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙏🏼", "folded hands: medium-light skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙏🏽", "folded hands: medium skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙏🏾", "folded hands: medium-dark skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🙏🏿", "folded hands: dark skin tone", "People & Body", "hands", E1_0, FullyQualified},
+	{"🚀", "rocket", "Travel & Places", "transport-air", E0_6, FullyQualified},
+	{"🚁", "helicopter", "Travel & Places", "transport-air", E1_0, FullyQualified},
+	{"🚂", "locomotive", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚃", "railway car", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚄", "high-speed train", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚅", "bullet train", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚆", "train", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚇", "metro", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚈", "light rail", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚉", "station", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚊", "tram", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚋", "tram car", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚌", "bus", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚍", "oncoming bus", "Travel & Places", "transport-ground", E0_7, FullyQualified},
+	{"🚎", "trolleybus", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚏", "bus stop", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚐", "minibus", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚑", "ambulance", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚒", "fire engine", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚓", "police car", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚔", "oncoming police car", "Travel & Places", "transport-ground", E0_7, FullyQualified},
+	{"🚕", "taxi", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚖", "oncoming taxi", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚗", "automobile", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚘", "oncoming automobile", "Travel & Places", "transport-ground", E0_7, FullyQualified},
+	{"🚙", "sport utility vehicle", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚚", "delivery truck", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚛", "articulated lorry", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚜", "tractor", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚝", "monorail", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚞", "mountain railway", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚟", "suspension railway", "Travel & Places", "transport-air", E1_0, FullyQualified},
+	{"🚠", "mountain cableway", "Travel & Places", "transport-air", E1_0, FullyQualified},
+	{"🚡", "aerial tramway", "Travel & Places", "transport-air", E1_0, FullyQualified},
+	{"🚢", "ship", "Travel & Places", "transport-water", E0_6, FullyQualified},
+	{"🚣", "person rowing boat", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚣\u200d♀", "woman rowing boat", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣\u200d♀️", "woman rowing boat", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣\u200d♂", "man rowing boat", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣\u200d♂️", "man rowing boat", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏻", "person rowing boat: light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚣🏻\u200d♀", "woman rowing boat: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏻\u200d♀️", "woman rowing boat: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏻\u200d♂", "man rowing boat: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏻\u200d♂️", "man rowing boat: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏼", "person rowing boat: medium-light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚣🏼\u200d♀", "woman rowing boat: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏼\u200d♀️", "woman rowing boat: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏼\u200d♂", "man rowing boat: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏼\u200d♂️", "man rowing boat: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏽", "person rowing boat: medium skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚣🏽\u200d♀", "woman rowing boat: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏽\u200d♀️", "woman rowing boat: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏽\u200d♂", "man rowing boat: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏽\u200d♂️", "man rowing boat: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏾", "person rowing boat: medium-dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚣🏾\u200d♀", "woman rowing boat: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏾\u200d♀️", "woman rowing boat: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏾\u200d♂", "man rowing boat: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏾\u200d♂️", "man rowing boat: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏿", "person rowing boat: dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚣🏿\u200d♀", "woman rowing boat: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏿\u200d♀️", "woman rowing boat: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚣🏿\u200d♂", "man rowing boat: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚣🏿\u200d♂️", "man rowing boat: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚤", "speedboat", "Travel & Places", "transport-water", E0_6, FullyQualified},
+	{"🚥", "horizontal traffic light", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚦", "vertical traffic light", "Travel & Places", "transport-ground", E1_0, FullyQualified},
+	{"🚧", "construction", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚨", "police car light", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚩", "triangular flag", "Flags", "flag", E0_6, FullyQualified},
+	{"🚪", "door", "Objects", "household", E0_6, FullyQualified},
+	{"🚫", "prohibited", "Symbols", "warning", E0_6, FullyQualified},
+	{"🚬", "cigarette", "Objects", "other-object", E0_6, FullyQualified},
+	{"🚭", "no smoking", "Symbols", "warning", E0_6, FullyQualified},
+	{"🚮", "litter in bin sign", "Symbols", "transport-sign", E1_0, FullyQualified},
+	{"🚯", "no littering", "Symbols", "warning", E1_0, FullyQualified},
+	{"🚰", "potable water", "Symbols", "transport-sign", E1_0, FullyQualified},
+	{"🚱", "non-potable water", "Symbols", "warning", E1_0, FullyQualified},
+	{"🚲", "bicycle", "Travel & Places", "transport-ground", E0_6, FullyQualified},
+	{"🚳", "no bicycles", "Symbols", "warning", E1_0, FullyQualified},
+	{"🚴", "person biking", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚴\u200d♀", "woman biking", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴\u200d♀️", "woman biking", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴\u200d♂", "man biking", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴\u200d♂️", "man biking", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏻", "person biking: light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚴🏻\u200d♀", "woman biking: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏻\u200d♀️", "woman biking: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏻\u200d♂", "man biking: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏻\u200d♂️", "man biking: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏼", "person biking: medium-light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚴🏼\u200d♀", "woman biking: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏼\u200d♀️", "woman biking: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏼\u200d♂", "man biking: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏼\u200d♂️", "man biking: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏽", "person biking: medium skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚴🏽\u200d♀", "woman biking: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏽\u200d♀️", "woman biking: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏽\u200d♂", "man biking: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏽\u200d♂️", "man biking: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏾", "person biking: medium-dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚴🏾\u200d♀", "woman biking: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏾\u200d♀️", "woman biking: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏾\u200d♂", "man biking: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏾\u200d♂️", "man biking: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏿", "person biking: dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚴🏿\u200d♀", "woman biking: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏿\u200d♀️", "woman biking: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚴🏿\u200d♂", "man biking: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚴🏿\u200d♂️", "man biking: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵", "person mountain biking", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚵\u200d♀", "woman mountain biking", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵\u200d♀️", "woman mountain biking", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵\u200d♂", "man mountain biking", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵\u200d♂️", "man mountain biking", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏻", "person mountain biking: light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚵🏻\u200d♀", "woman mountain biking: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏻\u200d♀️", "woman mountain biking: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏻\u200d♂", "man mountain biking: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏻\u200d♂️", "man mountain biking: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏼", "person mountain biking: medium-light skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚵🏼\u200d♀", "woman mountain biking: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏼\u200d♀️", "woman mountain biking: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏼\u200d♂", "man mountain biking: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏼\u200d♂️", "man mountain biking: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏽", "person mountain biking: medium skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚵🏽\u200d♀", "woman mountain biking: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏽\u200d♀️", "woman mountain biking: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏽\u200d♂", "man mountain biking: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏽\u200d♂️", "man mountain biking: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏾", "person mountain biking: medium-dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚵🏾\u200d♀", "woman mountain biking: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏾\u200d♀️", "woman mountain biking: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏾\u200d♂", "man mountain biking: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏾\u200d♂️", "man mountain biking: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏿", "person mountain biking: dark skin tone", "People & Body", "person-sport", E1_0, FullyQualified},
+	{"🚵🏿\u200d♀", "woman mountain biking: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏿\u200d♀️", "woman mountain biking: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚵🏿\u200d♂", "man mountain biking: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🚵🏿\u200d♂️", "man mountain biking: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🚶", "person walking", "People & Body", "person-activity", E0_6, FullyQualified},
+	{"🚶\u200d♀", "woman walking", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶\u200d♀\u200d➡", "woman walking facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶\u200d♀\u200d➡️", "woman walking facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶\u200d♀️", "woman walking", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶\u200d♀️\u200d➡", "woman walking facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶\u200d♀️\u200d➡️", "woman walking facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶\u200d♂", "man walking", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶\u200d♂\u200d➡", "man walking facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶\u200d♂\u200d➡️", "man walking facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶\u200d♂️", "man walking", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶\u200d♂️\u200d➡", "man walking facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶\u200d♂️\u200d➡️", "man walking facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶\u200d➡", "person walking facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶\u200d➡️", "person walking facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏻", "person walking: light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🚶🏻\u200d♀", "woman walking: light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏻\u200d♀\u200d➡", "woman walking facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏻\u200d♀\u200d➡️", "woman walking facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏻\u200d♀️", "woman walking: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏻\u200d♀️\u200d➡", "woman walking facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏻\u200d♀️\u200d➡️", "woman walking facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏻\u200d♂", "man walking: light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏻\u200d♂\u200d➡", "man walking facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏻\u200d♂\u200d➡️", "man walking facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏻\u200d♂️", "man walking: light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏻\u200d♂️\u200d➡", "man walking facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏻\u200d♂️\u200d➡️", "man walking facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏻\u200d➡", "person walking facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏻\u200d➡️", "person walking facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏼", "person walking: medium-light skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🚶🏼\u200d♀", "woman walking: medium-light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏼\u200d♀\u200d➡", "woman walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏼\u200d♀\u200d➡️", "woman walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏼\u200d♀️", "woman walking: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏼\u200d♀️\u200d➡", "woman walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏼\u200d♀️\u200d➡️", "woman walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏼\u200d♂", "man walking: medium-light skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏼\u200d♂\u200d➡", "man walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏼\u200d♂\u200d➡️", "man walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏼\u200d♂️", "man walking: medium-light skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏼\u200d♂️\u200d➡", "man walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏼\u200d♂️\u200d➡️", "man walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏼\u200d➡", "person walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏼\u200d➡️", "person walking facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏽", "person walking: medium skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🚶🏽\u200d♀", "woman walking: medium skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏽\u200d♀\u200d➡", "woman walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏽\u200d♀\u200d➡️", "woman walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏽\u200d♀️", "woman walking: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏽\u200d♀️\u200d➡", "woman walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏽\u200d♀️\u200d➡️", "woman walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏽\u200d♂", "man walking: medium skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏽\u200d♂\u200d➡", "man walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏽\u200d♂\u200d➡️", "man walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏽\u200d♂️", "man walking: medium skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏽\u200d♂️\u200d➡", "man walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏽\u200d♂️\u200d➡️", "man walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏽\u200d➡", "person walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏽\u200d➡️", "person walking facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏾", "person walking: medium-dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🚶🏾\u200d♀", "woman walking: medium-dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏾\u200d♀\u200d➡", "woman walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏾\u200d♀\u200d➡️", "woman walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏾\u200d♀️", "woman walking: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏾\u200d♀️\u200d➡", "woman walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏾\u200d♀️\u200d➡️", "woman walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏾\u200d♂", "man walking: medium-dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏾\u200d♂\u200d➡", "man walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏾\u200d♂\u200d➡️", "man walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏾\u200d♂️", "man walking: medium-dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏾\u200d♂️\u200d➡", "man walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏾\u200d♂️\u200d➡️", "man walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏾\u200d➡", "person walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏾\u200d➡️", "person walking facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏿", "person walking: dark skin tone", "People & Body", "person-activity", E1_0, FullyQualified},
+	{"🚶🏿\u200d♀", "woman walking: dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏿\u200d♀\u200d➡", "woman walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏿\u200d♀\u200d➡️", "woman walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏿\u200d♀️", "woman walking: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏿\u200d♀️\u200d➡", "woman walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏿\u200d♀️\u200d➡️", "woman walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏿\u200d♂", "man walking: dark skin tone", "People & Body", "person-activity", E4_0, MinimallyQualified},
+	{"🚶🏿\u200d♂\u200d➡", "man walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏿\u200d♂\u200d➡️", "man walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏿\u200d♂️", "man walking: dark skin tone", "People & Body", "person-activity", E4_0, FullyQualified},
+	{"🚶🏿\u200d♂️\u200d➡", "man walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏿\u200d♂️\u200d➡️", "man walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚶🏿\u200d➡", "person walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🚶🏿\u200d➡️", "person walking facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🚷", "no pedestrians", "Symbols", "warning", E1_0, FullyQualified},
+	{"🚸", "children crossing", "Symbols", "warning", E1_0, FullyQualified},
+	{"🚹", "men’s room", "Symbols", "transport-sign", E0_6, FullyQualified},
+	{"🚺", "women’s room", "Symbols", "transport-sign", E0_6, FullyQualified},
+	{"🚻", "restroom", "Symbols", "transport-sign", E0_6, FullyQualified},
+	{"🚼", "baby symbol", "Symbols", "transport-sign", E0_6, FullyQualified},
+	{"🚽", "toilet", "Objects", "household", E0_6, FullyQualified},
+	{"🚾", "water closet", "Symbols", "transport-sign", E0_6, FullyQualified},
+	{"🚿", "shower", "Objects", "household", E1_0, FullyQualified},
+	{"🛀", "person taking bath", "People & Body", "person-resting", E0_6, FullyQualified},
+	{"🛀🏻", "person taking bath: light skin tone", "People & Body", "person-resting", E1_0, FullyQualified},
+	{"🛀🏼", "person taking bath: medium-light skin tone", "People & Body", "person-resting", E1_0, FullyQualified},
+	{"🛀🏽", "person taking bath: medium skin tone", "People & Body", "person-resting", E1_0, FullyQualified},
+	{"🛀🏾", "person taking bath: medium-dark skin tone", "People & Body", "person-resting", E1_0, FullyQualified},
+	{"🛀🏿", "person taking bath: dark skin tone", "People & Body", "person-resting", E1_0, FullyQualified},
+	{"🛁", "bathtub", "Objects", "household", E1_0, FullyQualified},
+	{"🛂", "passport control", "Symbols", "transport-sign", E1_0, FullyQualified},
+	{"🛃", "customs", "Symbols", "transport-sign", E1_0, FullyQualified},
+	{"🛄", "baggage claim", "Symbols", "transport-sign", E1_0, FullyQualified},
+	{"🛅", "left luggage", "Symbols", "transport-sign", E1_0, FullyQualified},
+	{"🛋", "couch and lamp", "Objects", "household", E0_7, Unqualified},
+	{"🛋️", "couch and lamp", "Objects", "household", E0_7, FullyQualified},
+	{"🛌", "person in bed", "People & Body", "person-resting", E1_0, FullyQualified},
+	{"🛌🏻", "person in bed: light skin tone", "People & Body", "person-resting", E4_0, FullyQualified},
+	{"🛌🏼", "person in bed: medium-light skin tone", "People & Body", "person-resting", E4_0, FullyQualified},
+	{"🛌🏽", "person in bed: medium skin tone", "People & Body", "person-resting", E4_0, FullyQualified},
+	{"🛌🏾", "person in bed: medium-dark skin tone", "People & Body", "person-resting", E4_0, FullyQualified},
+	{"🛌🏿", "person in bed: dark skin tone", "People & Body", "person-resting", E4_0, FullyQualified},
+	{"🛍", "shopping bags", "Objects", "clothing", E0_7, Unqualified},
+	{"🛍️", "shopping bags", "Objects", "clothing", E0_7, FullyQualified},
+	{"🛎", "bellhop bell", "Travel & Places", "hotel", E0_7, Unqualified},
+	{"🛎️", "bellhop bell", "Travel & Places", "hotel", E0_7, FullyQualified},
+	{"🛏", "bed", "Objects", "household", E0_7, Unqualified},
+	{"🛏️", "bed", "Objects", "household", E0_7, FullyQualified},
+	{"🛐", "place of worship", "Symbols", "religion", E1_0, FullyQualified},
+	{"🛑", "stop sign", "Travel & Places", "transport-ground", E3_0, FullyQualified},
+	{"🛒", "shopping cart", "Objects", "household", E3_0, FullyQualified},
+	{"🛕", "hindu temple", "Travel & Places", "place-religious", E12_0, FullyQualified},
+	{"🛖", "hut", "Travel & Places", "place-building", E13_0, FullyQualified},
+	{"🛗", "elevator", "Objects", "household", E13_0, FullyQualified},
+	{"🛘", "landslide", "Travel & Places", "place-geographic", E17_0, FullyQualified},
+	{"🛜", "wireless", "Symbols", "av-symbol", E15_0, FullyQualified},
+	{"🛝", "playground slide", "Travel & Places", "place-other", E14_0, FullyQualified},
+	{"🛞", "wheel", "Travel & Places", "transport-ground", E14_0, FullyQualified},
+	{"🛟", "ring buoy", "Travel & Places", "transport-water", E14_0, FullyQualified},
+	{"🛠", "hammer and wrench", "Objects", "tool", E0_7, Unqualified},
+	{"🛠️", "hammer and wrench", "Objects", "tool", E0_7, FullyQualified},
+	{"🛡", "shield", "Objects", "tool", E0_7, Unqualified},
+	{"🛡️", "shield", "Objects", "tool", E0_7, FullyQualified},
+	{"🛢", "oil drum", "Travel & Places", "transport-ground", E0_7, Unqualified},
+	{"🛢️", "oil drum", "Travel & Places", "transport-ground", E0_7, FullyQualified},
+	{"🛣", "motorway", "Travel & Places", "transport-ground", E0_7, Unqualified},
+	{"🛣️", "motorway", "Travel & Places", "transport-ground", E0_7, FullyQualified},
+	{"🛤", "railway track", "Travel & Places", "transport-ground", E0_7, Unqualified},
+	{"🛤️", "railway track", "Travel & Places", "transport-ground", E0_7, FullyQualified},
+	{"🛥", "motor boat", "Travel & Places", "transport-water", E0_7, Unqualified},
+	{"🛥️", "motor boat", "Travel & Places", "transport-water", E0_7, FullyQualified},
+	{"🛩", "small airplane", "Travel & Places", "transport-air", E0_7, Unqualified},
+	{"🛩️", "small airplane", "Travel & Places", "transport-air", E0_7, FullyQualified},
+	{"🛫", "airplane departure", "Travel & Places", "transport-air", E1_0, FullyQualified},
+	{"🛬", "airplane arrival", "Travel & Places", "transport-air", E1_0, FullyQualified},
+	{"🛰", "satellite", "Travel & Places", "transport-air", E0_7, Unqualified},
+	{"🛰️", "satellite", "Travel & Places", "transport-air", E0_7, FullyQualified},
+	{"🛳", "passenger ship", "Travel & Places", "transport-water", E0_7, Unqualified},
+	{"🛳️", "passenger ship", "Travel & Places", "transport-water", E0_7, FullyQualified},
+	{"🛴", "kick scooter", "Travel & Places", "transport-ground", E3_0, FullyQualified},
+	{"🛵", "motor scooter", "Travel & Places", "transport-ground", E3_0, FullyQualified},
+	{"🛶", "canoe", "Travel & Places", "transport-water", E3_0, FullyQualified},
+	{"🛷", "sled", "Activities", "sport", E5_0, FullyQualified},
+	{"🛸", "flying saucer", "Travel & Places", "transport-air", E5_0, FullyQualified},
+	{"🛹", "skateboard", "Travel & Places", "transport-ground", E11_0, FullyQualified},
+	{"🛺", "auto rickshaw", "Travel & Places", "transport-ground", E12_0, FullyQualified},
+	{"🛻", "pickup truck", "Travel & Places", "transport-ground", E13_0, FullyQualified},
+	{"🛼", "roller skate", "Travel & Places", "transport-ground", E13_0, FullyQualified},
+	{"🟠", "orange circle", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟡", "yellow circle", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟢", "green circle", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟣", "purple circle", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟤", "brown circle", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟥", "red square", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟦", "blue square", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟧", "orange square", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟨", "yellow square", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟩", "green square", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟪", "purple square", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟫", "brown square", "Symbols", "geometric", E12_0, FullyQualified},
+	{"🟰", "heavy equals sign", "Symbols", "math", E14_0, FullyQualified},
+	{"🤌", "pinched fingers", "People & Body", "hand-fingers-partial", E13_0, FullyQualified},
+	{"🤌🏻", "pinched fingers: light skin tone", "People & Body", "hand-fingers-partial", E13_0, FullyQualified},
+	{"🤌🏼", "pinched fingers: medium-light skin tone", "People & Body", "hand-fingers-partial", E13_0, FullyQualified},
+	{"🤌🏽", "pinched fingers: medium skin tone", "People & Body", "hand-fingers-partial", E13_0, FullyQualified},
+	{"🤌🏾", "pinched fingers: medium-dark skin tone", "People & Body", "hand-fingers-partial", E13_0, FullyQualified},
+	{"🤌🏿", "pinched fingers: dark skin tone", "People & Body", "hand-fingers-partial", E13_0, FullyQualified},
+	{"🤍", "white heart", "Smileys & Emotion", "heart", E12_0, FullyQualified},
+	{"🤎", "brown heart", "Smileys & Emotion", "heart", E12_0, FullyQualified},
+	{"🤏", "pinching hand", "People & Body", "hand-fingers-partial", E12_0, FullyQualified},
+	{"🤏🏻", "pinching hand: light skin tone", "People & Body", "hand-fingers-partial", E12_0, FullyQualified},
+	{"🤏🏼", "pinching hand: medium-light skin tone", "People & Body", "hand-fingers-partial", E12_0, FullyQualified},
+	{"🤏🏽", "pinching hand: medium skin tone", "People & Body", "hand-fingers-partial", E12_0, FullyQualified},
+	{"🤏🏾", "pinching hand: medium-dark skin tone", "People & Body", "hand-fingers-partial", E12_0, FullyQualified},
+	{"🤏🏿", "pinching hand: dark skin tone", "People & Body", "hand-fingers-partial", E12_0, FullyQualified},
+	{"🤐", "zipper-mouth face", "Smileys & Emotion", "face-neutral-skeptical", E1_0, FullyQualified},
+	{"🤑", "money-mouth face", "Smileys & Emotion", "face-tongue", E1_0, FullyQualified},
+	{"🤒", "face with thermometer", "Smileys & Emotion", "face-unwell", E1_0, FullyQualified},
+	{"🤓", "nerd face", "Smileys & Emotion", "face-glasses", E1_0, FullyQualified},
+	{"🤔", "thinking face", "Smileys & Emotion", "face-hand", E1_0, FullyQualified},
+	{"🤕", "face with head-bandage", "Smileys & Emotion", "face-unwell", E1_0, FullyQualified},
+	{"🤖", "robot", "Smileys & Emotion", "face-costume", E1_0, FullyQualified},
+	{"🤗", "smiling face with open hands", "Smileys & Emotion", "face-hand", E1_0, FullyQualified},
+	{"🤘", "sign of the horns", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"🤘🏻", "sign of the horns: light skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"🤘🏼", "sign of the horns: medium-light skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"🤘🏽", "sign of the horns: medium skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"🤘🏾", "sign of the horns: medium-dark skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"🤘🏿", "sign of the horns: dark skin tone", "People & Body", "hand-fingers-partial", E1_0, FullyQualified},
+	{"🤙", "call me hand", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤙🏻", "call me hand: light skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤙🏼", "call me hand: medium-light skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤙🏽", "call me hand: medium skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤙🏾", "call me hand: medium-dark skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤙🏿", "call me hand: dark skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤚", "raised back of hand", "People & Body", "hand-fingers-open", E3_0, FullyQualified},
+	{"🤚🏻", "raised back of hand: light skin tone", "People & Body", "hand-fingers-open", E3_0, FullyQualified},
+	{"🤚🏼", "raised back of hand: medium-light skin tone", "People & Body", "hand-fingers-open", E3_0, FullyQualified},
+	{"🤚🏽", "raised back of hand: medium skin tone", "People & Body", "hand-fingers-open", E3_0, FullyQualified},
+	{"🤚🏾", "raised back of hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E3_0, FullyQualified},
+	{"🤚🏿", "raised back of hand: dark skin tone", "People & Body", "hand-fingers-open", E3_0, FullyQualified},
+	{"🤛", "left-facing fist", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤛🏻", "left-facing fist: light skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤛🏼", "left-facing fist: medium-light skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤛🏽", "left-facing fist: medium skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤛🏾", "left-facing fist: medium-dark skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤛🏿", "left-facing fist: dark skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤜", "right-facing fist", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤜🏻", "right-facing fist: light skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤜🏼", "right-facing fist: medium-light skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤜🏽", "right-facing fist: medium skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤜🏾", "right-facing fist: medium-dark skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤜🏿", "right-facing fist: dark skin tone", "People & Body", "hand-fingers-closed", E3_0, FullyQualified},
+	{"🤝", "handshake", "People & Body", "hands", E3_0, FullyQualified},
+	{"🤝🏻", "handshake: light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🤝🏼", "handshake: medium-light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🤝🏽", "handshake: medium skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🤝🏾", "handshake: medium-dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🤝🏿", "handshake: dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🤞", "crossed fingers", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤞🏻", "crossed fingers: light skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤞🏼", "crossed fingers: medium-light skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤞🏽", "crossed fingers: medium skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤞🏾", "crossed fingers: medium-dark skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤞🏿", "crossed fingers: dark skin tone", "People & Body", "hand-fingers-partial", E3_0, FullyQualified},
+	{"🤟", "love-you gesture", "People & Body", "hand-fingers-partial", E5_0, FullyQualified},
+	{"🤟🏻", "love-you gesture: light skin tone", "People & Body", "hand-fingers-partial", E5_0, FullyQualified},
+	{"🤟🏼", "love-you gesture: medium-light skin tone", "People & Body", "hand-fingers-partial", E5_0, FullyQualified},
+	{"🤟🏽", "love-you gesture: medium skin tone", "People & Body", "hand-fingers-partial", E5_0, FullyQualified},
+	{"🤟🏾", "love-you gesture: medium-dark skin tone", "People & Body", "hand-fingers-partial", E5_0, FullyQualified},
+	{"🤟🏿", "love-you gesture: dark skin tone", "People & Body", "hand-fingers-partial", E5_0, FullyQualified},
+	{"🤠", "cowboy hat face", "Smileys & Emotion", "face-hat", E3_0, FullyQualified},
+	{"🤡", "clown face", "Smileys & Emotion", "face-costume", E3_0, FullyQualified},
+	{"🤢", "nauseated face", "Smileys & Emotion", "face-unwell", E3_0, FullyQualified},
+	{"🤣", "rolling on the floor laughing", "Smileys & Emotion", "face-smiling", E3_0, FullyQualified},
+	{"🤤", "drooling face", "Smileys & Emotion", "face-sleepy", E3_0, FullyQualified},
+	{"🤥", "lying face", "Smileys & Emotion", "face-neutral-skeptical", E3_0, FullyQualified},
+	{"🤦", "person facepalming", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤦\u200d♀", "woman facepalming", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦\u200d♀️", "woman facepalming", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦\u200d♂", "man facepalming", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦\u200d♂️", "man facepalming", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏻", "person facepalming: light skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤦🏻\u200d♀", "woman facepalming: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏻\u200d♀️", "woman facepalming: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏻\u200d♂", "man facepalming: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏻\u200d♂️", "man facepalming: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏼", "person facepalming: medium-light skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤦🏼\u200d♀", "woman facepalming: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏼\u200d♀️", "woman facepalming: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏼\u200d♂", "man facepalming: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏼\u200d♂️", "man facepalming: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏽", "person facepalming: medium skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤦🏽\u200d♀", "woman facepalming: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏽\u200d♀️", "woman facepalming: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏽\u200d♂", "man facepalming: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏽\u200d♂️", "man facepalming: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏾", "person facepalming: medium-dark skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤦🏾\u200d♀", "woman facepalming: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏾\u200d♀️", "woman facepalming: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏾\u200d♂", "man facepalming: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏾\u200d♂️", "man facepalming: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏿", "person facepalming: dark skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤦🏿\u200d♀", "woman facepalming: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏿\u200d♀️", "woman facepalming: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤦🏿\u200d♂", "man facepalming: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤦🏿\u200d♂️", "man facepalming: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤧", "sneezing face", "Smileys & Emotion", "face-unwell", E3_0, FullyQualified},
+	{"🤨", "face with raised eyebrow", "Smileys & Emotion", "face-neutral-skeptical", E5_0, FullyQualified},
+	{"🤩", "star-struck", "Smileys & Emotion", "face-affection", E5_0, FullyQualified},
+	{"🤪", "zany face", "Smileys & Emotion", "face-tongue", E5_0, FullyQualified},
+	{"🤫", "shushing face", "Smileys & Emotion", "face-hand", E5_0, FullyQualified},
+	{"🤬", "face with symbols on mouth", "Smileys & Emotion", "face-negative", E5_0, FullyQualified},
+	{"🤭", "face with hand over mouth", "Smileys & Emotion", "face-hand", E5_0, FullyQualified},
+	{"🤮", "face vomiting", "Smileys & Emotion", "face-unwell", E5_0, FullyQualified},
+	{"🤯", "exploding head", "Smileys & Emotion", "face-unwell", E5_0, FullyQualified},
+	{"🤰", "pregnant woman", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤰🏻", "pregnant woman: light skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤰🏼", "pregnant woman: medium-light skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤰🏽", "pregnant woman: medium skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤰🏾", "pregnant woman: medium-dark skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤰🏿", "pregnant woman: dark skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤱", "breast-feeding", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🤱🏻", "breast-feeding: light skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🤱🏼", "breast-feeding: medium-light skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🤱🏽", "breast-feeding: medium skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🤱🏾", "breast-feeding: medium-dark skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🤱🏿", "breast-feeding: dark skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🤲", "palms up together", "People & Body", "hands", E5_0, FullyQualified},
+	{"🤲🏻", "palms up together: light skin tone", "People & Body", "hands", E5_0, FullyQualified},
+	{"🤲🏼", "palms up together: medium-light skin tone", "People & Body", "hands", E5_0, FullyQualified},
+	{"🤲🏽", "palms up together: medium skin tone", "People & Body", "hands", E5_0, FullyQualified},
+	{"🤲🏾", "palms up together: medium-dark skin tone", "People & Body", "hands", E5_0, FullyQualified},
+	{"🤲🏿", "palms up together: dark skin tone", "People & Body", "hands", E5_0, FullyQualified},
+	{"🤳", "selfie", "People & Body", "hand-prop", E3_0, FullyQualified},
+	{"🤳🏻", "selfie: light skin tone", "People & Body", "hand-prop", E3_0, FullyQualified},
+	{"🤳🏼", "selfie: medium-light skin tone", "People & Body", "hand-prop", E3_0, FullyQualified},
+	{"🤳🏽", "selfie: medium skin tone", "People & Body", "hand-prop", E3_0, FullyQualified},
+	{"🤳🏾", "selfie: medium-dark skin tone", "People & Body", "hand-prop", E3_0, FullyQualified},
+	{"🤳🏿", "selfie: dark skin tone", "People & Body", "hand-prop", E3_0, FullyQualified},
+	{"🤴", "prince", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤴🏻", "prince: light skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤴🏼", "prince: medium-light skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤴🏽", "prince: medium skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤴🏾", "prince: medium-dark skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤴🏿", "prince: dark skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤵", "person in tuxedo", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤵\u200d♀", "woman in tuxedo", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵\u200d♀️", "woman in tuxedo", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵\u200d♂", "man in tuxedo", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵\u200d♂️", "man in tuxedo", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏻", "person in tuxedo: light skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤵🏻\u200d♀", "woman in tuxedo: light skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏻\u200d♀️", "woman in tuxedo: light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏻\u200d♂", "man in tuxedo: light skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏻\u200d♂️", "man in tuxedo: light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏼", "person in tuxedo: medium-light skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤵🏼\u200d♀", "woman in tuxedo: medium-light skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏼\u200d♀️", "woman in tuxedo: medium-light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏼\u200d♂", "man in tuxedo: medium-light skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏼\u200d♂️", "man in tuxedo: medium-light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏽", "person in tuxedo: medium skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤵🏽\u200d♀", "woman in tuxedo: medium skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏽\u200d♀️", "woman in tuxedo: medium skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏽\u200d♂", "man in tuxedo: medium skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏽\u200d♂️", "man in tuxedo: medium skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏾", "person in tuxedo: medium-dark skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤵🏾\u200d♀", "woman in tuxedo: medium-dark skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏾\u200d♀️", "woman in tuxedo: medium-dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏾\u200d♂", "man in tuxedo: medium-dark skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏾\u200d♂️", "man in tuxedo: medium-dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏿", "person in tuxedo: dark skin tone", "People & Body", "person-role", E3_0, FullyQualified},
+	{"🤵🏿\u200d♀", "woman in tuxedo: dark skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏿\u200d♀️", "woman in tuxedo: dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤵🏿\u200d♂", "man in tuxedo: dark skin tone", "People & Body", "person-role", E13_0, MinimallyQualified},
+	{"🤵🏿\u200d♂️", "man in tuxedo: dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🤶", "Mrs. Claus", "People & Body", "person-fantasy", E3_0, FullyQualified},
+	{"🤶🏻", "Mrs. Claus: light skin tone", "People & Body", "person-fantasy", E3_0, FullyQualified},
+	{"🤶🏼", "Mrs. Claus: medium-light skin tone", "People & Body", "person-fantasy", E3_0, FullyQualified},
+	{"🤶🏽", "Mrs. Claus: medium skin tone", "People & Body", "person-fantasy", E3_0, FullyQualified},
+	{"🤶🏾", "Mrs. Claus: medium-dark skin tone", "People & Body", "person-fantasy", E3_0, FullyQualified},
+	{"🤶🏿", "Mrs. Claus: dark skin tone", "People & Body", "person-fantasy", E3_0, FullyQualified},
+	{"🤷", "person shrugging", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤷\u200d♀", "woman shrugging", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷\u200d♀️", "woman shrugging", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷\u200d♂", "man shrugging", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷\u200d♂️", "man shrugging", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏻", "person shrugging: light skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤷🏻\u200d♀", "woman shrugging: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏻\u200d♀️", "woman shrugging: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏻\u200d♂", "man shrugging: light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏻\u200d♂️", "man shrugging: light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏼", "person shrugging: medium-light skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤷🏼\u200d♀", "woman shrugging: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏼\u200d♀️", "woman shrugging: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏼\u200d♂", "man shrugging: medium-light skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏼\u200d♂️", "man shrugging: medium-light skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏽", "person shrugging: medium skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤷🏽\u200d♀", "woman shrugging: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏽\u200d♀️", "woman shrugging: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏽\u200d♂", "man shrugging: medium skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏽\u200d♂️", "man shrugging: medium skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏾", "person shrugging: medium-dark skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤷🏾\u200d♀", "woman shrugging: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏾\u200d♀️", "woman shrugging: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏾\u200d♂", "man shrugging: medium-dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏾\u200d♂️", "man shrugging: medium-dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏿", "person shrugging: dark skin tone", "People & Body", "person-gesture", E3_0, FullyQualified},
+	{"🤷🏿\u200d♀", "woman shrugging: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏿\u200d♀️", "woman shrugging: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤷🏿\u200d♂", "man shrugging: dark skin tone", "People & Body", "person-gesture", E4_0, MinimallyQualified},
+	{"🤷🏿\u200d♂️", "man shrugging: dark skin tone", "People & Body", "person-gesture", E4_0, FullyQualified},
+	{"🤸", "person cartwheeling", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤸\u200d♀", "woman cartwheeling", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸\u200d♀️", "woman cartwheeling", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸\u200d♂", "man cartwheeling", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸\u200d♂️", "man cartwheeling", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏻", "person cartwheeling: light skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤸🏻\u200d♀", "woman cartwheeling: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏻\u200d♀️", "woman cartwheeling: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏻\u200d♂", "man cartwheeling: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏻\u200d♂️", "man cartwheeling: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏼", "person cartwheeling: medium-light skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤸🏼\u200d♀", "woman cartwheeling: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏼\u200d♀️", "woman cartwheeling: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏼\u200d♂", "man cartwheeling: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏼\u200d♂️", "man cartwheeling: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏽", "person cartwheeling: medium skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤸🏽\u200d♀", "woman cartwheeling: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏽\u200d♀️", "woman cartwheeling: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏽\u200d♂", "man cartwheeling: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏽\u200d♂️", "man cartwheeling: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏾", "person cartwheeling: medium-dark skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤸🏾\u200d♀", "woman cartwheeling: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏾\u200d♀️", "woman cartwheeling: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏾\u200d♂", "man cartwheeling: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏾\u200d♂️", "man cartwheeling: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏿", "person cartwheeling: dark skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤸🏿\u200d♀", "woman cartwheeling: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏿\u200d♀️", "woman cartwheeling: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤸🏿\u200d♂", "man cartwheeling: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤸🏿\u200d♂️", "man cartwheeling: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹", "person juggling", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤹\u200d♀", "woman juggling", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹\u200d♀️", "woman juggling", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹\u200d♂", "man juggling", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹\u200d♂️", "man juggling", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏻", "person juggling: light skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤹🏻\u200d♀", "woman juggling: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏻\u200d♀️", "woman juggling: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏻\u200d♂", "man juggling: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏻\u200d♂️", "man juggling: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏼", "person juggling: medium-light skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤹🏼\u200d♀", "woman juggling: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏼\u200d♀️", "woman juggling: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏼\u200d♂", "man juggling: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏼\u200d♂️", "man juggling: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏽", "person juggling: medium skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤹🏽\u200d♀", "woman juggling: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏽\u200d♀️", "woman juggling: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏽\u200d♂", "man juggling: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏽\u200d♂️", "man juggling: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏾", "person juggling: medium-dark skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤹🏾\u200d♀", "woman juggling: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏾\u200d♀️", "woman juggling: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏾\u200d♂", "man juggling: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏾\u200d♂️", "man juggling: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏿", "person juggling: dark skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤹🏿\u200d♀", "woman juggling: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏿\u200d♀️", "woman juggling: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤹🏿\u200d♂", "man juggling: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤹🏿\u200d♂️", "man juggling: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤺", "person fencing", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤼", "people wrestling", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤼\u200d♀", "women wrestling", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤼\u200d♀️", "women wrestling", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤼\u200d♂", "men wrestling", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤼\u200d♂️", "men wrestling", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤼🏻", "people wrestling: light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏻\u200d♀", "women wrestling: light skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏻\u200d♀️", "women wrestling: light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏻\u200d♂", "men wrestling: light skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏻\u200d♂️", "men wrestling: light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏼", "people wrestling: medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏼\u200d♀", "women wrestling: medium-light skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏼\u200d♀️", "women wrestling: medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏼\u200d♂", "men wrestling: medium-light skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏼\u200d♂️", "men wrestling: medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏽", "people wrestling: medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏽\u200d♀", "women wrestling: medium skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏽\u200d♀️", "women wrestling: medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏽\u200d♂", "men wrestling: medium skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏽\u200d♂️", "men wrestling: medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏾", "people wrestling: medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏾\u200d♀", "women wrestling: medium-dark skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏾\u200d♀️", "women wrestling: medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏾\u200d♂", "men wrestling: medium-dark skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏾\u200d♂️", "men wrestling: medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏿", "people wrestling: dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏿\u200d♀", "women wrestling: dark skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏿\u200d♀️", "women wrestling: dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤼🏿\u200d♂", "men wrestling: dark skin tone", "People & Body", "person-sport", E17_0, MinimallyQualified},
+	{"🤼🏿\u200d♂️", "men wrestling: dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🤽", "person playing water polo", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤽\u200d♀", "woman playing water polo", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽\u200d♀️", "woman playing water polo", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽\u200d♂", "man playing water polo", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽\u200d♂️", "man playing water polo", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏻", "person playing water polo: light skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤽🏻\u200d♀", "woman playing water polo: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏻\u200d♀️", "woman playing water polo: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏻\u200d♂", "man playing water polo: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏻\u200d♂️", "man playing water polo: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏼", "person playing water polo: medium-light skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤽🏼\u200d♀", "woman playing water polo: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏼\u200d♀️", "woman playing water polo: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏼\u200d♂", "man playing water polo: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏼\u200d♂️", "man playing water polo: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏽", "person playing water polo: medium skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤽🏽\u200d♀", "woman playing water polo: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏽\u200d♀️", "woman playing water polo: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏽\u200d♂", "man playing water polo: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏽\u200d♂️", "man playing water polo: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏾", "person playing water polo: medium-dark skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤽🏾\u200d♀", "woman playing water polo: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏾\u200d♀️", "woman playing water polo: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏾\u200d♂", "man playing water polo: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏾\u200d♂️", "man playing water polo: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏿", "person playing water polo: dark skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤽🏿\u200d♀", "woman playing water polo: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏿\u200d♀️", "woman playing water polo: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤽🏿\u200d♂", "man playing water polo: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤽🏿\u200d♂️", "man playing water polo: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾", "person playing handball", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤾\u200d♀", "woman playing handball", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾\u200d♀️", "woman playing handball", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾\u200d♂", "man playing handball", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾\u200d♂️", "man playing handball", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏻", "person playing handball: light skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤾🏻\u200d♀", "woman playing handball: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏻\u200d♀️", "woman playing handball: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏻\u200d♂", "man playing handball: light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏻\u200d♂️", "man playing handball: light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏼", "person playing handball: medium-light skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤾🏼\u200d♀", "woman playing handball: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏼\u200d♀️", "woman playing handball: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏼\u200d♂", "man playing handball: medium-light skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏼\u200d♂️", "man playing handball: medium-light skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏽", "person playing handball: medium skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤾🏽\u200d♀", "woman playing handball: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏽\u200d♀️", "woman playing handball: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏽\u200d♂", "man playing handball: medium skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏽\u200d♂️", "man playing handball: medium skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏾", "person playing handball: medium-dark skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤾🏾\u200d♀", "woman playing handball: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏾\u200d♀️", "woman playing handball: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏾\u200d♂", "man playing handball: medium-dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏾\u200d♂️", "man playing handball: medium-dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏿", "person playing handball: dark skin tone", "People & Body", "person-sport", E3_0, FullyQualified},
+	{"🤾🏿\u200d♀", "woman playing handball: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏿\u200d♀️", "woman playing handball: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤾🏿\u200d♂", "man playing handball: dark skin tone", "People & Body", "person-sport", E4_0, MinimallyQualified},
+	{"🤾🏿\u200d♂️", "man playing handball: dark skin tone", "People & Body", "person-sport", E4_0, FullyQualified},
+	{"🤿", "diving mask", "Activities", "sport", E12_0, FullyQualified},
+	{"🥀", "wilted flower", "Animals & Nature", "plant-flower", E3_0, FullyQualified},
+	{"🥁", "drum", "Objects", "musical-instrument", E3_0, FullyQualified},
+	{"🥂", "clinking glasses", "Food & Drink", "drink", E3_0, FullyQualified},
+	{"🥃", "tumbler glass", "Food & Drink", "drink", E3_0, FullyQualified},
+	{"🥄", "spoon", "Food & Drink", "dishware", E3_0, FullyQualified},
+	{"🥅", "goal net", "Activities", "sport", E3_0, FullyQualified},
+	{"🥇", "1st place medal", "Activities", "award-medal", E3_0, FullyQualified},
+	{"🥈", "2nd place medal", "Activities", "award-medal", E3_0, FullyQualified},
+	{"🥉", "3rd place medal", "Activities", "award-medal", E3_0, FullyQualified},
+	{"🥊", "boxing glove", "Activities", "sport", E3_0, FullyQualified},
+	{"🥋", "martial arts uniform", "Activities", "sport", E3_0, FullyQualified},
+	{"🥌", "curling stone", "Activities", "sport", E5_0, FullyQualified},
+	{"🥍", "lacrosse", "Activities", "sport", E11_0, FullyQualified},
+	{"🥎", "softball", "Activities", "sport", E11_0, FullyQualified},
+	{"🥏", "flying disc", "Activities", "sport", E11_0, FullyQualified},
+	{"🥐", "croissant", "Food & Drink", "food-prepared", E3_0, FullyQualified},
+	{"🥑", "avocado", "Food & Drink", "food-vegetable", E3_0, FullyQualified},
+	{"🥒", "cucumber", "Food & Drink", "food-vegetable", E3_0, FullyQualified},
+	{"🥓", "bacon", "Food & Drink", "food-prepared", E3_0, FullyQualified},
+	{"🥔", "potato", "Food & Drink", "food-vegetable", E3_0, FullyQualified},
+	{"🥕", "carrot", "Food & Drink", "food-vegetable", E3_0, FullyQualified},
+	{"🥖", "baguette bread", "Food & Drink", "food-prepared", E3_0, FullyQualified},
+	{"🥗", "green salad", "Food & Drink", "food-prepared", E3_0, FullyQualified},
+	{"🥘", "shallow pan of food", "Food & Drink", "food-prepared", E3_0, FullyQualified},
+	{"🥙", "stuffed flatbread", "Food & Drink", "food-prepared", E3_0, FullyQualified},
+	{"🥚", "egg", "Food & Drink", "food-prepared", E3_0, FullyQualified},
+	{"🥛", "glass of milk", "Food & Drink", "drink", E3_0, FullyQualified},
+	{"🥜", "peanuts", "Food & Drink", "food-vegetable", E3_0, FullyQualified},
+	{"🥝", "kiwi fruit", "Food & Drink", "food-fruit", E3_0, FullyQualified},
+	{"🥞", "pancakes", "Food & Drink", "food-prepared", E3_0, FullyQualified},
+	{"🥟", "dumpling", "Food & Drink", "food-asian", E5_0, FullyQualified},
+	{"🥠", "fortune cookie", "Food & Drink", "food-asian", E5_0, FullyQualified},
+	{"🥡", "takeout box", "Food & Drink", "food-asian", E5_0, FullyQualified},
+	{"🥢", "chopsticks", "Food & Drink", "dishware", E5_0, FullyQualified},
+	{"🥣", "bowl with spoon", "Food & Drink", "food-prepared", E5_0, FullyQualified},
+	{"🥤", "cup with straw", "Food & Drink", "drink", E5_0, FullyQualified},
+	{"🥥", "coconut", "Food & Drink", "food-fruit", E5_0, FullyQualified},
+	{"🥦", "broccoli", "Food & Drink", "food-vegetable", E5_0, FullyQualified},
+	{"🥧", "pie", "Food & Drink", "food-sweet", E5_0, FullyQualified},
+	{"🥨", "pretzel", "Food & Drink", "food-prepared", E5_0, FullyQualified},
+	{"🥩", "cut of meat", "Food & Drink", "food-prepared", E5_0, FullyQualified},
+	{"🥪", "sandwich", "Food & Drink", "food-prepared", E5_0, FullyQualified},
+	{"🥫", "canned food", "Food & Drink", "food-prepared", E5_0, FullyQualified},
+	{"🥬", "leafy green", "Food & Drink", "food-vegetable", E11_0, FullyQualified},
+	{"🥭", "mango", "Food & Drink", "food-fruit", E11_0, FullyQualified},
+	{"🥮", "moon cake", "Food & Drink", "food-asian", E11_0, FullyQualified},
+	{"🥯", "bagel", "Food & Drink", "food-prepared", E11_0, FullyQualified},
+	{"🥰", "smiling face with hearts", "Smileys & Emotion", "face-affection", E11_0, FullyQualified},
+	{"🥱", "yawning face", "Smileys & Emotion", "face-concerned", E12_0, FullyQualified},
+	{"🥲", "smiling face with tear", "Smileys & Emotion", "face-affection", E13_0, FullyQualified},
+	{"🥳", "partying face", "Smileys & Emotion", "face-hat", E11_0, FullyQualified},
+	{"🥴", "woozy face", "Smileys & Emotion", "face-unwell", E11_0, FullyQualified},
+	{"🥵", "hot face", "Smileys & Emotion", "face-unwell", E11_0, FullyQualified},
+	{"🥶", "cold face", "Smileys & Emotion", "face-unwell", E11_0, FullyQualified},
+	{"🥷", "ninja", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🥷🏻", "ninja: light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🥷🏼", "ninja: medium-light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🥷🏽", "ninja: medium skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🥷🏾", "ninja: medium-dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🥷🏿", "ninja: dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🥸", "disguised face", "Smileys & Emotion", "face-hat", E13_0, FullyQualified},
+	{"🥹", "face holding back tears", "Smileys & Emotion", "face-concerned", E14_0, FullyQualified},
+	{"🥺", "pleading face", "Smileys & Emotion", "face-concerned", E11_0, FullyQualified},
+	{"🥻", "sari", "Objects", "clothing", E12_0, FullyQualified},
+	{"🥼", "lab coat", "Objects", "clothing", E11_0, FullyQualified},
+	{"🥽", "goggles", "Objects", "clothing", E11_0, FullyQualified},
+	{"🥾", "hiking boot", "Objects", "clothing", E11_0, FullyQualified},
+	{"🥿", "flat shoe", "Objects", "clothing", E11_0, FullyQualified},
+	{"🦀", "crab", "Food & Drink", "food-marine", E1_0, FullyQualified},
+	{"🦁", "lion", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🦂", "scorpion", "Animals & Nature", "animal-bug", E1_0, FullyQualified},
+	{"🦃", "turkey", "Animals & Nature", "animal-bird", E1_0, FullyQualified},
+	{"🦄", "unicorn", "Animals & Nature", "animal-mammal", E1_0, FullyQualified},
+	{"🦅", "eagle", "Animals & Nature", "animal-bird", E3_0, FullyQualified},
+	{"🦆", "duck", "Animals & Nature", "animal-bird", E3_0, FullyQualified},
+	{"🦇", "bat", "Animals & Nature", "animal-mammal", E3_0, FullyQualified},
+	{"🦈", "shark", "Animals & Nature", "animal-marine", E3_0, FullyQualified},
+	{"🦉", "owl", "Animals & Nature", "animal-bird", E3_0, FullyQualified},
+	{"🦊", "fox", "Animals & Nature", "animal-mammal", E3_0, FullyQualified},
+	{"🦋", "butterfly", "Animals & Nature", "animal-bug", E3_0, FullyQualified},
+	{"🦌", "deer", "Animals & Nature", "animal-mammal", E3_0, FullyQualified},
+	{"🦍", "gorilla", "Animals & Nature", "animal-mammal", E3_0, FullyQualified},
+	{"🦎", "lizard", "Animals & Nature", "animal-reptile", E3_0, FullyQualified},
+	{"🦏", "rhinoceros", "Animals & Nature", "animal-mammal", E3_0, FullyQualified},
+	{"🦐", "shrimp", "Food & Drink", "food-marine", E3_0, FullyQualified},
+	{"🦑", "squid", "Food & Drink", "food-marine", E3_0, FullyQualified},
+	{"🦒", "giraffe", "Animals & Nature", "animal-mammal", E5_0, FullyQualified},
+	{"🦓", "zebra", "Animals & Nature", "animal-mammal", E5_0, FullyQualified},
+	{"🦔", "hedgehog", "Animals & Nature", "animal-mammal", E5_0, FullyQualified},
+	{"🦕", "sauropod", "Animals & Nature", "animal-reptile", E5_0, FullyQualified},
+	{"🦖", "T-Rex", "Animals & Nature", "animal-reptile", E5_0, FullyQualified},
+	{"🦗", "cricket", "Animals & Nature", "animal-bug", E5_0, FullyQualified},
+	{"🦘", "kangaroo", "Animals & Nature", "animal-mammal", E11_0, FullyQualified},
+	{"🦙", "llama", "Animals & Nature", "animal-mammal", E11_0, FullyQualified},
+	{"🦚", "peacock", "Animals & Nature", "animal-bird", E11_0, FullyQualified},
+	{"🦛", "hippopotamus", "Animals & Nature", "animal-mammal", E11_0, FullyQualified},
+	{"🦜", "parrot", "Animals & Nature", "animal-bird", E11_0, FullyQualified},
+	{"🦝", "raccoon", "Animals & Nature", "animal-mammal", E11_0, FullyQualified},
+	{"🦞", "lobster", "Food & Drink", "food-marine", E11_0, FullyQualified},
+	{"🦟", "mosquito", "Animals & Nature", "animal-bug", E11_0, FullyQualified},
+	{"🦠", "microbe", "Animals & Nature", "animal-bug", E11_0, FullyQualified},
+	{"🦡", "badger", "Animals & Nature", "animal-mammal", E11_0, FullyQualified},
+	{"🦢", "swan", "Animals & Nature", "animal-bird", E11_0, FullyQualified},
+	{"🦣", "mammoth", "Animals & Nature", "animal-mammal", E13_0, FullyQualified},
+	{"🦤", "dodo", "Animals & Nature", "animal-bird", E13_0, FullyQualified},
+	{"🦥", "sloth", "Animals & Nature", "animal-mammal", E12_0, FullyQualified},
+	{"🦦", "otter", "Animals & Nature", "animal-mammal", E12_0, FullyQualified},
+	{"🦧", "orangutan", "Animals & Nature", "animal-mammal", E12_0, FullyQualified},
+	{"🦨", "skunk", "Animals & Nature", "animal-mammal", E12_0, FullyQualified},
+	{"🦩", "flamingo", "Animals & Nature", "animal-bird", E12_0, FullyQualified},
+	{"🦪", "oyster", "Food & Drink", "food-marine", E12_0, FullyQualified},
+	{"🦫", "beaver", "Animals & Nature", "animal-mammal", E13_0, FullyQualified},
+	{"🦬", "bison", "Animals & Nature", "animal-mammal", E13_0, FullyQualified},
+	{"🦭", "seal", "Animals & Nature", "animal-marine", E13_0, FullyQualified},
+	{"🦮", "guide dog", "Animals & Nature", "animal-mammal", E12_0, FullyQualified},
+	{"🦯", "white cane", "Objects", "tool", E12_0, FullyQualified},
+	{"🦰", "red hair", "Component", "hair-style", E11_0, Component},
+	{"🦱", "curly hair", "Component", "hair-style", E11_0, Component},
+	{"🦲", "bald", "Component", "hair-style", E11_0, Component},
+	{"🦳", "white hair", "Component", "hair-style", E11_0, Component},
+	{"🦴", "bone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦵", "leg", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦵🏻", "leg: light skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦵🏼", "leg: medium-light skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦵🏽", "leg: medium skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦵🏾", "leg: medium-dark skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦵🏿", "leg: dark skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦶", "foot", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦶🏻", "foot: light skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦶🏼", "foot: medium-light skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦶🏽", "foot: medium skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦶🏾", "foot: medium-dark skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦶🏿", "foot: dark skin tone", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦷", "tooth", "People & Body", "body-parts", E11_0, FullyQualified},
+	{"🦸", "superhero", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸\u200d♀", "woman superhero", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸\u200d♀️", "woman superhero", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸\u200d♂", "man superhero", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸\u200d♂️", "man superhero", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏻", "superhero: light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏻\u200d♀", "woman superhero: light skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏻\u200d♀️", "woman superhero: light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏻\u200d♂", "man superhero: light skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏻\u200d♂️", "man superhero: light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏼", "superhero: medium-light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏼\u200d♀", "woman superhero: medium-light skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏼\u200d♀️", "woman superhero: medium-light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏼\u200d♂", "man superhero: medium-light skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏼\u200d♂️", "man superhero: medium-light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏽", "superhero: medium skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏽\u200d♀", "woman superhero: medium skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏽\u200d♀️", "woman superhero: medium skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏽\u200d♂", "man superhero: medium skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏽\u200d♂️", "man superhero: medium skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏾", "superhero: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏾\u200d♀", "woman superhero: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏾\u200d♀️", "woman superhero: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏾\u200d♂", "man superhero: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏾\u200d♂️", "man superhero: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏿", "superhero: dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏿\u200d♀", "woman superhero: dark skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏿\u200d♀️", "woman superhero: dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦸🏿\u200d♂", "man superhero: dark skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦸🏿\u200d♂️", "man superhero: dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹", "supervillain", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹\u200d♀", "woman supervillain", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹\u200d♀️", "woman supervillain", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹\u200d♂", "man supervillain", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹\u200d♂️", "man supervillain", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏻", "supervillain: light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏻\u200d♀", "woman supervillain: light skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏻\u200d♀️", "woman supervillain: light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏻\u200d♂", "man supervillain: light skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏻\u200d♂️", "man supervillain: light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏼", "supervillain: medium-light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏼\u200d♀", "woman supervillain: medium-light skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏼\u200d♀️", "woman supervillain: medium-light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏼\u200d♂", "man supervillain: medium-light skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏼\u200d♂️", "man supervillain: medium-light skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏽", "supervillain: medium skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏽\u200d♀", "woman supervillain: medium skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏽\u200d♀️", "woman supervillain: medium skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏽\u200d♂", "man supervillain: medium skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏽\u200d♂️", "man supervillain: medium skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏾", "supervillain: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏾\u200d♀", "woman supervillain: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏾\u200d♀️", "woman supervillain: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏾\u200d♂", "man supervillain: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏾\u200d♂️", "man supervillain: medium-dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏿", "supervillain: dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏿\u200d♀", "woman supervillain: dark skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏿\u200d♀️", "woman supervillain: dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦹🏿\u200d♂", "man supervillain: dark skin tone", "People & Body", "person-fantasy", E11_0, MinimallyQualified},
+	{"🦹🏿\u200d♂️", "man supervillain: dark skin tone", "People & Body", "person-fantasy", E11_0, FullyQualified},
+	{"🦺", "safety vest", "Objects", "clothing", E12_0, FullyQualified},
+	{"🦻", "ear with hearing aid", "People & Body", "body-parts", E12_0, FullyQualified},
+	{"🦻🏻", "ear with hearing aid: light skin tone", "People & Body", "body-parts", E12_0, FullyQualified},
+	{"🦻🏼", "ear with hearing aid: medium-light skin tone", "People & Body", "body-parts", E12_0, FullyQualified},
+	{"🦻🏽", "ear with hearing aid: medium skin tone", "People & Body", "body-parts", E12_0, FullyQualified},
+	{"🦻🏾", "ear with hearing aid: medium-dark skin tone", "People & Body", "body-parts", E12_0, FullyQualified},
+	{"🦻🏿", "ear with hearing aid: dark skin tone", "People & Body", "body-parts", E12_0, FullyQualified},
+	{"🦼", "motorized wheelchair", "Travel & Places", "transport-ground", E12_0, FullyQualified},
+	{"🦽", "manual wheelchair", "Travel & Places", "transport-ground", E12_0, FullyQualified},
+	{"🦾", "mechanical arm", "People & Body", "body-parts", E12_0, FullyQualified},
+	{"🦿", "mechanical leg", "People & Body", "body-parts", E12_0, FullyQualified},
+	{"🧀", "cheese wedge", "Food & Drink", "food-prepared", E1_0, FullyQualified},
+	{"🧁", "cupcake", "Food & Drink", "food-sweet", E11_0, FullyQualified},
+	{"🧂", "salt", "Food & Drink", "food-prepared", E11_0, FullyQualified},
+	{"🧃", "beverage box", "Food & Drink", "drink", E12_0, FullyQualified},
+	{"🧄", "garlic", "Food & Drink", "food-vegetable", E12_0, FullyQualified},
+	{"🧅", "onion", "Food & Drink", "food-vegetable", E12_0, FullyQualified},
+	{"🧆", "falafel", "Food & Drink", "food-prepared", E12_0, FullyQualified},
+	{"🧇", "waffle", "Food & Drink", "food-prepared", E12_0, FullyQualified},
+	{"🧈", "butter", "Food & Drink", "food-prepared", E12_0, FullyQualified},
+	{"🧉", "mate", "Food & Drink", "drink", E12_0, FullyQualified},
+	{"🧊", "ice", "Food & Drink", "drink", E12_0, FullyQualified},
+	{"🧋", "bubble tea", "Food & Drink", "drink", E13_0, FullyQualified},
+	{"🧌", "troll", "People & Body", "person-fantasy", E14_0, FullyQualified},
+	{"🧍", "person standing", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍\u200d♀", "woman standing", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍\u200d♀️", "woman standing", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍\u200d♂", "man standing", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍\u200d♂️", "man standing", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏻", "person standing: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏻\u200d♀", "woman standing: light skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏻\u200d♀️", "woman standing: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏻\u200d♂", "man standing: light skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏻\u200d♂️", "man standing: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏼", "person standing: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏼\u200d♀", "woman standing: medium-light skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏼\u200d♀️", "woman standing: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏼\u200d♂", "man standing: medium-light skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏼\u200d♂️", "man standing: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏽", "person standing: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏽\u200d♀", "woman standing: medium skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏽\u200d♀️", "woman standing: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏽\u200d♂", "man standing: medium skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏽\u200d♂️", "man standing: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏾", "person standing: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏾\u200d♀", "woman standing: medium-dark skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏾\u200d♀️", "woman standing: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏾\u200d♂", "man standing: medium-dark skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏾\u200d♂️", "man standing: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏿", "person standing: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏿\u200d♀", "woman standing: dark skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏿\u200d♀️", "woman standing: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧍🏿\u200d♂", "man standing: dark skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧍🏿\u200d♂️", "man standing: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎", "person kneeling", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎\u200d♀", "woman kneeling", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎\u200d♀\u200d➡", "woman kneeling facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎\u200d♀\u200d➡️", "woman kneeling facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎\u200d♀️", "woman kneeling", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎\u200d♀️\u200d➡", "woman kneeling facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎\u200d♀️\u200d➡️", "woman kneeling facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎\u200d♂", "man kneeling", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎\u200d♂\u200d➡", "man kneeling facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎\u200d♂\u200d➡️", "man kneeling facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎\u200d♂️", "man kneeling", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎\u200d♂️\u200d➡", "man kneeling facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎\u200d♂️\u200d➡️", "man kneeling facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎\u200d➡", "person kneeling facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎\u200d➡️", "person kneeling facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏻", "person kneeling: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏻\u200d♀", "woman kneeling: light skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏻\u200d♀\u200d➡", "woman kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏻\u200d♀\u200d➡️", "woman kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏻\u200d♀️", "woman kneeling: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏻\u200d♀️\u200d➡", "woman kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏻\u200d♀️\u200d➡️", "woman kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏻\u200d♂", "man kneeling: light skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏻\u200d♂\u200d➡", "man kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏻\u200d♂\u200d➡️", "man kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏻\u200d♂️", "man kneeling: light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏻\u200d♂️\u200d➡", "man kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏻\u200d♂️\u200d➡️", "man kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏻\u200d➡", "person kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏻\u200d➡️", "person kneeling facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏼", "person kneeling: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏼\u200d♀", "woman kneeling: medium-light skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏼\u200d♀\u200d➡", "woman kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏼\u200d♀\u200d➡️", "woman kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏼\u200d♀️", "woman kneeling: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏼\u200d♀️\u200d➡", "woman kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏼\u200d♀️\u200d➡️", "woman kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏼\u200d♂", "man kneeling: medium-light skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏼\u200d♂\u200d➡", "man kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏼\u200d♂\u200d➡️", "man kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏼\u200d♂️", "man kneeling: medium-light skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏼\u200d♂️\u200d➡", "man kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏼\u200d♂️\u200d➡️", "man kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏼\u200d➡", "person kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏼\u200d➡️", "person kneeling facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏽", "person kneeling: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏽\u200d♀", "woman kneeling: medium skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏽\u200d♀\u200d➡", "woman kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏽\u200d♀\u200d➡️", "woman kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏽\u200d♀️", "woman kneeling: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏽\u200d♀️\u200d➡", "woman kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏽\u200d♀️\u200d➡️", "woman kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏽\u200d♂", "man kneeling: medium skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏽\u200d♂\u200d➡", "man kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏽\u200d♂\u200d➡️", "man kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏽\u200d♂️", "man kneeling: medium skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏽\u200d♂️\u200d➡", "man kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏽\u200d♂️\u200d➡️", "man kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏽\u200d➡", "person kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏽\u200d➡️", "person kneeling facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏾", "person kneeling: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏾\u200d♀", "woman kneeling: medium-dark skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏾\u200d♀\u200d➡", "woman kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏾\u200d♀\u200d➡️", "woman kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏾\u200d♀️", "woman kneeling: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏾\u200d♀️\u200d➡", "woman kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏾\u200d♀️\u200d➡️", "woman kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏾\u200d♂", "man kneeling: medium-dark skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏾\u200d♂\u200d➡", "man kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏾\u200d♂\u200d➡️", "man kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏾\u200d♂️", "man kneeling: medium-dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏾\u200d♂️\u200d➡", "man kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏾\u200d♂️\u200d➡️", "man kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏾\u200d➡", "person kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏾\u200d➡️", "person kneeling facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏿", "person kneeling: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏿\u200d♀", "woman kneeling: dark skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏿\u200d♀\u200d➡", "woman kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏿\u200d♀\u200d➡️", "woman kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏿\u200d♀️", "woman kneeling: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏿\u200d♀️\u200d➡", "woman kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏿\u200d♀️\u200d➡️", "woman kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏿\u200d♂", "man kneeling: dark skin tone", "People & Body", "person-activity", E12_0, MinimallyQualified},
+	{"🧎🏿\u200d♂\u200d➡", "man kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏿\u200d♂\u200d➡️", "man kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏿\u200d♂️", "man kneeling: dark skin tone", "People & Body", "person-activity", E12_0, FullyQualified},
+	{"🧎🏿\u200d♂️\u200d➡", "man kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏿\u200d♂️\u200d➡️", "man kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧎🏿\u200d➡", "person kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧎🏿\u200d➡️", "person kneeling facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧏", "deaf person", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏\u200d♀", "deaf woman", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏\u200d♀️", "deaf woman", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏\u200d♂", "deaf man", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏\u200d♂️", "deaf man", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏻", "deaf person: light skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏻\u200d♀", "deaf woman: light skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏻\u200d♀️", "deaf woman: light skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏻\u200d♂", "deaf man: light skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏻\u200d♂️", "deaf man: light skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏼", "deaf person: medium-light skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏼\u200d♀", "deaf woman: medium-light skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏼\u200d♀️", "deaf woman: medium-light skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏼\u200d♂", "deaf man: medium-light skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏼\u200d♂️", "deaf man: medium-light skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏽", "deaf person: medium skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏽\u200d♀", "deaf woman: medium skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏽\u200d♀️", "deaf woman: medium skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏽\u200d♂", "deaf man: medium skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏽\u200d♂️", "deaf man: medium skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏾", "deaf person: medium-dark skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏾\u200d♀", "deaf woman: medium-dark skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏾\u200d♀️", "deaf woman: medium-dark skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏾\u200d♂", "deaf man: medium-dark skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏾\u200d♂️", "deaf man: medium-dark skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏿", "deaf person: dark skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏿\u200d♀", "deaf woman: dark skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏿\u200d♀️", "deaf woman: dark skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧏🏿\u200d♂", "deaf man: dark skin tone", "People & Body", "person-gesture", E12_0, MinimallyQualified},
+	{"🧏🏿\u200d♂️", "deaf man: dark skin tone", "People & Body", "person-gesture", E12_0, FullyQualified},
+	{"🧐", "face with monocle", "Smileys & Emotion", "face-glasses", E5_0, FullyQualified},
+	{"🧑", "person", "People & Body", "person", E5_0, FullyQualified},
+	{"🧑\u200d⚕", "health worker", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑\u200d⚕️", "health worker", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d⚖", "judge", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑\u200d⚖️", "judge", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d✈", "pilot", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑\u200d✈️", "pilot", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🌾", "farmer", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🍳", "cook", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🍼", "person feeding baby", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🧑\u200d🎄", "Mx Claus", "People & Body", "person-fantasy", E13_0, FullyQualified},
+	{"🧑\u200d🎓", "student", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🎤", "singer", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🎨", "artist", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🏫", "teacher", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🏭", "factory worker", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d💻", "technologist", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d💼", "office worker", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🔧", "mechanic", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🔬", "scientist", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🚀", "astronaut", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🚒", "firefighter", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑\u200d🤝\u200d🧑", "people holding hands", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑\u200d🦯", "person with white cane", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑\u200d🦯\u200d➡", "person with white cane facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑\u200d🦯\u200d➡️", "person with white cane facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑\u200d🦰", "person: red hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑\u200d🦱", "person: curly hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑\u200d🦲", "person: bald", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑\u200d🦳", "person: white hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑\u200d🦼", "person in motorized wheelchair", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑\u200d🦼\u200d➡", "person in motorized wheelchair facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑\u200d🦼\u200d➡️", "person in motorized wheelchair facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑\u200d🦽", "person in manual wheelchair", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑\u200d🦽\u200d➡", "person in manual wheelchair facing right", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑\u200d🦽\u200d➡️", "person in manual wheelchair facing right", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑\u200d🧑\u200d🧒", "family: adult, adult, child", "People & Body", "person-symbol", E15_1, FullyQualified},
+	{"🧑\u200d🧑\u200d🧒\u200d🧒", "family: adult, adult, child, child", "People & Body", "person-symbol", E15_1, FullyQualified},
+	{"🧑\u200d🧒", "family: adult, child", "People & Body", "person-symbol", E15_1, FullyQualified},
+	{"🧑\u200d🧒\u200d🧒", "family: adult, child, child", "People & Body", "person-symbol", E15_1, FullyQualified},
+	{"🧑\u200d🩰", "ballet dancer", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏻", "person: light skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧑🏻\u200d⚕", "health worker: light skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏻\u200d⚕️", "health worker: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d⚖", "judge: light skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏻\u200d⚖️", "judge: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d✈", "pilot: light skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏻\u200d✈️", "pilot: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d❤\u200d💋\u200d🧑🏼", "kiss: person, person, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏻\u200d❤\u200d💋\u200d🧑🏽", "kiss: person, person, light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏻\u200d❤\u200d💋\u200d🧑🏾", "kiss: person, person, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏻\u200d❤\u200d💋\u200d🧑🏿", "kiss: person, person, light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏻\u200d❤\u200d🧑🏼", "couple with heart: person, person, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏻\u200d❤\u200d🧑🏽", "couple with heart: person, person, light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏻\u200d❤\u200d🧑🏾", "couple with heart: person, person, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏻\u200d❤\u200d🧑🏿", "couple with heart: person, person, light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏼", "kiss: person, person, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏽", "kiss: person, person, light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏾", "kiss: person, person, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏻\u200d❤️\u200d💋\u200d🧑🏿", "kiss: person, person, light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏻\u200d❤️\u200d🧑🏼", "couple with heart: person, person, light skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏻\u200d❤️\u200d🧑🏽", "couple with heart: person, person, light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏻\u200d❤️\u200d🧑🏾", "couple with heart: person, person, light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏻\u200d❤️\u200d🧑🏿", "couple with heart: person, person, light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏻\u200d🌾", "farmer: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🍳", "cook: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🍼", "person feeding baby: light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🧑🏻\u200d🎄", "Mx Claus: light skin tone", "People & Body", "person-fantasy", E13_0, FullyQualified},
+	{"🧑🏻\u200d🎓", "student: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🎤", "singer: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🎨", "artist: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🏫", "teacher: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🏭", "factory worker: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🐰\u200d🧑🏼", "people with bunny ears: light skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏻\u200d🐰\u200d🧑🏽", "people with bunny ears: light skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏻\u200d🐰\u200d🧑🏾", "people with bunny ears: light skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏻\u200d🐰\u200d🧑🏿", "people with bunny ears: light skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏻\u200d💻", "technologist: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d💼", "office worker: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🔧", "mechanic: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🔬", "scientist: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🚀", "astronaut: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🚒", "firefighter: light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏻\u200d🤝\u200d🧑🏻", "people holding hands: light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏻\u200d🤝\u200d🧑🏼", "people holding hands: light skin tone, medium-light skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏻\u200d🤝\u200d🧑🏽", "people holding hands: light skin tone, medium skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏻\u200d🤝\u200d🧑🏾", "people holding hands: light skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏻\u200d🤝\u200d🧑🏿", "people holding hands: light skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏻\u200d🦯", "person with white cane: light skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏻\u200d🦯\u200d➡", "person with white cane facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏻\u200d🦯\u200d➡️", "person with white cane facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏻\u200d🦰", "person: light skin tone, red hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏻\u200d🦱", "person: light skin tone, curly hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏻\u200d🦲", "person: light skin tone, bald", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏻\u200d🦳", "person: light skin tone, white hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏻\u200d🦼", "person in motorized wheelchair: light skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏻\u200d🦼\u200d➡", "person in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏻\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏻\u200d🦽", "person in manual wheelchair: light skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏻\u200d🦽\u200d➡", "person in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏻\u200d🦽\u200d➡️", "person in manual wheelchair facing right: light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏻\u200d🩰", "ballet dancer: light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏻\u200d🫯\u200d🧑🏼", "people wrestling: light skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏻\u200d🫯\u200d🧑🏽", "people wrestling: light skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏻\u200d🫯\u200d🧑🏾", "people wrestling: light skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏻\u200d🫯\u200d🧑🏿", "people wrestling: light skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏼", "person: medium-light skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧑🏼\u200d⚕", "health worker: medium-light skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏼\u200d⚕️", "health worker: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d⚖", "judge: medium-light skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏼\u200d⚖️", "judge: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d✈", "pilot: medium-light skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏼\u200d✈️", "pilot: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d❤\u200d💋\u200d🧑🏻", "kiss: person, person, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏼\u200d❤\u200d💋\u200d🧑🏽", "kiss: person, person, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏼\u200d❤\u200d💋\u200d🧑🏾", "kiss: person, person, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏼\u200d❤\u200d💋\u200d🧑🏿", "kiss: person, person, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏼\u200d❤\u200d🧑🏻", "couple with heart: person, person, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏼\u200d❤\u200d🧑🏽", "couple with heart: person, person, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏼\u200d❤\u200d🧑🏾", "couple with heart: person, person, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏼\u200d❤\u200d🧑🏿", "couple with heart: person, person, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏻", "kiss: person, person, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏽", "kiss: person, person, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏾", "kiss: person, person, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏼\u200d❤️\u200d💋\u200d🧑🏿", "kiss: person, person, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏼\u200d❤️\u200d🧑🏻", "couple with heart: person, person, medium-light skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏼\u200d❤️\u200d🧑🏽", "couple with heart: person, person, medium-light skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏼\u200d❤️\u200d🧑🏾", "couple with heart: person, person, medium-light skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏼\u200d❤️\u200d🧑🏿", "couple with heart: person, person, medium-light skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏼\u200d🌾", "farmer: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🍳", "cook: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🍼", "person feeding baby: medium-light skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🧑🏼\u200d🎄", "Mx Claus: medium-light skin tone", "People & Body", "person-fantasy", E13_0, FullyQualified},
+	{"🧑🏼\u200d🎓", "student: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🎤", "singer: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🎨", "artist: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🏫", "teacher: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🏭", "factory worker: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🐰\u200d🧑🏻", "people with bunny ears: medium-light skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏼\u200d🐰\u200d🧑🏽", "people with bunny ears: medium-light skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏼\u200d🐰\u200d🧑🏾", "people with bunny ears: medium-light skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏼\u200d🐰\u200d🧑🏿", "people with bunny ears: medium-light skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏼\u200d💻", "technologist: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d💼", "office worker: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🔧", "mechanic: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🔬", "scientist: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🚀", "astronaut: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🚒", "firefighter: medium-light skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏼\u200d🤝\u200d🧑🏻", "people holding hands: medium-light skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏼\u200d🤝\u200d🧑🏼", "people holding hands: medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏼\u200d🤝\u200d🧑🏽", "people holding hands: medium-light skin tone, medium skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏼\u200d🤝\u200d🧑🏾", "people holding hands: medium-light skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏼\u200d🤝\u200d🧑🏿", "people holding hands: medium-light skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏼\u200d🦯", "person with white cane: medium-light skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏼\u200d🦯\u200d➡", "person with white cane facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏼\u200d🦯\u200d➡️", "person with white cane facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏼\u200d🦰", "person: medium-light skin tone, red hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏼\u200d🦱", "person: medium-light skin tone, curly hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏼\u200d🦲", "person: medium-light skin tone, bald", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏼\u200d🦳", "person: medium-light skin tone, white hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏼\u200d🦼", "person in motorized wheelchair: medium-light skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏼\u200d🦼\u200d➡", "person in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏼\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏼\u200d🦽", "person in manual wheelchair: medium-light skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏼\u200d🦽\u200d➡", "person in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏼\u200d🦽\u200d➡️", "person in manual wheelchair facing right: medium-light skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏼\u200d🩰", "ballet dancer: medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏼\u200d🫯\u200d🧑🏻", "people wrestling: medium-light skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏼\u200d🫯\u200d🧑🏽", "people wrestling: medium-light skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏼\u200d🫯\u200d🧑🏾", "people wrestling: medium-light skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏼\u200d🫯\u200d🧑🏿", "people wrestling: medium-light skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏽", "person: medium skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧑🏽\u200d⚕", "health worker: medium skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏽\u200d⚕️", "health worker: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d⚖", "judge: medium skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏽\u200d⚖️", "judge: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d✈", "pilot: medium skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏽\u200d✈️", "pilot: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d❤\u200d💋\u200d🧑🏻", "kiss: person, person, medium skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏽\u200d❤\u200d💋\u200d🧑🏼", "kiss: person, person, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏽\u200d❤\u200d💋\u200d🧑🏾", "kiss: person, person, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏽\u200d❤\u200d💋\u200d🧑🏿", "kiss: person, person, medium skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏽\u200d❤\u200d🧑🏻", "couple with heart: person, person, medium skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏽\u200d❤\u200d🧑🏼", "couple with heart: person, person, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏽\u200d❤\u200d🧑🏾", "couple with heart: person, person, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏽\u200d❤\u200d🧑🏿", "couple with heart: person, person, medium skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏻", "kiss: person, person, medium skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏼", "kiss: person, person, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏾", "kiss: person, person, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏽\u200d❤️\u200d💋\u200d🧑🏿", "kiss: person, person, medium skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏽\u200d❤️\u200d🧑🏻", "couple with heart: person, person, medium skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏽\u200d❤️\u200d🧑🏼", "couple with heart: person, person, medium skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏽\u200d❤️\u200d🧑🏾", "couple with heart: person, person, medium skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏽\u200d❤️\u200d🧑🏿", "couple with heart: person, person, medium skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏽\u200d🌾", "farmer: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🍳", "cook: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🍼", "person feeding baby: medium skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🧑🏽\u200d🎄", "Mx Claus: medium skin tone", "People & Body", "person-fantasy", E13_0, FullyQualified},
+	{"🧑🏽\u200d🎓", "student: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🎤", "singer: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🎨", "artist: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🏫", "teacher: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🏭", "factory worker: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🐰\u200d🧑🏻", "people with bunny ears: medium skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏽\u200d🐰\u200d🧑🏼", "people with bunny ears: medium skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏽\u200d🐰\u200d🧑🏾", "people with bunny ears: medium skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏽\u200d🐰\u200d🧑🏿", "people with bunny ears: medium skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏽\u200d💻", "technologist: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d💼", "office worker: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🔧", "mechanic: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🔬", "scientist: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🚀", "astronaut: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🚒", "firefighter: medium skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏽\u200d🤝\u200d🧑🏻", "people holding hands: medium skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏽\u200d🤝\u200d🧑🏼", "people holding hands: medium skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏽\u200d🤝\u200d🧑🏽", "people holding hands: medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏽\u200d🤝\u200d🧑🏾", "people holding hands: medium skin tone, medium-dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏽\u200d🤝\u200d🧑🏿", "people holding hands: medium skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏽\u200d🦯", "person with white cane: medium skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏽\u200d🦯\u200d➡", "person with white cane facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏽\u200d🦯\u200d➡️", "person with white cane facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏽\u200d🦰", "person: medium skin tone, red hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏽\u200d🦱", "person: medium skin tone, curly hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏽\u200d🦲", "person: medium skin tone, bald", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏽\u200d🦳", "person: medium skin tone, white hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏽\u200d🦼", "person in motorized wheelchair: medium skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏽\u200d🦼\u200d➡", "person in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏽\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏽\u200d🦽", "person in manual wheelchair: medium skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏽\u200d🦽\u200d➡", "person in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏽\u200d🦽\u200d➡️", "person in manual wheelchair facing right: medium skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏽\u200d🩰", "ballet dancer: medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏽\u200d🫯\u200d🧑🏻", "people wrestling: medium skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏽\u200d🫯\u200d🧑🏼", "people wrestling: medium skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏽\u200d🫯\u200d🧑🏾", "people wrestling: medium skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏽\u200d🫯\u200d🧑🏿", "people wrestling: medium skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏾", "person: medium-dark skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧑🏾\u200d⚕", "health worker: medium-dark skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏾\u200d⚕️", "health worker: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d⚖", "judge: medium-dark skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏾\u200d⚖️", "judge: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d✈", "pilot: medium-dark skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏾\u200d✈️", "pilot: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d❤\u200d💋\u200d🧑🏻", "kiss: person, person, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏾\u200d❤\u200d💋\u200d🧑🏼", "kiss: person, person, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏾\u200d❤\u200d💋\u200d🧑🏽", "kiss: person, person, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏾\u200d❤\u200d💋\u200d🧑🏿", "kiss: person, person, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏾\u200d❤\u200d🧑🏻", "couple with heart: person, person, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏾\u200d❤\u200d🧑🏼", "couple with heart: person, person, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏾\u200d❤\u200d🧑🏽", "couple with heart: person, person, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏾\u200d❤\u200d🧑🏿", "couple with heart: person, person, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏻", "kiss: person, person, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏼", "kiss: person, person, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏽", "kiss: person, person, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏾\u200d❤️\u200d💋\u200d🧑🏿", "kiss: person, person, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏾\u200d❤️\u200d🧑🏻", "couple with heart: person, person, medium-dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏾\u200d❤️\u200d🧑🏼", "couple with heart: person, person, medium-dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏾\u200d❤️\u200d🧑🏽", "couple with heart: person, person, medium-dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏾\u200d❤️\u200d🧑🏿", "couple with heart: person, person, medium-dark skin tone, dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏾\u200d🌾", "farmer: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🍳", "cook: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🍼", "person feeding baby: medium-dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🧑🏾\u200d🎄", "Mx Claus: medium-dark skin tone", "People & Body", "person-fantasy", E13_0, FullyQualified},
+	{"🧑🏾\u200d🎓", "student: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🎤", "singer: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🎨", "artist: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🏫", "teacher: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🏭", "factory worker: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🐰\u200d🧑🏻", "people with bunny ears: medium-dark skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏾\u200d🐰\u200d🧑🏼", "people with bunny ears: medium-dark skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏾\u200d🐰\u200d🧑🏽", "people with bunny ears: medium-dark skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏾\u200d🐰\u200d🧑🏿", "people with bunny ears: medium-dark skin tone, dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏾\u200d💻", "technologist: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d💼", "office worker: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🔧", "mechanic: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🔬", "scientist: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🚀", "astronaut: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🚒", "firefighter: medium-dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏾\u200d🤝\u200d🧑🏻", "people holding hands: medium-dark skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏾\u200d🤝\u200d🧑🏼", "people holding hands: medium-dark skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏾\u200d🤝\u200d🧑🏽", "people holding hands: medium-dark skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏾\u200d🤝\u200d🧑🏾", "people holding hands: medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏾\u200d🤝\u200d🧑🏿", "people holding hands: medium-dark skin tone, dark skin tone", "People & Body", "family", E12_1, FullyQualified},
+	{"🧑🏾\u200d🦯", "person with white cane: medium-dark skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏾\u200d🦯\u200d➡", "person with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏾\u200d🦯\u200d➡️", "person with white cane facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏾\u200d🦰", "person: medium-dark skin tone, red hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏾\u200d🦱", "person: medium-dark skin tone, curly hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏾\u200d🦲", "person: medium-dark skin tone, bald", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏾\u200d🦳", "person: medium-dark skin tone, white hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏾\u200d🦼", "person in motorized wheelchair: medium-dark skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏾\u200d🦼\u200d➡", "person in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏾\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏾\u200d🦽", "person in manual wheelchair: medium-dark skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏾\u200d🦽\u200d➡", "person in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏾\u200d🦽\u200d➡️", "person in manual wheelchair facing right: medium-dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏾\u200d🩰", "ballet dancer: medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏾\u200d🫯\u200d🧑🏻", "people wrestling: medium-dark skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏾\u200d🫯\u200d🧑🏼", "people wrestling: medium-dark skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏾\u200d🫯\u200d🧑🏽", "people wrestling: medium-dark skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏾\u200d🫯\u200d🧑🏿", "people wrestling: medium-dark skin tone, dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏿", "person: dark skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧑🏿\u200d⚕", "health worker: dark skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏿\u200d⚕️", "health worker: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d⚖", "judge: dark skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏿\u200d⚖️", "judge: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d✈", "pilot: dark skin tone", "People & Body", "person-role", E12_1, MinimallyQualified},
+	{"🧑🏿\u200d✈️", "pilot: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d❤\u200d💋\u200d🧑🏻", "kiss: person, person, dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏿\u200d❤\u200d💋\u200d🧑🏼", "kiss: person, person, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏿\u200d❤\u200d💋\u200d🧑🏽", "kiss: person, person, dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏿\u200d❤\u200d💋\u200d🧑🏾", "kiss: person, person, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏿\u200d❤\u200d🧑🏻", "couple with heart: person, person, dark skin tone, light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏿\u200d❤\u200d🧑🏼", "couple with heart: person, person, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏿\u200d❤\u200d🧑🏽", "couple with heart: person, person, dark skin tone, medium skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏿\u200d❤\u200d🧑🏾", "couple with heart: person, person, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, MinimallyQualified},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏻", "kiss: person, person, dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏼", "kiss: person, person, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏽", "kiss: person, person, dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏿\u200d❤️\u200d💋\u200d🧑🏾", "kiss: person, person, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏿\u200d❤️\u200d🧑🏻", "couple with heart: person, person, dark skin tone, light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏿\u200d❤️\u200d🧑🏼", "couple with heart: person, person, dark skin tone, medium-light skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏿\u200d❤️\u200d🧑🏽", "couple with heart: person, person, dark skin tone, medium skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏿\u200d❤️\u200d🧑🏾", "couple with heart: person, person, dark skin tone, medium-dark skin tone", "People & Body", "family", E13_1, FullyQualified},
+	{"🧑🏿\u200d🌾", "farmer: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🍳", "cook: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🍼", "person feeding baby: dark skin tone", "People & Body", "person-role", E13_0, FullyQualified},
+	{"🧑🏿\u200d🎄", "Mx Claus: dark skin tone", "People & Body", "person-fantasy", E13_0, FullyQualified},
+	{"🧑🏿\u200d🎓", "student: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🎤", "singer: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🎨", "artist: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🏫", "teacher: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🏭", "factory worker: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🐰\u200d🧑🏻", "people with bunny ears: dark skin tone, light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏿\u200d🐰\u200d🧑🏼", "people with bunny ears: dark skin tone, medium-light skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏿\u200d🐰\u200d🧑🏽", "people with bunny ears: dark skin tone, medium skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏿\u200d🐰\u200d🧑🏾", "people with bunny ears: dark skin tone, medium-dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏿\u200d💻", "technologist: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d💼", "office worker: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🔧", "mechanic: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🔬", "scientist: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🚀", "astronaut: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🚒", "firefighter: dark skin tone", "People & Body", "person-role", E12_1, FullyQualified},
+	{"🧑🏿\u200d🤝\u200d🧑🏻", "people holding hands: dark skin tone, light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏿\u200d🤝\u200d🧑🏼", "people holding hands: dark skin tone, medium-light skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏿\u200d🤝\u200d🧑🏽", "people holding hands: dark skin tone, medium skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏿\u200d🤝\u200d🧑🏾", "people holding hands: dark skin tone, medium-dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏿\u200d🤝\u200d🧑🏿", "people holding hands: dark skin tone", "People & Body", "family", E12_0, FullyQualified},
+	{"🧑🏿\u200d🦯", "person with white cane: dark skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏿\u200d🦯\u200d➡", "person with white cane facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏿\u200d🦯\u200d➡️", "person with white cane facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏿\u200d🦰", "person: dark skin tone, red hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏿\u200d🦱", "person: dark skin tone, curly hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏿\u200d🦲", "person: dark skin tone, bald", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏿\u200d🦳", "person: dark skin tone, white hair", "People & Body", "person", E12_1, FullyQualified},
+	{"🧑🏿\u200d🦼", "person in motorized wheelchair: dark skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏿\u200d🦼\u200d➡", "person in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏿\u200d🦼\u200d➡️", "person in motorized wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏿\u200d🦽", "person in manual wheelchair: dark skin tone", "People & Body", "person-activity", E12_1, FullyQualified},
+	{"🧑🏿\u200d🦽\u200d➡", "person in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, MinimallyQualified},
+	{"🧑🏿\u200d🦽\u200d➡️", "person in manual wheelchair facing right: dark skin tone", "People & Body", "person-activity", E15_1, FullyQualified},
+	{"🧑🏿\u200d🩰", "ballet dancer: dark skin tone", "People & Body", "person-activity", E17_0, FullyQualified},
+	{"🧑🏿\u200d🫯\u200d🧑🏻", "people wrestling: dark skin tone, light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏿\u200d🫯\u200d🧑🏼", "people wrestling: dark skin tone, medium-light skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏿\u200d🫯\u200d🧑🏽", "people wrestling: dark skin tone, medium skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧑🏿\u200d🫯\u200d🧑🏾", "people wrestling: dark skin tone, medium-dark skin tone", "People & Body", "person-sport", E17_0, FullyQualified},
+	{"🧒", "child", "People & Body", "person", E5_0, FullyQualified},
+	{"🧒🏻", "child: light skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧒🏼", "child: medium-light skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧒🏽", "child: medium skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧒🏾", "child: medium-dark skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧒🏿", "child: dark skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧓", "older person", "People & Body", "person", E5_0, FullyQualified},
+	{"🧓🏻", "older person: light skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧓🏼", "older person: medium-light skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧓🏽", "older person: medium skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧓🏾", "older person: medium-dark skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧓🏿", "older person: dark skin tone", "People & Body", "person", E5_0, FullyQualified},
+	{"🧔", "person: beard", "People & Body", "person", E5_0, FullyQualified},
+	{"🧔\u200d♀", "woman: beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔\u200d♀️", "woman: beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔\u200d♂", "man: beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔\u200d♂️", "man: beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏻", "person: light skin tone, beard", "People & Body", "person", E5_0, FullyQualified},
+	{"🧔🏻\u200d♀", "woman: light skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏻\u200d♀️", "woman: light skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏻\u200d♂", "man: light skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏻\u200d♂️", "man: light skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏼", "person: medium-light skin tone, beard", "People & Body", "person", E5_0, FullyQualified},
+	{"🧔🏼\u200d♀", "woman: medium-light skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏼\u200d♀️", "woman: medium-light skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏼\u200d♂", "man: medium-light skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏼\u200d♂️", "man: medium-light skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏽", "person: medium skin tone, beard", "People & Body", "person", E5_0, FullyQualified},
+	{"🧔🏽\u200d♀", "woman: medium skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏽\u200d♀️", "woman: medium skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏽\u200d♂", "man: medium skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏽\u200d♂️", "man: medium skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏾", "person: medium-dark skin tone, beard", "People & Body", "person", E5_0, FullyQualified},
+	{"🧔🏾\u200d♀", "woman: medium-dark skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏾\u200d♀️", "woman: medium-dark skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏾\u200d♂", "man: medium-dark skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏾\u200d♂️", "man: medium-dark skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏿", "person: dark skin tone, beard", "People & Body", "person", E5_0, FullyQualified},
+	{"🧔🏿\u200d♀", "woman: dark skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏿\u200d♀️", "woman: dark skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧔🏿\u200d♂", "man: dark skin tone, beard", "People & Body", "person", E13_1, MinimallyQualified},
+	{"🧔🏿\u200d♂️", "man: dark skin tone, beard", "People & Body", "person", E13_1, FullyQualified},
+	{"🧕", "woman with headscarf", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🧕🏻", "woman with headscarf: light skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🧕🏼", "woman with headscarf: medium-light skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🧕🏽", "woman with headscarf: medium skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🧕🏾", "woman with headscarf: medium-dark skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🧕🏿", "woman with headscarf: dark skin tone", "People & Body", "person-role", E5_0, FullyQualified},
+	{"🧖", "person in steamy room", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖\u200d♀", "woman in steamy room", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖\u200d♀️", "woman in steamy room", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖\u200d♂", "man in steamy room", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖\u200d♂️", "man in steamy room", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏻", "person in steamy room: light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏻\u200d♀", "woman in steamy room: light skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏻\u200d♀️", "woman in steamy room: light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏻\u200d♂", "man in steamy room: light skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏻\u200d♂️", "man in steamy room: light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏼", "person in steamy room: medium-light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏼\u200d♀", "woman in steamy room: medium-light skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏼\u200d♀️", "woman in steamy room: medium-light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏼\u200d♂", "man in steamy room: medium-light skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏼\u200d♂️", "man in steamy room: medium-light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏽", "person in steamy room: medium skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏽\u200d♀", "woman in steamy room: medium skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏽\u200d♀️", "woman in steamy room: medium skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏽\u200d♂", "man in steamy room: medium skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏽\u200d♂️", "man in steamy room: medium skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏾", "person in steamy room: medium-dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏾\u200d♀", "woman in steamy room: medium-dark skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏾\u200d♀️", "woman in steamy room: medium-dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏾\u200d♂", "man in steamy room: medium-dark skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏾\u200d♂️", "man in steamy room: medium-dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏿", "person in steamy room: dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏿\u200d♀", "woman in steamy room: dark skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏿\u200d♀️", "woman in steamy room: dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧖🏿\u200d♂", "man in steamy room: dark skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧖🏿\u200d♂️", "man in steamy room: dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗", "person climbing", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗\u200d♀", "woman climbing", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗\u200d♀️", "woman climbing", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗\u200d♂", "man climbing", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗\u200d♂️", "man climbing", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏻", "person climbing: light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏻\u200d♀", "woman climbing: light skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏻\u200d♀️", "woman climbing: light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏻\u200d♂", "man climbing: light skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏻\u200d♂️", "man climbing: light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏼", "person climbing: medium-light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏼\u200d♀", "woman climbing: medium-light skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏼\u200d♀️", "woman climbing: medium-light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏼\u200d♂", "man climbing: medium-light skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏼\u200d♂️", "man climbing: medium-light skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏽", "person climbing: medium skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏽\u200d♀", "woman climbing: medium skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏽\u200d♀️", "woman climbing: medium skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏽\u200d♂", "man climbing: medium skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏽\u200d♂️", "man climbing: medium skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏾", "person climbing: medium-dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏾\u200d♀", "woman climbing: medium-dark skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏾\u200d♀️", "woman climbing: medium-dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏾\u200d♂", "man climbing: medium-dark skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏾\u200d♂️", "man climbing: medium-dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏿", "person climbing: dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏿\u200d♀", "woman climbing: dark skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏿\u200d♀️", "woman climbing: dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧗🏿\u200d♂", "man climbing: dark skin tone", "People & Body", "person-activity", E5_0, MinimallyQualified},
+	{"🧗🏿\u200d♂️", "man climbing: dark skin tone", "People & Body", "person-activity", E5_0, FullyQualified},
+	{"🧘", "person in lotus position", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘\u200d♀", "woman in lotus position", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘\u200d♀️", "woman in lotus position", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘\u200d♂", "man in lotus position", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘\u200d♂️", "man in lotus position", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏻", "person in lotus position: light skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏻\u200d♀", "woman in lotus position: light skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏻\u200d♀️", "woman in lotus position: light skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏻\u200d♂", "man in lotus position: light skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏻\u200d♂️", "man in lotus position: light skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏼", "person in lotus position: medium-light skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏼\u200d♀", "woman in lotus position: medium-light skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏼\u200d♀️", "woman in lotus position: medium-light skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏼\u200d♂", "man in lotus position: medium-light skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏼\u200d♂️", "man in lotus position: medium-light skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏽", "person in lotus position: medium skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏽\u200d♀", "woman in lotus position: medium skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏽\u200d♀️", "woman in lotus position: medium skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏽\u200d♂", "man in lotus position: medium skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏽\u200d♂️", "man in lotus position: medium skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏾", "person in lotus position: medium-dark skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏾\u200d♀", "woman in lotus position: medium-dark skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏾\u200d♀️", "woman in lotus position: medium-dark skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏾\u200d♂", "man in lotus position: medium-dark skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏾\u200d♂️", "man in lotus position: medium-dark skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏿", "person in lotus position: dark skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏿\u200d♀", "woman in lotus position: dark skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏿\u200d♀️", "woman in lotus position: dark skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧘🏿\u200d♂", "man in lotus position: dark skin tone", "People & Body", "person-resting", E5_0, MinimallyQualified},
+	{"🧘🏿\u200d♂️", "man in lotus position: dark skin tone", "People & Body", "person-resting", E5_0, FullyQualified},
+	{"🧙", "mage", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙\u200d♀", "woman mage", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙\u200d♀️", "woman mage", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙\u200d♂", "man mage", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙\u200d♂️", "man mage", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏻", "mage: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏻\u200d♀", "woman mage: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏻\u200d♀️", "woman mage: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏻\u200d♂", "man mage: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏻\u200d♂️", "man mage: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏼", "mage: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏼\u200d♀", "woman mage: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏼\u200d♀️", "woman mage: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏼\u200d♂", "man mage: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏼\u200d♂️", "man mage: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏽", "mage: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏽\u200d♀", "woman mage: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏽\u200d♀️", "woman mage: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏽\u200d♂", "man mage: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏽\u200d♂️", "man mage: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏾", "mage: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏾\u200d♀", "woman mage: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏾\u200d♀️", "woman mage: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏾\u200d♂", "man mage: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏾\u200d♂️", "man mage: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏿", "mage: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏿\u200d♀", "woman mage: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏿\u200d♀️", "woman mage: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧙🏿\u200d♂", "man mage: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧙🏿\u200d♂️", "man mage: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚", "fairy", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚\u200d♀", "woman fairy", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚\u200d♀️", "woman fairy", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚\u200d♂", "man fairy", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚\u200d♂️", "man fairy", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏻", "fairy: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏻\u200d♀", "woman fairy: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏻\u200d♀️", "woman fairy: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏻\u200d♂", "man fairy: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏻\u200d♂️", "man fairy: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏼", "fairy: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏼\u200d♀", "woman fairy: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏼\u200d♀️", "woman fairy: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏼\u200d♂", "man fairy: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏼\u200d♂️", "man fairy: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏽", "fairy: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏽\u200d♀", "woman fairy: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏽\u200d♀️", "woman fairy: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏽\u200d♂", "man fairy: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏽\u200d♂️", "man fairy: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏾", "fairy: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏾\u200d♀", "woman fairy: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏾\u200d♀️", "woman fairy: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏾\u200d♂", "man fairy: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏾\u200d♂️", "man fairy: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏿", "fairy: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏿\u200d♀", "woman fairy: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏿\u200d♀️", "woman fairy: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧚🏿\u200d♂", "man fairy: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧚🏿\u200d♂️", "man fairy: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛", "vampire", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛\u200d♀", "woman vampire", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛\u200d♀️", "woman vampire", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛\u200d♂", "man vampire", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛\u200d♂️", "man vampire", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏻", "vampire: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏻\u200d♀", "woman vampire: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏻\u200d♀️", "woman vampire: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏻\u200d♂", "man vampire: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏻\u200d♂️", "man vampire: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏼", "vampire: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏼\u200d♀", "woman vampire: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏼\u200d♀️", "woman vampire: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏼\u200d♂", "man vampire: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏼\u200d♂️", "man vampire: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏽", "vampire: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏽\u200d♀", "woman vampire: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏽\u200d♀️", "woman vampire: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏽\u200d♂", "man vampire: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏽\u200d♂️", "man vampire: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏾", "vampire: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏾\u200d♀", "woman vampire: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏾\u200d♀️", "woman vampire: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏾\u200d♂", "man vampire: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏾\u200d♂️", "man vampire: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏿", "vampire: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏿\u200d♀", "woman vampire: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏿\u200d♀️", "woman vampire: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧛🏿\u200d♂", "man vampire: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧛🏿\u200d♂️", "man vampire: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜", "merperson", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜\u200d♀", "mermaid", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜\u200d♀️", "mermaid", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜\u200d♂", "merman", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜\u200d♂️", "merman", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏻", "merperson: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏻\u200d♀", "mermaid: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏻\u200d♀️", "mermaid: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏻\u200d♂", "merman: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏻\u200d♂️", "merman: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏼", "merperson: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏼\u200d♀", "mermaid: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏼\u200d♀️", "mermaid: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏼\u200d♂", "merman: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏼\u200d♂️", "merman: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏽", "merperson: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏽\u200d♀", "mermaid: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏽\u200d♀️", "mermaid: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏽\u200d♂", "merman: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏽\u200d♂️", "merman: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏾", "merperson: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏾\u200d♀", "mermaid: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏾\u200d♀️", "mermaid: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏾\u200d♂", "merman: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏾\u200d♂️", "merman: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏿", "merperson: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏿\u200d♀", "mermaid: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏿\u200d♀️", "mermaid: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧜🏿\u200d♂", "merman: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧜🏿\u200d♂️", "merman: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝", "elf", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝\u200d♀", "woman elf", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝\u200d♀️", "woman elf", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝\u200d♂", "man elf", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝\u200d♂️", "man elf", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏻", "elf: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏻\u200d♀", "woman elf: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏻\u200d♀️", "woman elf: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏻\u200d♂", "man elf: light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏻\u200d♂️", "man elf: light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏼", "elf: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏼\u200d♀", "woman elf: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏼\u200d♀️", "woman elf: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏼\u200d♂", "man elf: medium-light skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏼\u200d♂️", "man elf: medium-light skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏽", "elf: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏽\u200d♀", "woman elf: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏽\u200d♀️", "woman elf: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏽\u200d♂", "man elf: medium skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏽\u200d♂️", "man elf: medium skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏾", "elf: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏾\u200d♀", "woman elf: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏾\u200d♀️", "woman elf: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏾\u200d♂", "man elf: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏾\u200d♂️", "man elf: medium-dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏿", "elf: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏿\u200d♀", "woman elf: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏿\u200d♀️", "woman elf: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧝🏿\u200d♂", "man elf: dark skin tone", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧝🏿\u200d♂️", "man elf: dark skin tone", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧞", "genie", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧞\u200d♀", "woman genie", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧞\u200d♀️", "woman genie", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧞\u200d♂", "man genie", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧞\u200d♂️", "man genie", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧟", "zombie", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧟\u200d♀", "woman zombie", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧟\u200d♀️", "woman zombie", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧟\u200d♂", "man zombie", "People & Body", "person-fantasy", E5_0, MinimallyQualified},
+	{"🧟\u200d♂️", "man zombie", "People & Body", "person-fantasy", E5_0, FullyQualified},
+	{"🧠", "brain", "People & Body", "body-parts", E5_0, FullyQualified},
+	{"🧡", "orange heart", "Smileys & Emotion", "heart", E5_0, FullyQualified},
+	{"🧢", "billed cap", "Objects", "clothing", E5_0, FullyQualified},
+	{"🧣", "scarf", "Objects", "clothing", E5_0, FullyQualified},
+	{"🧤", "gloves", "Objects", "clothing", E5_0, FullyQualified},
+	{"🧥", "coat", "Objects", "clothing", E5_0, FullyQualified},
+	{"🧦", "socks", "Objects", "clothing", E5_0, FullyQualified},
+	{"🧧", "red envelope", "Activities", "event", E11_0, FullyQualified},
+	{"🧨", "firecracker", "Activities", "event", E11_0, FullyQualified},
+	{"🧩", "puzzle piece", "Activities", "game", E11_0, FullyQualified},
+	{"🧪", "test tube", "Objects", "science", E11_0, FullyQualified},
+	{"🧫", "petri dish", "Objects", "science", E11_0, FullyQualified},
+	{"🧬", "dna", "Objects", "science", E11_0, FullyQualified},
+	{"🧭", "compass", "Travel & Places", "place-map", E11_0, FullyQualified},
+	{"🧮", "abacus", "Objects", "computer", E11_0, FullyQualified},
+	{"🧯", "fire extinguisher", "Objects", "household", E11_0, FullyQualified},
+	{"🧰", "toolbox", "Objects", "tool", E11_0, FullyQualified},
+	{"🧱", "brick", "Travel & Places", "place-building", E11_0, FullyQualified},
+	{"🧲", "magnet", "Objects", "tool", E11_0, FullyQualified},
+	{"🧳", "luggage", "Travel & Places", "hotel", E11_0, FullyQualified},
+	{"🧴", "lotion bottle", "Objects", "household", E11_0, FullyQualified},
+	{"🧵", "thread", "Activities", "arts & crafts", E11_0, FullyQualified},
+	{"🧶", "yarn", "Activities", "arts & crafts", E11_0, FullyQualified},
+	{"🧷", "safety pin", "Objects", "household", E11_0, FullyQualified},
+	{"🧸", "teddy bear", "Activities", "game", E11_0, FullyQualified},
+	{"🧹", "broom", "Objects", "household", E11_0, FullyQualified},
+	{"🧺", "basket", "Objects", "household", E11_0, FullyQualified},
+	{"🧻", "roll of paper", "Objects", "household", E11_0, FullyQualified},
+	{"🧼", "soap", "Objects", "household", E11_0, FullyQualified},
+	{"🧽", "sponge", "Objects", "household", E11_0, FullyQualified},
+	{"🧾", "receipt", "Objects", "money", E11_0, FullyQualified},
+	{"🧿", "nazar amulet", "Objects", "other-object", E11_0, FullyQualified},
+	{"🩰", "ballet shoes", "Objects", "clothing", E12_0, FullyQualified},
+	{"🩱", "one-piece swimsuit", "Objects", "clothing", E12_0, FullyQualified},
+	{"🩲", "briefs", "Objects", "clothing", E12_0, FullyQualified},
+	{"🩳", "shorts", "Objects", "clothing", E12_0, FullyQualified},
+	{"🩴", "thong sandal", "Objects", "clothing", E13_0, FullyQualified},
+	{"🩵", "light blue heart", "Smileys & Emotion", "heart", E15_0, FullyQualified},
+	{"🩶", "grey heart", "Smileys & Emotion", "heart", E15_0, FullyQualified},
+	{"🩷", "pink heart", "Smileys & Emotion", "heart", E15_0, FullyQualified},
+	{"🩸", "drop of blood", "Objects", "medical", E12_0, FullyQualified},
+	{"🩹", "adhesive bandage", "Objects", "medical", E12_0, FullyQualified},
+	{"🩺", "stethoscope", "Objects", "medical", E12_0, FullyQualified},
+	{"🩻", "x-ray", "Objects", "medical", E14_0, FullyQualified},
+	{"🩼", "crutch", "Objects", "medical", E14_0, FullyQualified},
+	{"🪀", "yo-yo", "Activities", "game", E12_0, FullyQualified},
+	{"🪁", "kite", "Activities", "game", E12_0, FullyQualified},
+	{"🪂", "parachute", "Travel & Places", "transport-air", E12_0, FullyQualified},
+	{"🪃", "boomerang", "Objects", "tool", E13_0, FullyQualified},
+	{"🪄", "magic wand", "Activities", "game", E13_0, FullyQualified},
+	{"🪅", "piñata", "Activities", "game", E13_0, FullyQualified},
+	{"🪆", "nesting dolls", "Activities", "game", E13_0, FullyQualified},
+	{"🪇", "maracas", "Objects", "musical-instrument", E15_0, FullyQualified},
+	{"🪈", "flute", "Objects", "musical-instrument", E15_0, FullyQualified},
+	{"🪉", "harp", "Objects", "musical-instrument", E16_0, FullyQualified},
+	{"🪊", "trombone", "Objects", "musical-instrument", E17_0, FullyQualified},
+	{"🪎", "treasure chest", "Objects", "money", E17_0, FullyQualified},
+	{"🪏", "shovel", "Objects", "tool", E16_0, FullyQualified},
+	{"🪐", "ringed planet", "Travel & Places", "sky & weather", E12_0, FullyQualified},
+	{"🪑", "chair", "Objects", "household", E12_0, FullyQualified},
+	{"🪒", "razor", "Objects", "household", E12_0, FullyQualified},
+	{"🪓", "axe", "Objects", "tool", E12_0, FullyQualified},
+	{"🪔", "diya lamp", "Objects", "light & video", E12_0, FullyQualified},
+	{"🪕", "banjo", "Objects", "musical-instrument", E12_0, FullyQualified},
+	{"🪖", "military helmet", "Objects", "clothing", E13_0, FullyQualified},
+	{"🪗", "accordion", "Objects", "musical-instrument", E13_0, FullyQualified},
+	{"🪘", "long drum", "Objects", "musical-instrument", E13_0, FullyQualified},
+	{"🪙", "coin", "Objects", "money", E13_0, FullyQualified},
+	{"🪚", "carpentry saw", "Objects", "tool", E13_0, FullyQualified},
+	{"🪛", "screwdriver", "Objects", "tool", E13_0, FullyQualified},
+	{"🪜", "ladder", "Objects", "tool", E13_0, FullyQualified},
+	{"🪝", "hook", "Objects", "tool", E13_0, FullyQualified},
+	{"🪞", "mirror", "Objects", "household", E13_0, FullyQualified},
+	{"🪟", "window", "Objects", "household", E13_0, FullyQualified},
+	{"🪠", "plunger", "Objects", "household", E13_0, FullyQualified},
+	{"🪡", "sewing needle", "Activities", "arts & crafts", E13_0, FullyQualified},
+	{"🪢", "knot", "Activities", "arts & crafts", E13_0, FullyQualified},
+	{"🪣", "bucket", "Objects", "household", E13_0, FullyQualified},
+	{"🪤", "mouse trap", "Objects", "household", E13_0, FullyQualified},
+	{"🪥", "toothbrush", "Objects", "household", E13_0, FullyQualified},
+	{"🪦", "headstone", "Objects", "other-object", E13_0, FullyQualified},
+	{"🪧", "placard", "Objects", "other-object", E13_0, FullyQualified},
+	{"🪨", "rock", "Travel & Places", "place-building", E13_0, FullyQualified},
+	{"🪩", "mirror ball", "Activities", "game", E14_0, FullyQualified},
+	{"🪪", "identification card", "Objects", "other-object", E14_0, FullyQualified},
+	{"🪫", "low battery", "Objects", "computer", E14_0, FullyQualified},
+	{"🪬", "hamsa", "Objects", "other-object", E14_0, FullyQualified},
+	{"🪭", "folding hand fan", "Objects", "clothing", E15_0, FullyQualified},
+	{"🪮", "hair pick", "Objects", "clothing", E15_0, FullyQualified},
+	{"🪯", "khanda", "Symbols", "religion", E15_0, FullyQualified},
+	{"🪰", "fly", "Animals & Nature", "animal-bug", E13_0, FullyQualified},
+	{"🪱", "worm", "Animals & Nature", "animal-bug", E13_0, FullyQualified},
+	{"🪲", "beetle", "Animals & Nature", "animal-bug", E13_0, FullyQualified},
+	{"🪳", "cockroach", "Animals & Nature", "animal-bug", E13_0, FullyQualified},
+	{"🪴", "potted plant", "Animals & Nature", "plant-other", E13_0, FullyQualified},
+	{"🪵", "wood", "Travel & Places", "place-building", E13_0, FullyQualified},
+	{"🪶", "feather", "Animals & Nature", "animal-bird", E13_0, FullyQualified},
+	{"🪷", "lotus", "Animals & Nature", "plant-flower", E14_0, FullyQualified},
+	{"🪸", "coral", "Animals & Nature", "animal-marine", E14_0, FullyQualified},
+	{"🪹", "empty nest", "Animals & Nature", "plant-other", E14_0, FullyQualified},
+	{"🪺", "nest with eggs", "Animals & Nature", "plant-other", E14_0, FullyQualified},
+	{"🪻", "hyacinth", "Animals & Nature", "plant-flower", E15_0, FullyQualified},
+	{"🪼", "jellyfish", "Animals & Nature", "animal-marine", E15_0, FullyQualified},
+	{"🪽", "wing", "Animals & Nature", "animal-bird", E15_0, FullyQualified},
+	{"🪾", "leafless tree", "Animals & Nature", "plant-other", E16_0, FullyQualified},
+	{"🪿", "goose", "Animals & Nature", "animal-bird", E15_0, FullyQualified},
+	{"🫀", "anatomical heart", "People & Body", "body-parts", E13_0, FullyQualified},
+	{"🫁", "lungs", "People & Body", "body-parts", E13_0, FullyQualified},
+	{"🫂", "people hugging", "People & Body", "person-symbol", E13_0, FullyQualified},
+	{"🫃", "pregnant man", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫃🏻", "pregnant man: light skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫃🏼", "pregnant man: medium-light skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫃🏽", "pregnant man: medium skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫃🏾", "pregnant man: medium-dark skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫃🏿", "pregnant man: dark skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫄", "pregnant person", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫄🏻", "pregnant person: light skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫄🏼", "pregnant person: medium-light skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫄🏽", "pregnant person: medium skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫄🏾", "pregnant person: medium-dark skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫄🏿", "pregnant person: dark skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫅", "person with crown", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫅🏻", "person with crown: light skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫅🏼", "person with crown: medium-light skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫅🏽", "person with crown: medium skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫅🏾", "person with crown: medium-dark skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫅🏿", "person with crown: dark skin tone", "People & Body", "person-role", E14_0, FullyQualified},
+	{"🫆", "fingerprint", "People & Body", "person-symbol", E16_0, FullyQualified},
+	{"🫈", "hairy creature", "People & Body", "person-fantasy", E17_0, FullyQualified},
+	{"🫍", "orca", "Animals & Nature", "animal-marine", E17_0, FullyQualified},
+	{"🫎", "moose", "Animals & Nature", "animal-mammal", E15_0, FullyQualified},
+	{"🫏", "donkey", "Animals & Nature", "animal-mammal", E15_0, FullyQualified},
+	{"🫐", "blueberries", "Food & Drink", "food-fruit", E13_0, FullyQualified},
+	{"🫑", "bell pepper", "Food & Drink", "food-vegetable", E13_0, FullyQualified},
+	{"🫒", "olive", "Food & Drink", "food-fruit", E13_0, FullyQualified},
+	{"🫓", "flatbread", "Food & Drink", "food-prepared", E13_0, FullyQualified},
+	{"🫔", "tamale", "Food & Drink", "food-prepared", E13_0, FullyQualified},
+	{"🫕", "fondue", "Food & Drink", "food-prepared", E13_0, FullyQualified},
+	{"🫖", "teapot", "Food & Drink", "drink", E13_0, FullyQualified},
+	{"🫗", "pouring liquid", "Food & Drink", "drink", E14_0, FullyQualified},
+	{"🫘", "beans", "Food & Drink", "food-vegetable", E14_0, FullyQualified},
+	{"🫙", "jar", "Food & Drink", "dishware", E14_0, FullyQualified},
+	{"🫚", "ginger root", "Food & Drink", "food-vegetable", E15_0, FullyQualified},
+	{"🫛", "pea pod", "Food & Drink", "food-vegetable", E15_0, FullyQualified},
+	{"🫜", "root vegetable", "Food & Drink", "food-vegetable", E16_0, FullyQualified},
+	{"🫟", "splatter", "Symbols", "other-symbol", E16_0, FullyQualified},
+	{"🫠", "melting face", "Smileys & Emotion", "face-smiling", E14_0, FullyQualified},
+	{"🫡", "saluting face", "Smileys & Emotion", "face-hand", E14_0, FullyQualified},
+	{"🫢", "face with open eyes and hand over mouth", "Smileys & Emotion", "face-hand", E14_0, FullyQualified},
+	{"🫣", "face with peeking eye", "Smileys & Emotion", "face-hand", E14_0, FullyQualified},
+	{"🫤", "face with diagonal mouth", "Smileys & Emotion", "face-concerned", E14_0, FullyQualified},
+	{"🫥", "dotted line face", "Smileys & Emotion", "face-neutral-skeptical", E14_0, FullyQualified},
+	{"🫦", "biting lip", "People & Body", "body-parts", E14_0, FullyQualified},
+	{"🫧", "bubbles", "Objects", "household", E14_0, FullyQualified},
+	{"🫨", "shaking face", "Smileys & Emotion", "face-neutral-skeptical", E15_0, FullyQualified},
+	{"🫩", "face with bags under eyes", "Smileys & Emotion", "face-sleepy", E16_0, FullyQualified},
+	{"🫪", "distorted face", "Smileys & Emotion", "face-concerned", E17_0, FullyQualified},
+	{"🫯", "fight cloud", "Smileys & Emotion", "emotion", E17_0, FullyQualified},
+	{"🫰", "hand with index finger and thumb crossed", "People & Body", "hand-fingers-partial", E14_0, FullyQualified},
+	{"🫰🏻", "hand with index finger and thumb crossed: light skin tone", "People & Body", "hand-fingers-partial", E14_0, FullyQualified},
+	{"🫰🏼", "hand with index finger and thumb crossed: medium-light skin tone", "People & Body", "hand-fingers-partial", E14_0, FullyQualified},
+	{"🫰🏽", "hand with index finger and thumb crossed: medium skin tone", "People & Body", "hand-fingers-partial", E14_0, FullyQualified},
+	{"🫰🏾", "hand with index finger and thumb crossed: medium-dark skin tone", "People & Body", "hand-fingers-partial", E14_0, FullyQualified},
+	{"🫰🏿", "hand with index finger and thumb crossed: dark skin tone", "People & Body", "hand-fingers-partial", E14_0, FullyQualified},
+	{"🫱", "rightwards hand", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫱🏻", "rightwards hand: light skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫱🏻\u200d🫲🏼", "handshake: light skin tone, medium-light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏻\u200d🫲🏽", "handshake: light skin tone, medium skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏻\u200d🫲🏾", "handshake: light skin tone, medium-dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏻\u200d🫲🏿", "handshake: light skin tone, dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏼", "rightwards hand: medium-light skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫱🏼\u200d🫲🏻", "handshake: medium-light skin tone, light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏼\u200d🫲🏽", "handshake: medium-light skin tone, medium skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏼\u200d🫲🏾", "handshake: medium-light skin tone, medium-dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏼\u200d🫲🏿", "handshake: medium-light skin tone, dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏽", "rightwards hand: medium skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫱🏽\u200d🫲🏻", "handshake: medium skin tone, light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏽\u200d🫲🏼", "handshake: medium skin tone, medium-light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏽\u200d🫲🏾", "handshake: medium skin tone, medium-dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏽\u200d🫲🏿", "handshake: medium skin tone, dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏾", "rightwards hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫱🏾\u200d🫲🏻", "handshake: medium-dark skin tone, light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏾\u200d🫲🏼", "handshake: medium-dark skin tone, medium-light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏾\u200d🫲🏽", "handshake: medium-dark skin tone, medium skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏾\u200d🫲🏿", "handshake: medium-dark skin tone, dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏿", "rightwards hand: dark skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫱🏿\u200d🫲🏻", "handshake: dark skin tone, light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏿\u200d🫲🏼", "handshake: dark skin tone, medium-light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏿\u200d🫲🏽", "handshake: dark skin tone, medium skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫱🏿\u200d🫲🏾", "handshake: dark skin tone, medium-dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫲", "leftwards hand", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫲🏻", "leftwards hand: light skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫲🏼", "leftwards hand: medium-light skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫲🏽", "leftwards hand: medium skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫲🏾", "leftwards hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫲🏿", "leftwards hand: dark skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫳", "palm down hand", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫳🏻", "palm down hand: light skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫳🏼", "palm down hand: medium-light skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫳🏽", "palm down hand: medium skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫳🏾", "palm down hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫳🏿", "palm down hand: dark skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫴", "palm up hand", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫴🏻", "palm up hand: light skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫴🏼", "palm up hand: medium-light skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫴🏽", "palm up hand: medium skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫴🏾", "palm up hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫴🏿", "palm up hand: dark skin tone", "People & Body", "hand-fingers-open", E14_0, FullyQualified},
+	{"🫵", "index pointing at the viewer", "People & Body", "hand-single-finger", E14_0, FullyQualified},
+	{"🫵🏻", "index pointing at the viewer: light skin tone", "People & Body", "hand-single-finger", E14_0, FullyQualified},
+	{"🫵🏼", "index pointing at the viewer: medium-light skin tone", "People & Body", "hand-single-finger", E14_0, FullyQualified},
+	{"🫵🏽", "index pointing at the viewer: medium skin tone", "People & Body", "hand-single-finger", E14_0, FullyQualified},
+	{"🫵🏾", "index pointing at the viewer: medium-dark skin tone", "People & Body", "hand-single-finger", E14_0, FullyQualified},
+	{"🫵🏿", "index pointing at the viewer: dark skin tone", "People & Body", "hand-single-finger", E14_0, FullyQualified},
+	{"🫶", "heart hands", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫶🏻", "heart hands: light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫶🏼", "heart hands: medium-light skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫶🏽", "heart hands: medium skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫶🏾", "heart hands: medium-dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫶🏿", "heart hands: dark skin tone", "People & Body", "hands", E14_0, FullyQualified},
+	{"🫷", "leftwards pushing hand", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫷🏻", "leftwards pushing hand: light skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫷🏼", "leftwards pushing hand: medium-light skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫷🏽", "leftwards pushing hand: medium skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫷🏾", "leftwards pushing hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫷🏿", "leftwards pushing hand: dark skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫸", "rightwards pushing hand", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫸🏻", "rightwards pushing hand: light skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫸🏼", "rightwards pushing hand: medium-light skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫸🏽", "rightwards pushing hand: medium skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫸🏾", "rightwards pushing hand: medium-dark skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+	{"🫸🏿", "rightwards pushing hand: dark skin tone", "People & Body", "hand-fingers-open", E15_0, FullyQualified},
+}
+var gemoji = [][2]string{
+	{"+1", "👍"},
+	{"thumbsup", "👍"},
+	{"-1", "👎"},
+	{"thumbsdown", "👎"},
+	{"100", "💯"},
+	{"1234", "🔢"},
+	{"1st_place_medal", "🥇"},
+	{"2nd_place_medal", "🥈"},
+	{"3rd_place_medal", "🥉"},
+	{"8ball", "🎱"},
+	{"a", "🅰️"},
+	{"ab", "🆎"},
+	{"abacus", "🧮"},
+	{"abc", "🔤"},
+	{"abcd", "🔡"},
+	{"accept", "🉑"},
+	{"accordion", "🪗"},
+	{"adhesive_bandage", "🩹"},
+	{"adult", "🧑"},
+	{"aerial_tramway", "🚡"},
+	{"afghanistan", "🇦🇫"},
+	{"airplane", "✈️"},
+	{"aland_islands", "🇦🇽"},
+	{"alarm_clock", "⏰"},
+	{"albania", "🇦🇱"},
+	{"alembic", "⚗️"},
+	{"algeria", "🇩🇿"},
+	{"alien", "👽"},
+	{"ambulance", "🚑"},
+	{"american_samoa", "🇦🇸"},
+	{"amphora", "🏺"},
+	{"anatomical_heart", "🫀"},
+	{"anchor", "⚓"},
+	{"andorra", "🇦🇩"},
+	{"angel", "👼"},
+	{"anger", "💢"},
+	{"angola", "🇦🇴"},
+	{"angry", "😠"},
+	{"anguilla", "🇦🇮"},
+	{"anguished", "😧"},
+	{"ant", "🐜"},
+	{"antarctica", "🇦🇶"},
+	{"antigua_barbuda", "🇦🇬"},
+	{"apple", "🍎"},
+	{"aquarius", "♒"},
+	{"argentina", "🇦🇷"},
+	{"aries", "♈"},
+	{"armenia", "🇦🇲"},
+	{"arrow_backward", "◀️"},
+	{"arrow_double_down", "⏬"},
+	{"arrow_double_up", "⏫"},
+	{"arrow_down", "⬇️"},
+	{"arrow_down_small", "🔽"},
+	{"arrow_forward", "▶️"},
+	{"arrow_heading_down", "⤵️"},
+	{"arrow_heading_up", "⤴️"},
+	{"arrow_left", "⬅️"},
+	{"arrow_lower_left", "↙️"},
+	{"arrow_lower_right", "↘️"},
+	{"arrow_right", "➡️"},
+	{"arrow_right_hook", "↪️"},
+	{"arrow_up", "⬆️"},
+	{"arrow_up_down", "↕️"},
+	{"arrow_up_small", "🔼"},
+	{"arrow_upper_left", "↖️"},
+	{"arrow_upper_right", "↗️"},
+	{"arrows_clockwise", "🔃"},
+	{"arrows_counterclockwise", "🔄"},
+	{"art", "🎨"},
+	{"articulated_lorry", "🚛"},
+	{"artificial_satellite", "🛰️"},
+	{"artist", "🧑\u200d🎨"},
+	{"aruba", "🇦🇼"},
+	{"ascension_island", "🇦🇨"},
+	{"asterisk", "*️⃣"},
+	{"astonished", "😲"},
+	{"astronaut", "🧑\u200d🚀"},
+	{"athletic_shoe", "👟"},
+	{"atm", "🏧"},
+	{"atom_symbol", "⚛️"},
+	{"australia", "🇦🇺"},
+	{"austria", "🇦🇹"},
+	{"auto_rickshaw", "🛺"},
+	{"avocado", "🥑"},
+	{"axe", "🪓"},
+	{"azerbaijan", "🇦🇿"},
+	{"b", "🅱️"},
+	{"baby", "👶"},
+	{"baby_bottle", "🍼"},
+	{"baby_chick", "🐤"},
+	{"baby_symbol", "🚼"},
+	{"back", "🔙"},
+	{"bacon", "🥓"},
+	{"badger", "🦡"},
+	{"badminton", "🏸"},
+	{"bagel", "🥯"},
+	{"baggage_claim", "🛄"},
+	{"baguette_bread", "🥖"},
+	{"bahamas", "🇧🇸"},
+	{"bahrain", "🇧🇭"},
+	{"balance_scale", "⚖️"},
+	{"bald_man", "👨\u200d🦲"},
+	{"bald_woman", "👩\u200d🦲"},
+	{"ballet_shoes", "🩰"},
+	{"balloon", "🎈"},
+	{"ballot_box", "🗳️"},
+	{"ballot_box_with_check", "☑️"},
+	{"bamboo", "🎍"},
+	{"banana", "🍌"},
+	{"bangbang", "‼️"},
+	{"bangladesh", "🇧🇩"},
+	{"banjo", "🪕"},
+	{"bank", "🏦"},
+	{"bar_chart", "📊"},
+	{"barbados", "🇧🇧"},
+	{"barber", "💈"},
+	{"baseball", "⚾"},
+	{"basket", "🧺"},
+	{"basketball", "🏀"},
+	{"bat", "🦇"},
+	{"bath", "🛀"},
+	{"bathtub", "🛁"},
+	{"battery", "🔋"},
+	{"beach_umbrella", "🏖️"},
+	{"beans", "🫘"},
+	{"bear", "🐻"},
+	{"bearded_person", "🧔"},
+	{"beaver", "🦫"},
+	{"bed", "🛏️"},
+	{"bee", "🐝"},
+	{"honeybee", "🐝"},
+	{"beer", "🍺"},
+	{"beers", "🍻"},
+	{"beetle", "🪲"},
+	{"beginner", "🔰"},
+	{"belarus", "🇧🇾"},
+	{"belgium", "🇧🇪"},
+	{"belize", "🇧🇿"},
+	{"bell", "🔔"},
+	{"bell_pepper", "🫑"},
+	{"bellhop_bell", "🛎️"},
+	{"benin", "🇧🇯"},
+	{"bento", "🍱"},
+	{"bermuda", "🇧🇲"},
+	{"beverage_box", "🧃"},
+	{"bhutan", "🇧🇹"},
+	{"bicyclist", "🚴"},
+	{"bike", "🚲"},
+	{"biking_man", "🚴\u200d♂️"},
+	{"biking_woman", "🚴\u200d♀️"},
+	{"bikini", "👙"},
+	{"billed_cap", "🧢"},
+	{"biohazard", "☣️"},
+	{"bird", "🐦"},
+	{"birthday", "🎂"},
+	{"bison", "🦬"},
+	{"biting_lip", "🫦"},
+	{"black_bird", "🐦\u200d⬛"},
+	{"black_cat", "🐈\u200d⬛"},
+	{"black_circle", "⚫"},
+	{"black_flag", "🏴"},
+	{"black_heart", "🖤"},
+	{"black_joker", "🃏"},
+	{"black_large_square", "⬛"},
+	{"black_medium_small_square", "◾"},
+	{"black_medium_square", "◼️"},
+	{"black_nib", "✒️"},
+	{"black_small_square", "▪️"},
+	{"black_square_button", "🔲"},
+	{"blond_haired_man", "👱\u200d♂️"},
+	{"blond_haired_person", "👱"},
+	{"blond_haired_woman", "👱\u200d♀️"},
+	{"blonde_woman", "👱\u200d♀️"},
+	{"blossom", "🌼"},
+	{"blowfish", "🐡"},
+	{"blue_book", "📘"},
+	{"blue_car", "🚙"},
+	{"blue_heart", "💙"},
+	{"blue_square", "🟦"},
+	{"blueberries", "🫐"},
+	{"blush", "😊"},
+	{"boar", "🐗"},
+	{"boat", "⛵"},
+	{"sailboat", "⛵"},
+	{"bolivia", "🇧🇴"},
+	{"bomb", "💣"},
+	{"bone", "🦴"},
+	{"book", "📖"},
+	{"open_book", "📖"},
+	{"bookmark", "🔖"},
+	{"bookmark_tabs", "📑"},
+	{"books", "📚"},
+	{"boom", "💥"},
+	{"collision", "💥"},
+	{"boomerang", "🪃"},
+	{"boot", "👢"},
+	{"bosnia_herzegovina", "🇧🇦"},
+	{"botswana", "🇧🇼"},
+	{"bouncing_ball_man", "⛹️\u200d♂️"},
+	{"basketball_man", "⛹️\u200d♂️"},
+	{"bouncing_ball_person", "⛹️"},
+	{"bouncing_ball_woman", "⛹️\u200d♀️"},
+	{"basketball_woman", "⛹️\u200d♀️"},
+	{"bouquet", "💐"},
+	{"bouvet_island", "🇧🇻"},
+	{"bow", "🙇"},
+	{"bow_and_arrow", "🏹"},
+	{"bowing_man", "🙇\u200d♂️"},
+	{"bowing_woman", "🙇\u200d♀️"},
+	{"bowl_with_spoon", "🥣"},
+	{"bowling", "🎳"},
+	{"boxing_glove", "🥊"},
+	{"boy", "👦"},
+	{"brain", "🧠"},
+	{"brazil", "🇧🇷"},
+	{"bread", "🍞"},
+	{"breast_feeding", "🤱"},
+	{"bricks", "🧱"},
+	{"bridge_at_night", "🌉"},
+	{"briefcase", "💼"},
+	{"british_indian_ocean_territory", "🇮🇴"},
+	{"british_virgin_islands", "🇻🇬"},
+	{"broccoli", "🥦"},
+	{"broken_chain", "⛓️\u200d💥"},
+	{"broken_heart", "💔"},
+	{"broom", "🧹"},
+	{"brown_circle", "🟤"},
+	{"brown_heart", "🤎"},
+	{"brown_mushroom", "🍄\u200d🟫"},
+	{"brown_square", "🟫"},
+	{"brunei", "🇧🇳"},
+	{"bubble_tea", "🧋"},
+	{"bubbles", "🫧"},
+	{"bucket", "🪣"},
+	{"bug", "🐛"},
+	{"building_construction", "🏗️"},
+	{"bulb", "💡"},
+	{"bulgaria", "🇧🇬"},
+	{"bullettrain_front", "🚅"},
+	{"bullettrain_side", "🚄"},
+	{"burkina_faso", "🇧🇫"},
+	{"burrito", "🌯"},
+	{"burundi", "🇧🇮"},
+	{"bus", "🚌"},
+	{"business_suit_levitating", "🕴️"},
+	{"busstop", "🚏"},
+	{"bust_in_silhouette", "👤"},
+	{"busts_in_silhouette", "👥"},
+	{"butter", "🧈"},
+	{"butterfly", "🦋"},
+	{"cactus", "🌵"},
+	{"cake", "🍰"},
+	{"calendar", "📆"},
+	{"call_me_hand", "🤙"},
+	{"calling", "📲"},
+	{"cambodia", "🇰🇭"},
+	{"camel", "🐫"},
+	{"camera", "📷"},
+	{"camera_flash", "📸"},
+	{"cameroon", "🇨🇲"},
+	{"camping", "🏕️"},
+	{"canada", "🇨🇦"},
+	{"canary_islands", "🇮🇨"},
+	{"cancer", "♋"},
+	{"candle", "🕯️"},
+	{"candy", "🍬"},
+	{"canned_food", "🥫"},
+	{"canoe", "🛶"},
+	{"cape_verde", "🇨🇻"},
+	{"capital_abcd", "🔠"},
+	{"capricorn", "♑"},
+	{"car", "🚗"},
+	{"red_car", "🚗"},
+	{"card_file_box", "🗃️"},
+	{"card_index", "📇"},
+	{"card_index_dividers", "🗂️"},
+	{"caribbean_netherlands", "🇧🇶"},
+	{"carousel_horse", "🎠"},
+	{"carpentry_saw", "🪚"},
+	{"carrot", "🥕"},
+	{"cartwheeling", "🤸"},
+	{"cat", "🐱"},
+	{"cat2", "🐈"},
+	{"cayman_islands", "🇰🇾"},
+	{"cd", "💿"},
+	{"central_african_republic", "🇨🇫"},
+	{"ceuta_melilla", "🇪🇦"},
+	{"chad", "🇹🇩"},
+	{"chains", "⛓️"},
+	{"chair", "🪑"},
+	{"champagne", "🍾"},
+	{"chart", "💹"},
+	{"chart_with_downwards_trend", "📉"},
+	{"chart_with_upwards_trend", "📈"},
+	{"checkered_flag", "🏁"},
+	{"cheese", "🧀"},
+	{"cherries", "🍒"},
+	{"cherry_blossom", "🌸"},
+	{"chess_pawn", "♟️"},
+	{"chestnut", "🌰"},
+	{"chicken", "🐔"},
+	{"child", "🧒"},
+	{"children_crossing", "🚸"},
+	{"chile", "🇨🇱"},
+	{"chipmunk", "🐿️"},
+	{"chocolate_bar", "🍫"},
+	{"chopsticks", "🥢"},
+	{"christmas_island", "🇨🇽"},
+	{"christmas_tree", "🎄"},
+	{"church", "⛪"},
+	{"cinema", "🎦"},
+	{"circus_tent", "🎪"},
+	{"city_sunrise", "🌇"},
+	{"city_sunset", "🌆"},
+	{"cityscape", "🏙️"},
+	{"cl", "🆑"},
+	{"clamp", "🗜️"},
+	{"clap", "👏"},
+	{"clapper", "🎬"},
+	{"classical_building", "🏛️"},
+	{"climbing", "🧗"},
+	{"climbing_man", "🧗\u200d♂️"},
+	{"climbing_woman", "🧗\u200d♀️"},
+	{"clinking_glasses", "🥂"},
+	{"clipboard", "📋"},
+	{"clipperton_island", "🇨🇵"},
+	{"clock1", "🕐"},
+	{"clock10", "🕙"},
+	{"clock1030", "🕥"},
+	{"clock11", "🕚"},
+	{"clock1130", "🕦"},
+	{"clock12", "🕛"},
+	{"clock1230", "🕧"},
+	{"clock130", "🕜"},
+	{"clock2", "🕑"},
+	{"clock230", "🕝"},
+	{"clock3", "🕒"},
+	{"clock330", "🕞"},
+	{"clock4", "🕓"},
+	{"clock430", "🕟"},
+	{"clock5", "🕔"},
+	{"clock530", "🕠"},
+	{"clock6", "🕕"},
+	{"clock630", "🕡"},
+	{"clock7", "🕖"},
+	{"clock730", "🕢"},
+	{"clock8", "🕗"},
+	{"clock830", "🕣"},
+	{"clock9", "🕘"},
+	{"clock930", "🕤"},
+	{"closed_book", "📕"},
+	{"closed_lock_with_key", "🔐"},
+	{"closed_umbrella", "🌂"},
+	{"cloud", "☁️"},
+	{"cloud_with_lightning", "🌩️"},
+	{"cloud_with_lightning_and_rain", "⛈️"},
+	{"cloud_with_rain", "🌧️"},
+	{"cloud_with_snow", "🌨️"},
+	{"clown_face", "🤡"},
+	{"clubs", "♣️"},
+	{"cn", "🇨🇳"},
+	{"coat", "🧥"},
+	{"cockroach", "🪳"},
+	{"cocktail", "🍸"},
+	{"coconut", "🥥"},
+	{"cocos_islands", "🇨🇨"},
+	{"coffee", "☕"},
+	{"coffin", "⚰️"},
+	{"coin", "🪙"},
+	{"cold_face", "🥶"},
+	{"cold_sweat", "😰"},
+	{"colombia", "🇨🇴"},
+	{"comet", "☄️"},
+	{"comoros", "🇰🇲"},
+	{"compass", "🧭"},
+	{"computer", "💻"},
+	{"computer_mouse", "🖱️"},
+	{"confetti_ball", "🎊"},
+	{"confounded", "😖"},
+	{"confused", "😕"},
+	{"congo_brazzaville", "🇨🇬"},
+	{"congo_kinshasa", "🇨🇩"},
+	{"congratulations", "㊗️"},
+	{"construction", "🚧"},
+	{"construction_worker", "👷"},
+	{"construction_worker_man", "👷\u200d♂️"},
+	{"construction_worker_woman", "👷\u200d♀️"},
+	{"control_knobs", "🎛️"},
+	{"convenience_store", "🏪"},
+	{"cook", "🧑\u200d🍳"},
+	{"cook_islands", "🇨🇰"},
+	{"cookie", "🍪"},
+	{"cool", "🆒"},
+	{"copyright", "©️"},
+	{"coral", "🪸"},
+	{"corn", "🌽"},
+	{"costa_rica", "🇨🇷"},
+	{"cote_divoire", "🇨🇮"},
+	{"couch_and_lamp", "🛋️"},
+	{"couple", "👫"},
+	{"couple_with_heart", "💑"},
+	{"couple_with_heart_man_man", "👨\u200d❤️\u200d👨"},
+	{"couple_with_heart_woman_man", "👩\u200d❤️\u200d👨"},
+	{"couple_with_heart_woman_woman", "👩\u200d❤️\u200d👩"},
+	{"couplekiss", "💏"},
+	{"couplekiss_man_man", "👨\u200d❤️\u200d💋\u200d👨"},
+	{"couplekiss_man_woman", "👩\u200d❤️\u200d💋\u200d👨"},
+	{"couplekiss_woman_woman", "👩\u200d❤️\u200d💋\u200d👩"},
+	{"cow", "🐮"},
+	{"cow2", "🐄"},
+	{"cowboy_hat_face", "🤠"},
+	{"crab", "🦀"},
+	{"crayon", "🖍️"},
+	{"credit_card", "💳"},
+	{"crescent_moon", "🌙"},
+	{"cricket", "🦗"},
+	{"cricket_game", "🏏"},
+	{"croatia", "🇭🇷"},
+	{"crocodile", "🐊"},
+	{"croissant", "🥐"},
+	{"crossed_fingers", "🤞"},
+	{"crossed_flags", "🎌"},
+	{"crossed_swords", "⚔️"},
+	{"crown", "👑"},
+	{"crutch", "🩼"},
+	{"cry", "😢"},
+	{"crying_cat_face", "😿"},
+	{"crystal_ball", "🔮"},
+	{"cuba", "🇨🇺"},
+	{"cucumber", "🥒"},
+	{"cup_with_straw", "🥤"},
+	{"cupcake", "🧁"},
+	{"cupid", "💘"},
+	{"curacao", "🇨🇼"},
+	{"curling_stone", "🥌"},
+	{"curly_haired_man", "👨\u200d🦱"},
+	{"curly_haired_woman", "👩\u200d🦱"},
+	{"curly_loop", "➰"},
+	{"currency_exchange", "💱"},
+	{"curry", "🍛"},
+	{"cursing_face", "🤬"},
+	{"custard", "🍮"},
+	{"customs", "🛃"},
+	{"cut_of_meat", "🥩"},
+	{"cyclone", "🌀"},
+	{"cyprus", "🇨🇾"},
+	{"czech_republic", "🇨🇿"},
+	{"dagger", "🗡️"},
+	{"dancers", "👯"},
+	{"dancing_men", "👯\u200d♂️"},
+	{"dancing_women", "👯\u200d♀️"},
+	{"dango", "🍡"},
+	{"dark_sunglasses", "🕶️"},
+	{"dart", "🎯"},
+	{"dash", "💨"},
+	{"date", "📅"},
+	{"de", "🇩🇪"},
+	{"deaf_man", "🧏\u200d♂️"},
+	{"deaf_person", "🧏"},
+	{"deaf_woman", "🧏\u200d♀️"},
+	{"deciduous_tree", "🌳"},
+	{"deer", "🦌"},
+	{"denmark", "🇩🇰"},
+	{"department_store", "🏬"},
+	{"derelict_house", "🏚️"},
+	{"desert", "🏜️"},
+	{"desert_island", "🏝️"},
+	{"desktop_computer", "🖥️"},
+	{"detective", "🕵️"},
+	{"diamond_shape_with_a_dot_inside", "💠"},
+	{"diamonds", "♦️"},
+	{"diego_garcia", "🇩🇬"},
+	{"disappointed", "😞"},
+	{"disappointed_relieved", "😥"},
+	{"disguised_face", "🥸"},
+	{"diving_mask", "🤿"},
+	{"diya_lamp", "🪔"},
+	{"dizzy", "💫"},
+	{"dizzy_face", "😵"},
+	{"djibouti", "🇩🇯"},
+	{"dna", "🧬"},
+	{"do_not_litter", "🚯"},
+	{"dodo", "🦤"},
+	{"dog", "🐶"},
+	{"dog2", "🐕"},
+	{"dollar", "💵"},
+	{"dolls", "🎎"},
+	{"dolphin", "🐬"},
+	{"flipper", "🐬"},
+	{"dominica", "🇩🇲"},
+	{"dominican_republic", "🇩🇴"},
+	{"donkey", "🫏"},
+	{"door", "🚪"},
+	{"dotted_line_face", "🫥"},
+	{"doughnut", "🍩"},
+	{"dove", "🕊️"},
+	{"dragon", "🐉"},
+	{"dragon_face", "🐲"},
+	{"dress", "👗"},
+	{"dromedary_camel", "🐪"},
+	{"drooling_face", "🤤"},
+	{"drop_of_blood", "🩸"},
+	{"droplet", "💧"},
+	{"drum", "🥁"},
+	{"duck", "🦆"},
+	{"dumpling", "🥟"},
+	{"dvd", "📀"},
+	{"eagle", "🦅"},
+	{"ear", "👂"},
+	{"ear_of_rice", "🌾"},
+	{"ear_with_hearing_aid", "🦻"},
+	{"earth_africa", "🌍"},
+	{"earth_americas", "🌎"},
+	{"earth_asia", "🌏"},
+	{"ecuador", "🇪🇨"},
+	{"egg", "🥚"},
+	{"eggplant", "🍆"},
+	{"egypt", "🇪🇬"},
+	{"eight", "8️⃣"},
+	{"eight_pointed_black_star", "✴️"},
+	{"eight_spoked_asterisk", "✳️"},
+	{"eject_button", "⏏️"},
+	{"el_salvador", "🇸🇻"},
+	{"electric_plug", "🔌"},
+	{"elephant", "🐘"},
+	{"elevator", "🛗"},
+	{"elf", "🧝"},
+	{"elf_man", "🧝\u200d♂️"},
+	{"elf_woman", "🧝\u200d♀️"},
+	{"email", "📧"},
+	{"e-mail", "📧"},
+	{"empty_nest", "🪹"},
+	{"end", "🔚"},
+	{"england", "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f"},
+	{"envelope", "✉️"},
+	{"envelope_with_arrow", "📩"},
+	{"equatorial_guinea", "🇬🇶"},
+	{"eritrea", "🇪🇷"},
+	{"es", "🇪🇸"},
+	{"estonia", "🇪🇪"},
+	{"ethiopia", "🇪🇹"},
+	{"eu", "🇪🇺"},
+	{"european_union", "🇪🇺"},
+	{"euro", "💶"},
+	{"european_castle", "🏰"},
+	{"european_post_office", "🏤"},
+	{"evergreen_tree", "🌲"},
+	{"exclamation", "❗"},
+	{"heavy_exclamation_mark", "❗"},
+	{"exploding_head", "🤯"},
+	{"expressionless", "😑"},
+	{"eye", "👁️"},
+	{"eye_speech_bubble", "👁️\u200d🗨️"},
+	{"eyeglasses", "👓"},
+	{"eyes", "👀"},
+	{"face_exhaling", "😮\u200d💨"},
+	{"face_holding_back_tears", "🥹"},
+	{"face_in_clouds", "😶\u200d🌫️"},
+	{"face_with_bags_under_eyes", "🫩"},
+	{"face_with_diagonal_mouth", "🫤"},
+	{"face_with_head_bandage", "🤕"},
+	{"face_with_open_eyes_and_hand_over_mouth", "🫢"},
+	{"face_with_peeking_eye", "🫣"},
+	{"face_with_spiral_eyes", "😵\u200d💫"},
+	{"face_with_thermometer", "🤒"},
+	{"facepalm", "🤦"},
+	{"factory", "🏭"},
+	{"factory_worker", "🧑\u200d🏭"},
+	{"fairy", "🧚"},
+	{"fairy_man", "🧚\u200d♂️"},
+	{"fairy_woman", "🧚\u200d♀️"},
+	{"falafel", "🧆"},
+	{"falkland_islands", "🇫🇰"},
+	{"fallen_leaf", "🍂"},
+	{"family", "👪"},
+	{"family_adult_adult_child", "🧑\u200d🧑\u200d🧒"},
+	{"family_adult_adult_child_child", "🧑\u200d🧑\u200d🧒\u200d🧒"},
+	{"family_adult_child", "🧑\u200d🧒"},
+	{"family_adult_child_child", "🧑\u200d🧒\u200d🧒"},
+	{"family_man_boy", "👨\u200d👦"},
+	{"family_man_boy_boy", "👨\u200d👦\u200d👦"},
+	{"family_man_girl", "👨\u200d👧"},
+	{"family_man_girl_boy", "👨\u200d👧\u200d👦"},
+	{"family_man_girl_girl", "👨\u200d👧\u200d👧"},
+	{"family_man_man_boy", "👨\u200d👨\u200d👦"},
+	{"family_man_man_boy_boy", "👨\u200d👨\u200d👦\u200d👦"},
+	{"family_man_man_girl", "👨\u200d👨\u200d👧"},
+	{"family_man_man_girl_boy", "👨\u200d👨\u200d👧\u200d👦"},
+	{"family_man_man_girl_girl", "👨\u200d👨\u200d👧\u200d👧"},
+	{"family_man_woman_boy", "👨\u200d👩\u200d👦"},
+	{"family_man_woman_boy_boy", "👨\u200d👩\u200d👦\u200d👦"},
+	{"family_man_woman_girl", "👨\u200d👩\u200d👧"},
+	{"family_man_woman_girl_boy", "👨\u200d👩\u200d👧\u200d👦"},
+	{"family_man_woman_girl_girl", "👨\u200d👩\u200d👧\u200d👧"},
+	{"family_woman_boy", "👩\u200d👦"},
+	{"family_woman_boy_boy", "👩\u200d👦\u200d👦"},
+	{"family_woman_girl", "👩\u200d👧"},
+	{"family_woman_girl_boy", "👩\u200d👧\u200d👦"},
+	{"family_woman_girl_girl", "👩\u200d👧\u200d👧"},
+	{"family_woman_woman_boy", "👩\u200d👩\u200d👦"},
+	{"family_woman_woman_boy_boy", "👩\u200d👩\u200d👦\u200d👦"},
+	{"family_woman_woman_girl", "👩\u200d👩\u200d👧"},
+	{"family_woman_woman_girl_boy", "👩\u200d👩\u200d👧\u200d👦"},
+	{"family_woman_woman_girl_girl", "👩\u200d👩\u200d👧\u200d👧"},
+	{"farmer", "🧑\u200d🌾"},
+	{"faroe_islands", "🇫🇴"},
+	{"fast_forward", "⏩"},
+	{"fax", "📠"},
+	{"fearful", "😨"},
+	{"feather", "🪶"},
+	{"feet", "🐾"},
+	{"paw_prints", "🐾"},
+	{"female_detective", "🕵️\u200d♀️"},
+	{"female_sign", "♀️"},
+	{"ferris_wheel", "🎡"},
+	{"ferry", "⛴️"},
+	{"field_hockey", "🏑"},
+	{"fiji", "🇫🇯"},
+	{"file_cabinet", "🗄️"},
+	{"file_folder", "📁"},
+	{"film_projector", "📽️"},
+	{"film_strip", "🎞️"},
+	{"fingerprint", "🫆"},
+	{"finland", "🇫🇮"},
+	{"fire", "🔥"},
+	{"fire_engine", "🚒"},
+	{"fire_extinguisher", "🧯"},
+	{"firecracker", "🧨"},
+	{"firefighter", "🧑\u200d🚒"},
+	{"fireworks", "🎆"},
+	{"first_quarter_moon", "🌓"},
+	{"first_quarter_moon_with_face", "🌛"},
+	{"fish", "🐟"},
+	{"fish_cake", "🍥"},
+	{"fishing_pole_and_fish", "🎣"},
+	{"fist_left", "🤛"},
+	{"fist_oncoming", "👊"},
+	{"facepunch", "👊"},
+	{"punch", "👊"},
+	{"fist_raised", "✊"},
+	{"fist", "✊"},
+	{"fist_right", "🤜"},
+	{"five", "5️⃣"},
+	{"flag_sark", "🇨🇶"},
+	{"flags", "🎏"},
+	{"flamingo", "🦩"},
+	{"flashlight", "🔦"},
+	{"flat_shoe", "🥿"},
+	{"flatbread", "🫓"},
+	{"fleur_de_lis", "⚜️"},
+	{"flight_arrival", "🛬"},
+	{"flight_departure", "🛫"},
+	{"floppy_disk", "💾"},
+	{"flower_playing_cards", "🎴"},
+	{"flushed", "😳"},
+	{"flute", "🪈"},
+	{"fly", "🪰"},
+	{"flying_disc", "🥏"},
+	{"flying_saucer", "🛸"},
+	{"fog", "🌫️"},
+	{"foggy", "🌁"},
+	{"folding_hand_fan", "🪭"},
+	{"fondue", "🫕"},
+	{"foot", "🦶"},
+	{"football", "🏈"},
+	{"footprints", "👣"},
+	{"fork_and_knife", "🍴"},
+	{"fortune_cookie", "🥠"},
+	{"fountain", "⛲"},
+	{"fountain_pen", "🖋️"},
+	{"four", "4️⃣"},
+	{"four_leaf_clover", "🍀"},
+	{"fox_face", "🦊"},
+	{"fr", "🇫🇷"},
+	{"framed_picture", "🖼️"},
+	{"free", "🆓"},
+	{"french_guiana", "🇬🇫"},
+	{"french_polynesia", "🇵🇫"},
+	{"french_southern_territories", "🇹🇫"},
+	{"fried_egg", "🍳"},
+	{"fried_shrimp", "🍤"},
+	{"fries", "🍟"},
+	{"frog", "🐸"},
+	{"frowning", "😦"},
+	{"frowning_face", "☹️"},
+	{"frowning_man", "🙍\u200d♂️"},
+	{"frowning_person", "🙍"},
+	{"frowning_woman", "🙍\u200d♀️"},
+	{"fuelpump", "⛽"},
+	{"full_moon", "🌕"},
+	{"full_moon_with_face", "🌝"},
+	{"funeral_urn", "⚱️"},
+	{"gabon", "🇬🇦"},
+	{"gambia", "🇬🇲"},
+	{"game_die", "🎲"},
+	{"garlic", "🧄"},
+	{"gb", "🇬🇧"},
+	{"uk", "🇬🇧"},
+	{"gear", "⚙️"},
+	{"gem", "💎"},
+	{"gemini", "♊"},
+	{"genie", "🧞"},
+	{"genie_man", "🧞\u200d♂️"},
+	{"genie_woman", "🧞\u200d♀️"},
+	{"georgia", "🇬🇪"},
+	{"ghana", "🇬🇭"},
+	{"ghost", "👻"},
+	{"gibraltar", "🇬🇮"},
+	{"gift", "🎁"},
+	{"gift_heart", "💝"},
+	{"ginger_root", "🫚"},
+	{"giraffe", "🦒"},
+	{"girl", "👧"},
+	{"globe_with_meridians", "🌐"},
+	{"gloves", "🧤"},
+	{"goal_net", "🥅"},
+	{"goat", "🐐"},
+	{"goggles", "🥽"},
+	{"golf", "⛳"},
+	{"golfing", "🏌️"},
+	{"golfing_man", "🏌️\u200d♂️"},
+	{"golfing_woman", "🏌️\u200d♀️"},
+	{"goose", "🪿"},
+	{"gorilla", "🦍"},
+	{"grapes", "🍇"},
+	{"greece", "🇬🇷"},
+	{"green_apple", "🍏"},
+	{"green_book", "📗"},
+	{"green_circle", "🟢"},
+	{"green_heart", "💚"},
+	{"green_salad", "🥗"},
+	{"green_square", "🟩"},
+	{"greenland", "🇬🇱"},
+	{"grenada", "🇬🇩"},
+	{"grey_exclamation", "❕"},
+	{"grey_heart", "🩶"},
+	{"grey_question", "❔"},
+	{"grimacing", "😬"},
+	{"grin", "😁"},
+	{"grinning", "😀"},
+	{"guadeloupe", "🇬🇵"},
+	{"guam", "🇬🇺"},
+	{"guard", "💂"},
+	{"guardsman", "💂\u200d♂️"},
+	{"guardswoman", "💂\u200d♀️"},
+	{"guatemala", "🇬🇹"},
+	{"guernsey", "🇬🇬"},
+	{"guide_dog", "🦮"},
+	{"guinea", "🇬🇳"},
+	{"guinea_bissau", "🇬🇼"},
+	{"guitar", "🎸"},
+	{"gun", "🔫"},
+	{"guyana", "🇬🇾"},
+	{"hair_pick", "🪮"},
+	{"haircut", "💇"},
+	{"haircut_man", "💇\u200d♂️"},
+	{"haircut_woman", "💇\u200d♀️"},
+	{"haiti", "🇭🇹"},
+	{"hamburger", "🍔"},
+	{"hammer", "🔨"},
+	{"hammer_and_pick", "⚒️"},
+	{"hammer_and_wrench", "🛠️"},
+	{"hamsa", "🪬"},
+	{"hamster", "🐹"},
+	{"hand", "✋"},
+	{"raised_hand", "✋"},
+	{"hand_over_mouth", "🤭"},
+	{"hand_with_index_finger_and_thumb_crossed", "🫰"},
+	{"handbag", "👜"},
+	{"handball_person", "🤾"},
+	{"handshake", "🤝"},
+	{"hankey", "💩"},
+	{"poop", "💩"},
+	{"shit", "💩"},
+	{"harp", "🪉"},
+	{"hash", "#️⃣"},
+	{"hatched_chick", "🐥"},
+	{"hatching_chick", "🐣"},
+	{"head_shaking_horizontally", "🙂\u200d↔️"},
+	{"head_shaking_vertically", "🙂\u200d↕️"},
+	{"headphones", "🎧"},
+	{"headstone", "🪦"},
+	{"health_worker", "🧑\u200d⚕️"},
+	{"hear_no_evil", "🙉"},
+	{"heard_mcdonald_islands", "🇭🇲"},
+	{"heart", "❤️"},
+	{"heart_decoration", "💟"},
+	{"heart_eyes", "😍"},
+	{"heart_eyes_cat", "😻"},
+	{"heart_hands", "🫶"},
+	{"heart_on_fire", "❤️\u200d🔥"},
+	{"heartbeat", "💓"},
+	{"heartpulse", "💗"},
+	{"hearts", "♥️"},
+	{"heavy_check_mark", "✔️"},
+	{"heavy_division_sign", "➗"},
+	{"heavy_dollar_sign", "💲"},
+	{"heavy_equals_sign", "🟰"},
+	{"heavy_heart_exclamation", "❣️"},
+	{"heavy_minus_sign", "➖"},
+	{"heavy_multiplication_x", "✖️"},
+	{"heavy_plus_sign", "➕"},
+	{"hedgehog", "🦔"},
+	{"helicopter", "🚁"},
+	{"herb", "🌿"},
+	{"hibiscus", "🌺"},
+	{"high_brightness", "🔆"},
+	{"high_heel", "👠"},
+	{"hiking_boot", "🥾"},
+	{"hindu_temple", "🛕"},
+	{"hippopotamus", "🦛"},
+	{"hocho", "🔪"},
+	{"knife", "🔪"},
+	{"hole", "🕳️"},
+	{"honduras", "🇭🇳"},
+	{"honey_pot", "🍯"},
+	{"hong_kong", "🇭🇰"},
+	{"hook", "🪝"},
+	{"horse", "🐴"},
+	{"horse_racing", "🏇"},
+	{"hospital", "🏥"},
+	{"hot_face", "🥵"},
+	{"hot_pepper", "🌶️"},
+	{"hotdog", "🌭"},
+	{"hotel", "🏨"},
+	{"hotsprings", "♨️"},
+	{"hourglass", "⌛"},
+	{"hourglass_flowing_sand", "⏳"},
+	{"house", "🏠"},
+	{"house_with_garden", "🏡"},
+	{"houses", "🏘️"},
+	{"hugs", "🤗"},
+	{"hungary", "🇭🇺"},
+	{"hushed", "😯"},
+	{"hut", "🛖"},
+	{"hyacinth", "🪻"},
+	{"ice_cream", "🍨"},
+	{"ice_cube", "🧊"},
+	{"ice_hockey", "🏒"},
+	{"ice_skate", "⛸️"},
+	{"icecream", "🍦"},
+	{"iceland", "🇮🇸"},
+	{"id", "🆔"},
+	{"identification_card", "🪪"},
+	{"ideograph_advantage", "🉐"},
+	{"imp", "👿"},
+	{"inbox_tray", "📥"},
+	{"incoming_envelope", "📨"},
+	{"index_pointing_at_the_viewer", "🫵"},
+	{"india", "🇮🇳"},
+	{"indonesia", "🇮🇩"},
+	{"infinity", "♾️"},
+	{"information_source", "ℹ️"},
+	{"innocent", "😇"},
+	{"interrobang", "⁉️"},
+	{"iphone", "📱"},
+	{"iran", "🇮🇷"},
+	{"iraq", "🇮🇶"},
+	{"ireland", "🇮🇪"},
+	{"isle_of_man", "🇮🇲"},
+	{"israel", "🇮🇱"},
+	{"it", "🇮🇹"},
+	{"izakaya_lantern", "🏮"},
+	{"lantern", "🏮"},
+	{"jack_o_lantern", "🎃"},
+	{"jamaica", "🇯🇲"},
+	{"japan", "🗾"},
+	{"japanese_castle", "🏯"},
+	{"japanese_goblin", "👺"},
+	{"japanese_ogre", "👹"},
+	{"jar", "🫙"},
+	{"jeans", "👖"},
+	{"jellyfish", "🪼"},
+	{"jersey", "🇯🇪"},
+	{"jigsaw", "🧩"},
+	{"jordan", "🇯🇴"},
+	{"joy", "😂"},
+	{"joy_cat", "😹"},
+	{"joystick", "🕹️"},
+	{"jp", "🇯🇵"},
+	{"judge", "🧑\u200d⚖️"},
+	{"juggling_person", "🤹"},
+	{"kaaba", "🕋"},
+	{"kangaroo", "🦘"},
+	{"kazakhstan", "🇰🇿"},
+	{"kenya", "🇰🇪"},
+	{"key", "🔑"},
+	{"keyboard", "⌨️"},
+	{"keycap_ten", "🔟"},
+	{"khanda", "🪯"},
+	{"kick_scooter", "🛴"},
+	{"kimono", "👘"},
+	{"kiribati", "🇰🇮"},
+	{"kiss", "💋"},
+	{"kissing", "😗"},
+	{"kissing_cat", "😽"},
+	{"kissing_closed_eyes", "😚"},
+	{"kissing_heart", "😘"},
+	{"kissing_smiling_eyes", "😙"},
+	{"kite", "🪁"},
+	{"kiwi_fruit", "🥝"},
+	{"kneeling_man", "🧎\u200d♂️"},
+	{"kneeling_person", "🧎"},
+	{"kneeling_woman", "🧎\u200d♀️"},
+	{"knot", "🪢"},
+	{"koala", "🐨"},
+	{"koko", "🈁"},
+	{"kosovo", "🇽🇰"},
+	{"kr", "🇰🇷"},
+	{"kuwait", "🇰🇼"},
+	{"kyrgyzstan", "🇰🇬"},
+	{"lab_coat", "🥼"},
+	{"label", "🏷️"},
+	{"lacrosse", "🥍"},
+	{"ladder", "🪜"},
+	{"lady_beetle", "🐞"},
+	{"laos", "🇱🇦"},
+	{"large_blue_circle", "🔵"},
+	{"large_blue_diamond", "🔷"},
+	{"large_orange_diamond", "🔶"},
+	{"last_quarter_moon", "🌗"},
+	{"last_quarter_moon_with_face", "🌜"},
+	{"latin_cross", "✝️"},
+	{"latvia", "🇱🇻"},
+	{"laughing", "😆"},
+	{"satisfied", "😆"},
+	{"leafless_tree", "🪾"},
+	{"leafy_green", "🥬"},
+	{"leaves", "🍃"},
+	{"lebanon", "🇱🇧"},
+	{"ledger", "📒"},
+	{"left_luggage", "🛅"},
+	{"left_right_arrow", "↔️"},
+	{"left_speech_bubble", "🗨️"},
+	{"leftwards_arrow_with_hook", "↩️"},
+	{"leftwards_hand", "🫲"},
+	{"leftwards_pushing_hand", "🫷"},
+	{"leg", "🦵"},
+	{"lemon", "🍋"},
+	{"leo", "♌"},
+	{"leopard", "🐆"},
+	{"lesotho", "🇱🇸"},
+	{"level_slider", "🎚️"},
+	{"liberia", "🇱🇷"},
+	{"libra", "♎"},
+	{"libya", "🇱🇾"},
+	{"liechtenstein", "🇱🇮"},
+	{"light_blue_heart", "🩵"},
+	{"light_rail", "🚈"},
+	{"lime", "🍋\u200d🟩"},
+	{"link", "🔗"},
+	{"lion", "🦁"},
+	{"lips", "👄"},
+	{"lipstick", "💄"},
+	{"lithuania", "🇱🇹"},
+	{"lizard", "🦎"},
+	{"llama", "🦙"},
+	{"lobster", "🦞"},
+	{"lock", "🔒"},
+	{"lock_with_ink_pen", "🔏"},
+	{"lollipop", "🍭"},
+	{"long_drum", "🪘"},
+	{"loop", "➿"},
+	{"lotion_bottle", "🧴"},
+	{"lotus", "🪷"},
+	{"lotus_position", "🧘"},
+	{"lotus_position_man", "🧘\u200d♂️"},
+	{"lotus_position_woman", "🧘\u200d♀️"},
+	{"loud_sound", "🔊"},
+	{"loudspeaker", "📢"},
+	{"love_hotel", "🏩"},
+	{"love_letter", "💌"},
+	{"love_you_gesture", "🤟"},
+	{"low_battery", "🪫"},
+	{"low_brightness", "🔅"},
+	{"luggage", "🧳"},
+	{"lungs", "🫁"},
+	{"luxembourg", "🇱🇺"},
+	{"lying_face", "🤥"},
+	{"m", "Ⓜ️"},
+	{"macau", "🇲🇴"},
+	{"macedonia", "🇲🇰"},
+	{"madagascar", "🇲🇬"},
+	{"mag", "🔍"},
+	{"mag_right", "🔎"},
+	{"mage", "🧙"},
+	{"mage_man", "🧙\u200d♂️"},
+	{"mage_woman", "🧙\u200d♀️"},
+	{"magic_wand", "🪄"},
+	{"magnet", "🧲"},
+	{"mahjong", "🀄"},
+	{"mailbox", "📫"},
+	{"mailbox_closed", "📪"},
+	{"mailbox_with_mail", "📬"},
+	{"mailbox_with_no_mail", "📭"},
+	{"malawi", "🇲🇼"},
+	{"malaysia", "🇲🇾"},
+	{"maldives", "🇲🇻"},
+	{"male_detective", "🕵️\u200d♂️"},
+	{"male_sign", "♂️"},
+	{"mali", "🇲🇱"},
+	{"malta", "🇲🇹"},
+	{"mammoth", "🦣"},
+	{"man", "👨"},
+	{"man_artist", "👨\u200d🎨"},
+	{"man_astronaut", "👨\u200d🚀"},
+	{"man_beard", "🧔\u200d♂️"},
+	{"man_cartwheeling", "🤸\u200d♂️"},
+	{"man_cook", "👨\u200d🍳"},
+	{"man_dancing", "🕺"},
+	{"man_facepalming", "🤦\u200d♂️"},
+	{"man_factory_worker", "👨\u200d🏭"},
+	{"man_farmer", "👨\u200d🌾"},
+	{"man_feeding_baby", "👨\u200d🍼"},
+	{"man_firefighter", "👨\u200d🚒"},
+	{"man_health_worker", "👨\u200d⚕️"},
+	{"man_in_manual_wheelchair", "👨\u200d🦽"},
+	{"man_in_manual_wheelchair_facing_right", "👨\u200d🦽\u200d➡️"},
+	{"man_in_motorized_wheelchair", "👨\u200d🦼"},
+	{"man_in_motorized_wheelchair_facing_right", "👨\u200d🦼\u200d➡️"},
+	{"man_in_tuxedo", "🤵\u200d♂️"},
+	{"man_judge", "👨\u200d⚖️"},
+	{"man_juggling", "🤹\u200d♂️"},
+	{"man_kneeling_facing_right", "🧎\u200d♂️\u200d➡️"},
+	{"man_mechanic", "👨\u200d🔧"},
+	{"man_office_worker", "👨\u200d💼"},
+	{"man_pilot", "👨\u200d✈️"},
+	{"man_playing_handball", "🤾\u200d♂️"},
+	{"man_playing_water_polo", "🤽\u200d♂️"},
+	{"man_running_facing_right", "🏃\u200d♂️\u200d➡️"},
+	{"man_scientist", "👨\u200d🔬"},
+	{"man_shrugging", "🤷\u200d♂️"},
+	{"man_singer", "👨\u200d🎤"},
+	{"man_student", "👨\u200d🎓"},
+	{"man_teacher", "👨\u200d🏫"},
+	{"man_technologist", "👨\u200d💻"},
+	{"man_walking_facing_right", "🚶\u200d♂️\u200d➡️"},
+	{"man_with_gua_pi_mao", "👲"},
+	{"man_with_probing_cane", "👨\u200d🦯"},
+	{"man_with_turban", "👳\u200d♂️"},
+	{"man_with_veil", "👰\u200d♂️"},
+	{"man_with_white_cane_facing_right", "👨\u200d🦯\u200d➡️"},
+	{"mango", "🥭"},
+	{"mans_shoe", "👞"},
+	{"shoe", "👞"},
+	{"mantelpiece_clock", "🕰️"},
+	{"manual_wheelchair", "🦽"},
+	{"maple_leaf", "🍁"},
+	{"maracas", "🪇"},
+	{"marshall_islands", "🇲🇭"},
+	{"martial_arts_uniform", "🥋"},
+	{"martinique", "🇲🇶"},
+	{"mask", "😷"},
+	{"massage", "💆"},
+	{"massage_man", "💆\u200d♂️"},
+	{"massage_woman", "💆\u200d♀️"},
+	{"mate", "🧉"},
+	{"mauritania", "🇲🇷"},
+	{"mauritius", "🇲🇺"},
+	{"mayotte", "🇾🇹"},
+	{"meat_on_bone", "🍖"},
+	{"mechanic", "🧑\u200d🔧"},
+	{"mechanical_arm", "🦾"},
+	{"mechanical_leg", "🦿"},
+	{"medal_military", "🎖️"},
+	{"medal_sports", "🏅"},
+	{"medical_symbol", "⚕️"},
+	{"mega", "📣"},
+	{"melon", "🍈"},
+	{"melting_face", "🫠"},
+	{"memo", "📝"},
+	{"pencil", "📝"},
+	{"men_wrestling", "🤼\u200d♂️"},
+	{"mending_heart", "❤️\u200d🩹"},
+	{"menorah", "🕎"},
+	{"mens", "🚹"},
+	{"mermaid", "🧜\u200d♀️"},
+	{"merman", "🧜\u200d♂️"},
+	{"merperson", "🧜"},
+	{"metal", "🤘"},
+	{"metro", "🚇"},
+	{"mexico", "🇲🇽"},
+	{"microbe", "🦠"},
+	{"micronesia", "🇫🇲"},
+	{"microphone", "🎤"},
+	{"microscope", "🔬"},
+	{"middle_finger", "🖕"},
+	{"fu", "🖕"},
+	{"military_helmet", "🪖"},
+	{"milk_glass", "🥛"},
+	{"milky_way", "🌌"},
+	{"minibus", "🚐"},
+	{"minidisc", "💽"},
+	{"mirror", "🪞"},
+	{"mirror_ball", "🪩"},
+	{"mobile_phone_off", "📴"},
+	{"moldova", "🇲🇩"},
+	{"monaco", "🇲🇨"},
+	{"money_mouth_face", "🤑"},
+	{"money_with_wings", "💸"},
+	{"moneybag", "💰"},
+	{"mongolia", "🇲🇳"},
+	{"monkey", "🐒"},
+	{"monkey_face", "🐵"},
+	{"monocle_face", "🧐"},
+	{"monorail", "🚝"},
+	{"montenegro", "🇲🇪"},
+	{"montserrat", "🇲🇸"},
+	{"moon", "🌔"},
+	{"waxing_gibbous_moon", "🌔"},
+	{"moon_cake", "🥮"},
+	{"moose", "🫎"},
+	{"morocco", "🇲🇦"},
+	{"mortar_board", "🎓"},
+	{"mosque", "🕌"},
+	{"mosquito", "🦟"},
+	{"motor_boat", "🛥️"},
+	{"motor_scooter", "🛵"},
+	{"motorcycle", "🏍️"},
+	{"motorized_wheelchair", "🦼"},
+	{"motorway", "🛣️"},
+	{"mount_fuji", "🗻"},
+	{"mountain", "⛰️"},
+	{"mountain_bicyclist", "🚵"},
+	{"mountain_biking_man", "🚵\u200d♂️"},
+	{"mountain_biking_woman", "🚵\u200d♀️"},
+	{"mountain_cableway", "🚠"},
+	{"mountain_railway", "🚞"},
+	{"mountain_snow", "🏔️"},
+	{"mouse", "🐭"},
+	{"mouse2", "🐁"},
+	{"mouse_trap", "🪤"},
+	{"movie_camera", "🎥"},
+	{"moyai", "🗿"},
+	{"mozambique", "🇲🇿"},
+	{"mrs_claus", "🤶"},
+	{"muscle", "💪"},
+	{"mushroom", "🍄"},
+	{"musical_keyboard", "🎹"},
+	{"musical_note", "🎵"},
+	{"musical_score", "🎼"},
+	{"mute", "🔇"},
+	{"mx_claus", "🧑\u200d🎄"},
+	{"myanmar", "🇲🇲"},
+	{"nail_care", "💅"},
+	{"name_badge", "📛"},
+	{"namibia", "🇳🇦"},
+	{"national_park", "🏞️"},
+	{"nauru", "🇳🇷"},
+	{"nauseated_face", "🤢"},
+	{"nazar_amulet", "🧿"},
+	{"necktie", "👔"},
+	{"negative_squared_cross_mark", "❎"},
+	{"nepal", "🇳🇵"},
+	{"nerd_face", "🤓"},
+	{"nest_with_eggs", "🪺"},
+	{"nesting_dolls", "🪆"},
+	{"netherlands", "🇳🇱"},
+	{"neutral_face", "😐"},
+	{"new", "🆕"},
+	{"new_caledonia", "🇳🇨"},
+	{"new_moon", "🌑"},
+	{"new_moon_with_face", "🌚"},
+	{"new_zealand", "🇳🇿"},
+	{"newspaper", "📰"},
+	{"newspaper_roll", "🗞️"},
+	{"next_track_button", "⏭️"},
+	{"ng", "🆖"},
+	{"nicaragua", "🇳🇮"},
+	{"niger", "🇳🇪"},
+	{"nigeria", "🇳🇬"},
+	{"night_with_stars", "🌃"},
+	{"nine", "9️⃣"},
+	{"ninja", "🥷"},
+	{"niue", "🇳🇺"},
+	{"no_bell", "🔕"},
+	{"no_bicycles", "🚳"},
+	{"no_entry", "⛔"},
+	{"no_entry_sign", "🚫"},
+	{"no_good", "🙅"},
+	{"no_good_man", "🙅\u200d♂️"},
+	{"ng_man", "🙅\u200d♂️"},
+	{"no_good_woman", "🙅\u200d♀️"},
+	{"ng_woman", "🙅\u200d♀️"},
+	{"no_mobile_phones", "📵"},
+	{"no_mouth", "😶"},
+	{"no_pedestrians", "🚷"},
+	{"no_smoking", "🚭"},
+	{"non-potable_water", "🚱"},
+	{"norfolk_island", "🇳🇫"},
+	{"north_korea", "🇰🇵"},
+	{"northern_mariana_islands", "🇲🇵"},
+	{"norway", "🇳🇴"},
+	{"nose", "👃"},
+	{"notebook", "📓"},
+	{"notebook_with_decorative_cover", "📔"},
+	{"notes", "🎶"},
+	{"nut_and_bolt", "🔩"},
+	{"o", "⭕"},
+	{"o2", "🅾️"},
+	{"ocean", "🌊"},
+	{"octopus", "🐙"},
+	{"oden", "🍢"},
+	{"office", "🏢"},
+	{"office_worker", "🧑\u200d💼"},
+	{"oil_drum", "🛢️"},
+	{"ok", "🆗"},
+	{"ok_hand", "👌"},
+	{"ok_man", "🙆\u200d♂️"},
+	{"ok_person", "🙆"},
+	{"ok_woman", "🙆\u200d♀️"},
+	{"old_key", "🗝️"},
+	{"older_adult", "🧓"},
+	{"older_man", "👴"},
+	{"older_woman", "👵"},
+	{"olive", "🫒"},
+	{"om", "🕉️"},
+	{"oman", "🇴🇲"},
+	{"on", "🔛"},
+	{"oncoming_automobile", "🚘"},
+	{"oncoming_bus", "🚍"},
+	{"oncoming_police_car", "🚔"},
+	{"oncoming_taxi", "🚖"},
+	{"one", "1️⃣"},
+	{"one_piece_swimsuit", "🩱"},
+	{"onion", "🧅"},
+	{"open_file_folder", "📂"},
+	{"open_hands", "👐"},
+	{"open_mouth", "😮"},
+	{"open_umbrella", "☂️"},
+	{"ophiuchus", "⛎"},
+	{"orange_book", "📙"},
+	{"orange_circle", "🟠"},
+	{"orange_heart", "🧡"},
+	{"orange_square", "🟧"},
+	{"orangutan", "🦧"},
+	{"orthodox_cross", "☦️"},
+	{"otter", "🦦"},
+	{"outbox_tray", "📤"},
+	{"owl", "🦉"},
+	{"ox", "🐂"},
+	{"oyster", "🦪"},
+	{"package", "📦"},
+	{"page_facing_up", "📄"},
+	{"page_with_curl", "📃"},
+	{"pager", "📟"},
+	{"paintbrush", "🖌️"},
+	{"pakistan", "🇵🇰"},
+	{"palau", "🇵🇼"},
+	{"palestinian_territories", "🇵🇸"},
+	{"palm_down_hand", "🫳"},
+	{"palm_tree", "🌴"},
+	{"palm_up_hand", "🫴"},
+	{"palms_up_together", "🤲"},
+	{"panama", "🇵🇦"},
+	{"pancakes", "🥞"},
+	{"panda_face", "🐼"},
+	{"paperclip", "📎"},
+	{"paperclips", "🖇️"},
+	{"papua_new_guinea", "🇵🇬"},
+	{"parachute", "🪂"},
+	{"paraguay", "🇵🇾"},
+	{"parasol_on_ground", "⛱️"},
+	{"parking", "🅿️"},
+	{"parrot", "🦜"},
+	{"part_alternation_mark", "〽️"},
+	{"partly_sunny", "⛅"},
+	{"partying_face", "🥳"},
+	{"passenger_ship", "🛳️"},
+	{"passport_control", "🛂"},
+	{"pause_button", "⏸️"},
+	{"pea_pod", "🫛"},
+	{"peace_symbol", "☮️"},
+	{"peach", "🍑"},
+	{"peacock", "🦚"},
+	{"peanuts", "🥜"},
+	{"pear", "🍐"},
+	{"pen", "🖊️"},
+	{"pencil2", "✏️"},
+	{"penguin", "🐧"},
+	{"pensive", "😔"},
+	{"people_holding_hands", "🧑\u200d🤝\u200d🧑"},
+	{"people_hugging", "🫂"},
+	{"performing_arts", "🎭"},
+	{"persevere", "😣"},
+	{"person_bald", "🧑\u200d🦲"},
+	{"person_curly_hair", "🧑\u200d🦱"},
+	{"person_feeding_baby", "🧑\u200d🍼"},
+	{"person_fencing", "🤺"},
+	{"person_in_manual_wheelchair", "🧑\u200d🦽"},
+	{"person_in_manual_wheelchair_facing_right", "🧑\u200d🦽\u200d➡️"},
+	{"person_in_motorized_wheelchair", "🧑\u200d🦼"},
+	{"person_in_motorized_wheelchair_facing_right", "🧑\u200d🦼\u200d➡️"},
+	{"person_in_tuxedo", "🤵"},
+	{"person_kneeling_facing_right", "🧎\u200d➡️"},
+	{"person_red_hair", "🧑\u200d🦰"},
+	{"person_running_facing_right", "🏃\u200d➡️"},
+	{"person_walking_facing_right", "🚶\u200d➡️"},
+	{"person_white_hair", "🧑\u200d🦳"},
+	{"person_with_crown", "🫅"},
+	{"person_with_probing_cane", "🧑\u200d🦯"},
+	{"person_with_turban", "👳"},
+	{"person_with_veil", "👰"},
+	{"person_with_white_cane_facing_right", "🧑\u200d🦯\u200d➡️"},
+	{"peru", "🇵🇪"},
+	{"petri_dish", "🧫"},
+	{"philippines", "🇵🇭"},
+	{"phoenix", "🐦\u200d🔥"},
+	{"phone", "☎️"},
+	{"telephone", "☎️"},
+	{"pick", "⛏️"},
+	{"pickup_truck", "🛻"},
+	{"pie", "🥧"},
+	{"pig", "🐷"},
+	{"pig2", "🐖"},
+	{"pig_nose", "🐽"},
+	{"pill", "💊"},
+	{"pilot", "🧑\u200d✈️"},
+	{"pinata", "🪅"},
+	{"pinched_fingers", "🤌"},
+	{"pinching_hand", "🤏"},
+	{"pineapple", "🍍"},
+	{"ping_pong", "🏓"},
+	{"pink_heart", "🩷"},
+	{"pirate_flag", "🏴\u200d☠️"},
+	{"pisces", "♓"},
+	{"pitcairn_islands", "🇵🇳"},
+	{"pizza", "🍕"},
+	{"placard", "🪧"},
+	{"place_of_worship", "🛐"},
+	{"plate_with_cutlery", "🍽️"},
+	{"play_or_pause_button", "⏯️"},
+	{"playground_slide", "🛝"},
+	{"pleading_face", "🥺"},
+	{"plunger", "🪠"},
+	{"point_down", "👇"},
+	{"point_left", "👈"},
+	{"point_right", "👉"},
+	{"point_up", "☝️"},
+	{"point_up_2", "👆"},
+	{"poland", "🇵🇱"},
+	{"polar_bear", "🐻\u200d❄️"},
+	{"police_car", "🚓"},
+	{"police_officer", "👮"},
+	{"cop", "👮"},
+	{"policeman", "👮\u200d♂️"},
+	{"policewoman", "👮\u200d♀️"},
+	{"poodle", "🐩"},
+	{"popcorn", "🍿"},
+	{"portugal", "🇵🇹"},
+	{"post_office", "🏣"},
+	{"postal_horn", "📯"},
+	{"postbox", "📮"},
+	{"potable_water", "🚰"},
+	{"potato", "🥔"},
+	{"potted_plant", "🪴"},
+	{"pouch", "👝"},
+	{"poultry_leg", "🍗"},
+	{"pound", "💷"},
+	{"pouring_liquid", "🫗"},
+	{"pouting_cat", "😾"},
+	{"pouting_face", "🙎"},
+	{"pouting_man", "🙎\u200d♂️"},
+	{"pouting_woman", "🙎\u200d♀️"},
+	{"pray", "🙏"},
+	{"prayer_beads", "📿"},
+	{"pregnant_man", "🫃"},
+	{"pregnant_person", "🫄"},
+	{"pregnant_woman", "🤰"},
+	{"pretzel", "🥨"},
+	{"previous_track_button", "⏮️"},
+	{"prince", "🤴"},
+	{"princess", "👸"},
+	{"printer", "🖨️"},
+	{"probing_cane", "🦯"},
+	{"puerto_rico", "🇵🇷"},
+	{"purple_circle", "🟣"},
+	{"purple_heart", "💜"},
+	{"purple_square", "🟪"},
+	{"purse", "👛"},
+	{"pushpin", "📌"},
+	{"put_litter_in_its_place", "🚮"},
+	{"qatar", "🇶🇦"},
+	{"question", "❓"},
+	{"rabbit", "🐰"},
+	{"rabbit2", "🐇"},
+	{"raccoon", "🦝"},
+	{"racehorse", "🐎"},
+	{"racing_car", "🏎️"},
+	{"radio", "📻"},
+	{"radio_button", "🔘"},
+	{"radioactive", "☢️"},
+	{"rage", "😡"},
+	{"pout", "😡"},
+	{"railway_car", "🚃"},
+	{"railway_track", "🛤️"},
+	{"rainbow", "🌈"},
+	{"rainbow_flag", "🏳️\u200d🌈"},
+	{"raised_back_of_hand", "🤚"},
+	{"raised_eyebrow", "🤨"},
+	{"raised_hand_with_fingers_splayed", "🖐️"},
+	{"raised_hands", "🙌"},
+	{"raising_hand", "🙋"},
+	{"raising_hand_man", "🙋\u200d♂️"},
+	{"raising_hand_woman", "🙋\u200d♀️"},
+	{"ram", "🐏"},
+	{"ramen", "🍜"},
+	{"rat", "🐀"},
+	{"razor", "🪒"},
+	{"receipt", "🧾"},
+	{"record_button", "⏺️"},
+	{"recycle", "♻️"},
+	{"red_circle", "🔴"},
+	{"red_envelope", "🧧"},
+	{"red_haired_man", "👨\u200d🦰"},
+	{"red_haired_woman", "👩\u200d🦰"},
+	{"red_square", "🟥"},
+	{"registered", "®️"},
+	{"relaxed", "☺️"},
+	{"relieved", "😌"},
+	{"reminder_ribbon", "🎗️"},
+	{"repeat", "🔁"},
+	{"repeat_one", "🔂"},
+	{"rescue_worker_helmet", "⛑️"},
+	{"restroom", "🚻"},
+	{"reunion", "🇷🇪"},
+	{"revolving_hearts", "💞"},
+	{"rewind", "⏪"},
+	{"rhinoceros", "🦏"},
+	{"ribbon", "🎀"},
+	{"rice", "🍚"},
+	{"rice_ball", "🍙"},
+	{"rice_cracker", "🍘"},
+	{"rice_scene", "🎑"},
+	{"right_anger_bubble", "🗯️"},
+	{"rightwards_hand", "🫱"},
+	{"rightwards_pushing_hand", "🫸"},
+	{"ring", "💍"},
+	{"ring_buoy", "🛟"},
+	{"ringed_planet", "🪐"},
+	{"robot", "🤖"},
+	{"rock", "🪨"},
+	{"rocket", "🚀"},
+	{"rofl", "🤣"},
+	{"roll_eyes", "🙄"},
+	{"roll_of_paper", "🧻"},
+	{"roller_coaster", "🎢"},
+	{"roller_skate", "🛼"},
+	{"romania", "🇷🇴"},
+	{"rooster", "🐓"},
+	{"root_vegetable", "🫜"},
+	{"rose", "🌹"},
+	{"rosette", "🏵️"},
+	{"rotating_light", "🚨"},
+	{"round_pushpin", "📍"},
+	{"rowboat", "🚣"},
+	{"rowing_man", "🚣\u200d♂️"},
+	{"rowing_woman", "🚣\u200d♀️"},
+	{"ru", "🇷🇺"},
+	{"rugby_football", "🏉"},
+	{"runner", "🏃"},
+	{"running", "🏃"},
+	{"running_man", "🏃\u200d♂️"},
+	{"running_shirt_with_sash", "🎽"},
+	{"running_woman", "🏃\u200d♀️"},
+	{"rwanda", "🇷🇼"},
+	{"sa", "🈂️"},
+	{"safety_pin", "🧷"},
+	{"safety_vest", "🦺"},
+	{"sagittarius", "♐"},
+	{"sake", "🍶"},
+	{"salt", "🧂"},
+	{"saluting_face", "🫡"},
+	{"samoa", "🇼🇸"},
+	{"san_marino", "🇸🇲"},
+	{"sandal", "👡"},
+	{"sandwich", "🥪"},
+	{"santa", "🎅"},
+	{"sao_tome_principe", "🇸🇹"},
+	{"sari", "🥻"},
+	{"satellite", "📡"},
+	{"saudi_arabia", "🇸🇦"},
+	{"sauna_man", "🧖\u200d♂️"},
+	{"sauna_person", "🧖"},
+	{"sauna_woman", "🧖\u200d♀️"},
+	{"sauropod", "🦕"},
+	{"saxophone", "🎷"},
+	{"scarf", "🧣"},
+	{"school", "🏫"},
+	{"school_satchel", "🎒"},
+	{"scientist", "🧑\u200d🔬"},
+	{"scissors", "✂️"},
+	{"scorpion", "🦂"},
+	{"scorpius", "♏"},
+	{"scotland", "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f"},
+	{"scream", "😱"},
+	{"scream_cat", "🙀"},
+	{"screwdriver", "🪛"},
+	{"scroll", "📜"},
+	{"seal", "🦭"},
+	{"seat", "💺"},
+	{"secret", "㊙️"},
+	{"see_no_evil", "🙈"},
+	{"seedling", "🌱"},
+	{"selfie", "🤳"},
+	{"senegal", "🇸🇳"},
+	{"serbia", "🇷🇸"},
+	{"service_dog", "🐕\u200d🦺"},
+	{"seven", "7️⃣"},
+	{"sewing_needle", "🪡"},
+	{"seychelles", "🇸🇨"},
+	{"shaking_face", "🫨"},
+	{"shallow_pan_of_food", "🥘"},
+	{"shamrock", "☘️"},
+	{"shark", "🦈"},
+	{"shaved_ice", "🍧"},
+	{"sheep", "🐑"},
+	{"shell", "🐚"},
+	{"shield", "🛡️"},
+	{"shinto_shrine", "⛩️"},
+	{"ship", "🚢"},
+	{"shirt", "👕"},
+	{"tshirt", "👕"},
+	{"shopping", "🛍️"},
+	{"shopping_cart", "🛒"},
+	{"shorts", "🩳"},
+	{"shovel", "🪏"},
+	{"shower", "🚿"},
+	{"shrimp", "🦐"},
+	{"shrug", "🤷"},
+	{"shushing_face", "🤫"},
+	{"sierra_leone", "🇸🇱"},
+	{"signal_strength", "📶"},
+	{"singapore", "🇸🇬"},
+	{"singer", "🧑\u200d🎤"},
+	{"sint_maarten", "🇸🇽"},
+	{"six", "6️⃣"},
+	{"six_pointed_star", "🔯"},
+	{"skateboard", "🛹"},
+	{"ski", "🎿"},
+	{"skier", "⛷️"},
+	{"skull", "💀"},
+	{"skull_and_crossbones", "☠️"},
+	{"skunk", "🦨"},
+	{"sled", "🛷"},
+	{"sleeping", "😴"},
+	{"sleeping_bed", "🛌"},
+	{"sleepy", "😪"},
+	{"slightly_frowning_face", "🙁"},
+	{"slightly_smiling_face", "🙂"},
+	{"slot_machine", "🎰"},
+	{"sloth", "🦥"},
+	{"slovakia", "🇸🇰"},
+	{"slovenia", "🇸🇮"},
+	{"small_airplane", "🛩️"},
+	{"small_blue_diamond", "🔹"},
+	{"small_orange_diamond", "🔸"},
+	{"small_red_triangle", "🔺"},
+	{"small_red_triangle_down", "🔻"},
+	{"smile", "😄"},
+	{"smile_cat", "😸"},
+	{"smiley", "😃"},
+	{"smiley_cat", "😺"},
+	{"smiling_face_with_tear", "🥲"},
+	{"smiling_face_with_three_hearts", "🥰"},
+	{"smiling_imp", "😈"},
+	{"smirk", "😏"},
+	{"smirk_cat", "😼"},
+	{"smoking", "🚬"},
+	{"snail", "🐌"},
+	{"snake", "🐍"},
+	{"sneezing_face", "🤧"},
+	{"snowboarder", "🏂"},
+	{"snowflake", "❄️"},
+	{"snowman", "⛄"},
+	{"snowman_with_snow", "☃️"},
+	{"soap", "🧼"},
+	{"sob", "😭"},
+	{"soccer", "⚽"},
+	{"socks", "🧦"},
+	{"softball", "🥎"},
+	{"solomon_islands", "🇸🇧"},
+	{"somalia", "🇸🇴"},
+	{"soon", "🔜"},
+	{"sos", "🆘"},
+	{"sound", "🔉"},
+	{"south_africa", "🇿🇦"},
+	{"south_georgia_south_sandwich_islands", "🇬🇸"},
+	{"south_sudan", "🇸🇸"},
+	{"space_invader", "👾"},
+	{"spades", "♠️"},
+	{"spaghetti", "🍝"},
+	{"sparkle", "❇️"},
+	{"sparkler", "🎇"},
+	{"sparkles", "✨"},
+	{"sparkling_heart", "💖"},
+	{"speak_no_evil", "🙊"},
+	{"speaker", "🔈"},
+	{"speaking_head", "🗣️"},
+	{"speech_balloon", "💬"},
+	{"speedboat", "🚤"},
+	{"spider", "🕷️"},
+	{"spider_web", "🕸️"},
+	{"spiral_calendar", "🗓️"},
+	{"spiral_notepad", "🗒️"},
+	{"splatter", "🫟"},
+	{"sponge", "🧽"},
+	{"spoon", "🥄"},
+	{"squid", "🦑"},
+	{"sri_lanka", "🇱🇰"},
+	{"st_barthelemy", "🇧🇱"},
+	{"st_helena", "🇸🇭"},
+	{"st_kitts_nevis", "🇰🇳"},
+	{"st_lucia", "🇱🇨"},
+	{"st_martin", "🇲🇫"},
+	{"st_pierre_miquelon", "🇵🇲"},
+	{"st_vincent_grenadines", "🇻🇨"},
+	{"stadium", "🏟️"},
+	{"standing_man", "🧍\u200d♂️"},
+	{"standing_person", "🧍"},
+	{"standing_woman", "🧍\u200d♀️"},
+	{"star", "⭐"},
+	{"star2", "🌟"},
+	{"star_and_crescent", "☪️"},
+	{"star_of_david", "✡️"},
+	{"star_struck", "🤩"},
+	{"stars", "🌠"},
+	{"station", "🚉"},
+	{"statue_of_liberty", "🗽"},
+	{"steam_locomotive", "🚂"},
+	{"stethoscope", "🩺"},
+	{"stew", "🍲"},
+	{"stop_button", "⏹️"},
+	{"stop_sign", "🛑"},
+	{"stopwatch", "⏱️"},
+	{"straight_ruler", "📏"},
+	{"strawberry", "🍓"},
+	{"stuck_out_tongue", "😛"},
+	{"stuck_out_tongue_closed_eyes", "😝"},
+	{"stuck_out_tongue_winking_eye", "😜"},
+	{"student", "🧑\u200d🎓"},
+	{"studio_microphone", "🎙️"},
+	{"stuffed_flatbread", "🥙"},
+	{"sudan", "🇸🇩"},
+	{"sun_behind_large_cloud", "🌥️"},
+	{"sun_behind_rain_cloud", "🌦️"},
+	{"sun_behind_small_cloud", "🌤️"},
+	{"sun_with_face", "🌞"},
+	{"sunflower", "🌻"},
+	{"sunglasses", "😎"},
+	{"sunny", "☀️"},
+	{"sunrise", "🌅"},
+	{"sunrise_over_mountains", "🌄"},
+	{"superhero", "🦸"},
+	{"superhero_man", "🦸\u200d♂️"},
+	{"superhero_woman", "🦸\u200d♀️"},
+	{"supervillain", "🦹"},
+	{"supervillain_man", "🦹\u200d♂️"},
+	{"supervillain_woman", "🦹\u200d♀️"},
+	{"surfer", "🏄"},
+	{"surfing_man", "🏄\u200d♂️"},
+	{"surfing_woman", "🏄\u200d♀️"},
+	{"suriname", "🇸🇷"},
+	{"sushi", "🍣"},
+	{"suspension_railway", "🚟"},
+	{"svalbard_jan_mayen", "🇸🇯"},
+	{"swan", "🦢"},
+	{"swaziland", "🇸🇿"},
+	{"sweat", "😓"},
+	{"sweat_drops", "💦"},
+	{"sweat_smile", "😅"},
+	{"sweden", "🇸🇪"},
+	{"sweet_potato", "🍠"},
+	{"swim_brief", "🩲"},
+	{"swimmer", "🏊"},
+	{"swimming_man", "🏊\u200d♂️"},
+	{"swimming_woman", "🏊\u200d♀️"},
+	{"switzerland", "🇨🇭"},
+	{"symbols", "🔣"},
+	{"synagogue", "🕍"},
+	{"syria", "🇸🇾"},
+	{"syringe", "💉"},
+	{"t-rex", "🦖"},
+	{"taco", "🌮"},
+	{"tada", "🎉"},
+	{"taiwan", "🇹🇼"},
+	{"tajikistan", "🇹🇯"},
+	{"takeout_box", "🥡"},
+	{"tamale", "🫔"},
+	{"tanabata_tree", "🎋"},
+	{"tangerine", "🍊"},
+	{"orange", "🍊"},
+	{"mandarin", "🍊"},
+	{"tanzania", "🇹🇿"},
+	{"taurus", "♉"},
+	{"taxi", "🚕"},
+	{"tea", "🍵"},
+	{"teacher", "🧑\u200d🏫"},
+	{"teapot", "🫖"},
+	{"technologist", "🧑\u200d💻"},
+	{"teddy_bear", "🧸"},
+	{"telephone_receiver", "📞"},
+	{"telescope", "🔭"},
+	{"tennis", "🎾"},
+	{"tent", "⛺"},
+	{"test_tube", "🧪"},
+	{"thailand", "🇹🇭"},
+	{"thermometer", "🌡️"},
+	{"thinking", "🤔"},
+	{"thong_sandal", "🩴"},
+	{"thought_balloon", "💭"},
+	{"thread", "🧵"},
+	{"three", "3️⃣"},
+	{"ticket", "🎫"},
+	{"tickets", "🎟️"},
+	{"tiger", "🐯"},
+	{"tiger2", "🐅"},
+	{"timer_clock", "⏲️"},
+	{"timor_leste", "🇹🇱"},
+	{"tipping_hand_man", "💁\u200d♂️"},
+	{"sassy_man", "💁\u200d♂️"},
+	{"tipping_hand_person", "💁"},
+	{"information_desk_person", "💁"},
+	{"tipping_hand_woman", "💁\u200d♀️"},
+	{"sassy_woman", "💁\u200d♀️"},
+	{"tired_face", "😫"},
+	{"tm", "™️"},
+	{"togo", "🇹🇬"},
+	{"toilet", "🚽"},
+	{"tokelau", "🇹🇰"},
+	{"tokyo_tower", "🗼"},
+	{"tomato", "🍅"},
+	{"tonga", "🇹🇴"},
+	{"tongue", "👅"},
+	{"toolbox", "🧰"},
+	{"tooth", "🦷"},
+	{"toothbrush", "🪥"},
+	{"top", "🔝"},
+	{"tophat", "🎩"},
+	{"tornado", "🌪️"},
+	{"tr", "🇹🇷"},
+	{"trackball", "🖲️"},
+	{"tractor", "🚜"},
+	{"traffic_light", "🚥"},
+	{"train", "🚋"},
+	{"train2", "🚆"},
+	{"tram", "🚊"},
+	{"transgender_flag", "🏳️\u200d⚧️"},
+	{"transgender_symbol", "⚧️"},
+	{"triangular_flag_on_post", "🚩"},
+	{"triangular_ruler", "📐"},
+	{"trident", "🔱"},
+	{"trinidad_tobago", "🇹🇹"},
+	{"tristan_da_cunha", "🇹🇦"},
+	{"triumph", "😤"},
+	{"troll", "🧌"},
+	{"trolleybus", "🚎"},
+	{"trophy", "🏆"},
+	{"tropical_drink", "🍹"},
+	{"tropical_fish", "🐠"},
+	{"truck", "🚚"},
+	{"trumpet", "🎺"},
+	{"tulip", "🌷"},
+	{"tumbler_glass", "🥃"},
+	{"tunisia", "🇹🇳"},
+	{"turkey", "🦃"},
+	{"turkmenistan", "🇹🇲"},
+	{"turks_caicos_islands", "🇹🇨"},
+	{"turtle", "🐢"},
+	{"tuvalu", "🇹🇻"},
+	{"tv", "📺"},
+	{"twisted_rightwards_arrows", "🔀"},
+	{"two", "2️⃣"},
+	{"two_hearts", "💕"},
+	{"two_men_holding_hands", "👬"},
+	{"two_women_holding_hands", "👭"},
+	{"u5272", "🈹"},
+	{"u5408", "🈴"},
+	{"u55b6", "🈺"},
+	{"u6307", "🈯"},
+	{"u6708", "🈷️"},
+	{"u6709", "🈶"},
+	{"u6e80", "🈵"},
+	{"u7121", "🈚"},
+	{"u7533", "🈸"},
+	{"u7981", "🈲"},
+	{"u7a7a", "🈳"},
+	{"uganda", "🇺🇬"},
+	{"ukraine", "🇺🇦"},
+	{"umbrella", "☔"},
+	{"unamused", "😒"},
+	{"underage", "🔞"},
+	{"unicorn", "🦄"},
+	{"united_arab_emirates", "🇦🇪"},
+	{"united_nations", "🇺🇳"},
+	{"unlock", "🔓"},
+	{"up", "🆙"},
+	{"upside_down_face", "🙃"},
+	{"uruguay", "🇺🇾"},
+	{"us", "🇺🇸"},
+	{"us_outlying_islands", "🇺🇲"},
+	{"us_virgin_islands", "🇻🇮"},
+	{"uzbekistan", "🇺🇿"},
+	{"v", "✌️"},
+	{"vampire", "🧛"},
+	{"vampire_man", "🧛\u200d♂️"},
+	{"vampire_woman", "🧛\u200d♀️"},
+	{"vanuatu", "🇻🇺"},
+	{"vatican_city", "🇻🇦"},
+	{"venezuela", "🇻🇪"},
+	{"vertical_traffic_light", "🚦"},
+	{"vhs", "📼"},
+	{"vibration_mode", "📳"},
+	{"video_camera", "📹"},
+	{"video_game", "🎮"},
+	{"vietnam", "🇻🇳"},
+	{"violin", "🎻"},
+	{"virgo", "♍"},
+	{"volcano", "🌋"},
+	{"volleyball", "🏐"},
+	{"vomiting_face", "🤮"},
+	{"vs", "🆚"},
+	{"vulcan_salute", "🖖"},
+	{"waffle", "🧇"},
+	{"wales", "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f"},
+	{"walking", "🚶"},
+	{"walking_man", "🚶\u200d♂️"},
+	{"walking_woman", "🚶\u200d♀️"},
+	{"wallis_futuna", "🇼🇫"},
+	{"waning_crescent_moon", "🌘"},
+	{"waning_gibbous_moon", "🌖"},
+	{"warning", "⚠️"},
+	{"wastebasket", "🗑️"},
+	{"watch", "⌚"},
+	{"water_buffalo", "🐃"},
+	{"water_polo", "🤽"},
+	{"watermelon", "🍉"},
+	{"wave", "👋"},
+	{"wavy_dash", "〰️"},
+	{"waxing_crescent_moon", "🌒"},
+	{"wc", "🚾"},
+	{"weary", "😩"},
+	{"wedding", "💒"},
+	{"weight_lifting", "🏋️"},
+	{"weight_lifting_man", "🏋️\u200d♂️"},
+	{"weight_lifting_woman", "🏋️\u200d♀️"},
+	{"western_sahara", "🇪🇭"},
+	{"whale", "🐳"},
+	{"whale2", "🐋"},
+	{"wheel", "🛞"},
+	{"wheel_of_dharma", "☸️"},
+	{"wheelchair", "♿"},
+	{"white_check_mark", "✅"},
+	{"white_circle", "⚪"},
+	{"white_flag", "🏳️"},
+	{"white_flower", "💮"},
+	{"white_haired_man", "👨\u200d🦳"},
+	{"white_haired_woman", "👩\u200d🦳"},
+	{"white_heart", "🤍"},
+	{"white_large_square", "⬜"},
+	{"white_medium_small_square", "◽"},
+	{"white_medium_square", "◻️"},
+	{"white_small_square", "▫️"},
+	{"white_square_button", "🔳"},
+	{"wilted_flower", "🥀"},
+	{"wind_chime", "🎐"},
+	{"wind_face", "🌬️"},
+	{"window", "🪟"},
+	{"wine_glass", "🍷"},
+	{"wing", "🪽"},
+	{"wink", "😉"},
+	{"wireless", "🛜"},
+	{"wolf", "🐺"},
+	{"woman", "👩"},
+	{"woman_artist", "👩\u200d🎨"},
+	{"woman_astronaut", "👩\u200d🚀"},
+	{"woman_beard", "🧔\u200d♀️"},
+	{"woman_cartwheeling", "🤸\u200d♀️"},
+	{"woman_cook", "👩\u200d🍳"},
+	{"woman_dancing", "💃"},
+	{"dancer", "💃"},
+	{"woman_facepalming", "🤦\u200d♀️"},
+	{"woman_factory_worker", "👩\u200d🏭"},
+	{"woman_farmer", "👩\u200d🌾"},
+	{"woman_feeding_baby", "👩\u200d🍼"},
+	{"woman_firefighter", "👩\u200d🚒"},
+	{"woman_health_worker", "👩\u200d⚕️"},
+	{"woman_in_manual_wheelchair", "👩\u200d🦽"},
+	{"woman_in_manual_wheelchair_facing_right", "👩\u200d🦽\u200d➡️"},
+	{"woman_in_motorized_wheelchair", "👩\u200d🦼"},
+	{"woman_in_motorized_wheelchair_facing_right", "👩\u200d🦼\u200d➡️"},
+	{"woman_in_tuxedo", "🤵\u200d♀️"},
+	{"woman_judge", "👩\u200d⚖️"},
+	{"woman_juggling", "🤹\u200d♀️"},
+	{"woman_kneeling_facing_right", "🧎\u200d♀️\u200d➡️"},
+	{"woman_mechanic", "👩\u200d🔧"},
+	{"woman_office_worker", "👩\u200d💼"},
+	{"woman_pilot", "👩\u200d✈️"},
+	{"woman_playing_handball", "🤾\u200d♀️"},
+	{"woman_playing_water_polo", "🤽\u200d♀️"},
+	{"woman_running_facing_right", "🏃\u200d♀️\u200d➡️"},
+	{"woman_scientist", "👩\u200d🔬"},
+	{"woman_shrugging", "🤷\u200d♀️"},
+	{"woman_singer", "👩\u200d🎤"},
+	{"woman_student", "👩\u200d🎓"},
+	{"woman_teacher", "👩\u200d🏫"},
+	{"woman_technologist", "👩\u200d💻"},
+	{"woman_walking_facing_right", "🚶\u200d♀️\u200d➡️"},
+	{"woman_with_headscarf", "🧕"},
+	{"woman_with_probing_cane", "👩\u200d🦯"},
+	{"woman_with_turban", "👳\u200d♀️"},
+	{"woman_with_veil", "👰\u200d♀️"},
+	{"bride_with_veil", "👰\u200d♀️"},
+	{"woman_with_white_cane_facing_right", "👩\u200d🦯\u200d➡️"},
+	{"womans_clothes", "👚"},
+	{"womans_hat", "👒"},
+	{"women_wrestling", "🤼\u200d♀️"},
+	{"womens", "🚺"},
+	{"wood", "🪵"},
+	{"woozy_face", "🥴"},
+	{"world_map", "🗺️"},
+	{"worm", "🪱"},
+	{"worried", "😟"},
+	{"wrench", "🔧"},
+	{"wrestling", "🤼"},
+	{"writing_hand", "✍️"},
+	{"x", "❌"},
+	{"x_ray", "🩻"},
+	{"yarn", "🧶"},
+	{"yawning_face", "🥱"},
+	{"yellow_circle", "🟡"},
+	{"yellow_heart", "💛"},
+	{"yellow_square", "🟨"},
+	{"yemen", "🇾🇪"},
+	{"yen", "💴"},
+	{"yin_yang", "☯️"},
+	{"yo_yo", "🪀"},
+	{"yum", "😋"},
+	{"zambia", "🇿🇲"},
+	{"zany_face", "🤪"},
+	{"zap", "⚡"},
+	{"zebra", "🦓"},
+	{"zero", "0️⃣"},
+	{"zimbabwe", "🇿🇼"},
+	{"zipper_mouth_face", "🤐"},
+	{"zombie", "🧟"},
+	{"zombie_man", "🧟\u200d♂️"},
+	{"zombie_woman", "🧟\u200d♀️"},
+	{"zzz", "💤"},
+}
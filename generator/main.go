@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"slices"
@@ -11,31 +12,90 @@ import (
 )
 
 func main() {
-	xml := internal.LoadXML("ucd.nounihan.flat.xml")
+	ucdPath := flag.String("ucd", "ucd.nounihan.flat.zip", "ucd.nounihan.flat.zip or the extracted ucd.nounihan.flat.xml")
+	sequencesPath := flag.String("sequences", "emoji-sequences.txt", "emoji-sequences.txt")
+	zwjPath := flag.String("zwj", "emoji-zwj-sequences.txt", "emoji-zwj-sequences.txt")
+	testPath := flag.String("test", "emoji-test.txt", "emoji-test.txt")
+	gemojiPath := flag.String("gemoji", "emoji.json", "db/emoji.json of rhysd/gemoji")
+	output := flag.String("o", "generated_data.go", "output file")
+	flag.Parse()
+
+	ucd := internal.LoadUCD(*ucdPath)
+	version := unicodeVersion(ucd)
+	checkVersion(version, *sequencesPath, *zwjPath, *testPath)
+	sequences := internal.LoadTXT(*sequencesPath)
 
 	builder := new(strings.Builder)
 	builder.WriteString("// Code generated by generator/main.go DO NOT EDIT.\n\n")
 	builder.WriteString("package emojitoolkit\n\n")
+	builder.WriteString("import \"unicode\"\n\n")
+	builder.WriteString("// Supported Unicode version\n")
+	builder.WriteString("const Version = " + strconv.Quote(version) + "\n\n")
 
-	repertoire := xml.GetFirstChild("repertoire")
+	repertoire := ucd.Repertoire
 	builder.WriteString("var emoji_ranges1 = " + GenerateEmojiRanges(repertoire) + "\n")
 	builder.WriteString("var emoji_ranges2 = " + GenerateEmojiRanges2(repertoire) + "\n")
-	builder.WriteString("var emoji_ranges3 = " + GenerateEmojiRanges3(repertoire) + "\n")
+	builder.WriteString("var emoji_ranges3 = " + GenerateEmojiRanges3(sequences) + "\n")
+
+	builder.WriteString("var emoji_table = " + GenerateRangeTable(repertoire, func(c internal.Char) bool { return c.Emoji }) + "\n")
+	builder.WriteString("var emoji_presentation_table = " + GenerateRangeTable(repertoire, func(c internal.Char) bool { return c.EPres }) + "\n")
+	builder.WriteString("var emoji_modifier_table = " + GenerateRangeTable(repertoire, func(c internal.Char) bool { return c.EMod }) + "\n")
+	builder.WriteString("var emoji_modifier_base_table = " + GenerateRangeTable(repertoire, func(c internal.Char) bool { return c.EBase }) + "\n")
+	builder.WriteString("var emoji_component_table = " + GenerateRangeTable(repertoire, func(c internal.Char) bool { return c.EComp }) + "\n")
+	builder.WriteString("var extended_pictographic_table = " + GenerateRangeTable(repertoire, func(c internal.Char) bool { return c.ExtPict }) + "\n")
+
+	builder.WriteString("var grapheme_ranges = " + GenerateGraphemeRanges(repertoire) + "\n")
+	builder.WriteString("var wide_ranges = " + GenerateEastAsianWidthRanges(repertoire, "W", "F") + "\n")
+	builder.WriteString("var ambiguous_ranges = " + GenerateEastAsianWidthRanges(repertoire, "A") + "\n")
+
+	builder.WriteString("var variant_ranges = " + GenerateVariantRanges(ucd.StandardizedVariants) + "\n")
+
+	zwj := internal.LoadTXT(*zwjPath)
+	builder.WriteString("var zwj_sequences = " + GenerateSequences(zwj, "RGI_Emoji_ZWJ_Sequence") + "\n")
+
+	builder.WriteString("var tag_sequences = " + GenerateSequences(sequences, "RGI_Emoji_Tag_Sequence") + "\n")
+	builder.WriteString("var flag_sequences = " + GenerateSequences(sequences, "RGI_Emoji_Flag_Sequence") + "\n")
 
-	variants := xml.GetFirstChild("standardized-variants")
-	builder.WriteString("var variant_ranges = " + GenerateVariantRanges(variants) + "\n")
+	tests := internal.LoadEmojiTest(*testPath)
+	builder.WriteString("var emoji_test = " + GenerateEmojiTest(tests, version) + "\n")
+
+	gemoji := internal.LoadGemoji(*gemojiPath)
+	builder.WriteString("var gemoji = " + GenerateGemoji(gemoji) + "\n")
+
+	err := os.WriteFile(*output, []byte(builder.String()), os.ModePerm)
+	if err != nil {
+		panic("Error writing file: " + err.Error())
+	}
+}
+
+// Returns the Unicode version like "17.0.0" from the description of the UCD like "Unicode 17.0.0".
+// It is written to generated_data.go as Version so the generator does not depend on
+// the package it generates.
+func unicodeVersion(ucd internal.UCD) string {
+	version, ok := strings.CutPrefix(ucd.Description, "Unicode ")
+	if !ok || strings.Count(version, ".") != 2 {
+		panic(fmt.Sprintf("UCD has no Unicode version in its description %q", ucd.Description))
+	}
+	return version
+}
 
-	os.WriteFile("generated_data.go", []byte(builder.String()), os.ModePerm)
+// Checks that the emoji data files have the same version like "17.0" as the UCD like "17.0.0".
+// Emoji versions are aligned with the Unicode version but have no update number.
+func checkVersion(version string, paths ...string) {
+	for _, path := range paths {
+		if v := internal.LoadVersion(path); !strings.HasPrefix(version, v+".") {
+			panic(fmt.Sprintf("%s is version %s but the UCD is Unicode %s", path, v, version))
+		}
+	}
 }
 
 // Singe Codepoint emojis with Emoji_Presentation=Yes & Emoji_Component=No
-func GenerateEmojiRanges(repertoire internal.AnyXML) string {
+func GenerateEmojiRanges(repertoire []internal.Char) string {
 	codepoints := make([]int32, 0, 1024)
 
-	for _, char := range repertoire.Children {
-		if char.GetAttr("EPres") == "Y" && char.GetAttr("EComp") == "N" {
-			n, _ := strconv.ParseUint(char.GetAttr("cp"), 16, 32)
-			codepoints = append(codepoints, int32(n))
+	for _, char := range repertoire {
+		if char.EPres && !char.EComp {
+			codepoints = appendRange(codepoints, char)
 		}
 	}
 
@@ -46,49 +106,106 @@ func GenerateEmojiRanges(repertoire internal.AnyXML) string {
 // These characters will appear as emojis when followed by U+FE0F (Variation Selector-16)
 //
 // ED-7 see https://www.unicode.org/reports/tr51/#def_text_presentation
-func GenerateEmojiRanges2(repertoire internal.AnyXML) string {
+func GenerateEmojiRanges2(repertoire []internal.Char) string {
 	codepoints := make([]int32, 0, 1024)
 
-	for _, char := range repertoire.Children {
-		if char.GetAttr("Emoji") == "Y" && char.GetAttr("EPres") == "N" {
-			n, _ := strconv.ParseUint(char.GetAttr("cp"), 16, 32)
-			codepoints = append(codepoints, int32(n))
+	for _, char := range repertoire {
+		if char.Emoji && !char.EPres {
+			codepoints = appendRange(codepoints, char)
 		}
 	}
 
 	return writeRanges(codepoints)
 }
 
-// Emojis that can be used in a RGI_Emoji_Modifier_Sequence
+// Emojis that can be used in a RGI_Emoji_Modifier_Sequence.
+// These are the bases of all modifier sequences listed in emoji-sequences.txt.
+// Not every Emoji_Modifier_Base has RGI modifier sequences like U+1F46A Family.
 //
 // ED-22 see https://www.unicode.org/reports/tr51/#def_std_emoji_modifier_sequence_set
-func GenerateEmojiRanges3(repertoire internal.AnyXML) string {
+func GenerateEmojiRanges3(lines [][]string) string {
+	codepoints := make([]int32, 0, 1024)
+
+	for _, fields := range lines {
+		if fields[1] != "RGI_Emoji_Modifier_Sequence" {
+			continue
+		}
+
+		base, _, _ := strings.Cut(fields[0], " ")
+		n, _ := strconv.ParseUint(base, 16, 32)
+		codepoints = append(codepoints, int32(n))
+	}
+
+	slices.Sort(codepoints)
+	return writeRanges(slices.Compact(codepoints))
+}
+
+// Codepoints with a binary emoji property like Emoji_Presentation as a
+// unicode.RangeTable for use with unicode.Is. Ranges below U+10000 go into R16
+// and all others into R32.
+//
+// ED-3 to ED-6 see https://www.unicode.org/reports/tr51/#Emoji_Properties
+func GenerateRangeTable(repertoire []internal.Char, property func(internal.Char) bool) string {
 	codepoints := make([]int32, 0, 1024)
 
-	for _, char := range repertoire.Children {
-		if char.GetAttr("EBase") == "Y" {
-			n, _ := strconv.ParseUint(char.GetAttr("cp"), 16, 32)
-			if n != 0x1F46A {
-				// Skip U+1F46A Family
-				codepoints = append(codepoints, int32(n))
+	for _, char := range repertoire {
+		if property(char) {
+			codepoints = appendRange(codepoints, char)
+		}
+	}
+
+	if len(codepoints) == 0 {
+		panic("No codepoints with the property")
+	}
+	slices.Sort(codepoints)
+
+	r16 := new(strings.Builder)
+	r32 := new(strings.Builder)
+	latinOffset := 0
+	for i := 0; i < len(codepoints); {
+		j := i
+		for j+1 < len(codepoints) && codepoints[j+1] == codepoints[j]+1 {
+			j++
+		}
+
+		lo, hi := codepoints[i], codepoints[j]
+		if hi <= 0xFFFF {
+			fmt.Fprintf(r16, "\t\t{Lo: 0x%04x, Hi: 0x%04x, Stride: 1},\n", lo, hi)
+			if hi <= 0xFF {
+				latinOffset++
 			}
+		} else if lo > 0xFFFF {
+			fmt.Fprintf(r32, "\t\t{Lo: 0x%x, Hi: 0x%x, Stride: 1},\n", lo, hi)
+		} else {
+			panic(fmt.Sprintf("Range %X..%X crosses U+FFFF", lo, hi))
 		}
+		i = j + 1
 	}
 
-	return writeRanges(codepoints)
+	builder := new(strings.Builder)
+	builder.WriteString("&unicode.RangeTable{\n")
+	if r16.Len() > 0 {
+		builder.WriteString("\tR16: []unicode.Range16{\n" + r16.String() + "\t},\n")
+	}
+	if r32.Len() > 0 {
+		builder.WriteString("\tR32: []unicode.Range32{\n" + r32.String() + "\t},\n")
+	}
+	if latinOffset > 0 {
+		fmt.Fprintf(builder, "\tLatinOffset: %d,\n", latinOffset)
+	}
+	builder.WriteString("}")
+	return builder.String()
 }
 
-func GenerateVariantRanges(variants internal.AnyXML) string {
+func GenerateVariantRanges(variants []internal.StandardizedVariant) string {
 	text_variants := make([]int32, 0, 256)
 	emoji_variants := make([]int32, 0, 256)
-	for _, variant := range variants.Children {
-		desc := variant.GetAttr("desc")
-		cps := variant.GetAttr("cps")
-		codepoint, _ := strconv.ParseInt(strings.SplitN(cps, " ", 2)[0], 16, 32)
+	for _, variant := range variants {
+		codepoint, _ := strconv.ParseInt(strings.SplitN(variant.Codepoints, " ", 2)[0], 16, 32)
 
-		if desc == "text style" {
+		if variant.Desc == "text style" {
 			text_variants = append(text_variants, int32(codepoint))
-		} else if desc == "emoji style" {
+		} else if variant.Desc == "emoji style" {
 			emoji_variants = append(emoji_variants, int32(codepoint))
 		}
 	}
@@ -104,6 +221,187 @@ func GenerateVariantRanges(variants internal.AnyXML) string {
 	return writeRanges(emoji_variants)
 }
 
+// Grapheme_Cluster_Break, Extended_Pictographic and Indic_Conjunct_Break properties
+// combined into one property per codepoint for the extended grapheme cluster rules of UAX #29.
+// Each range is written as three int32: first codepoint, last codepoint and property.
+// Codepoints with the property Other are omitted.
+//
+// UAX #29 see https://www.unicode.org/reports/tr29/#Grapheme_Cluster_Boundary_Rules
+func GenerateGraphemeRanges(repertoire []internal.Char) string {
+	properties := map[string]string{
+		"CN":  "gcbControl",
+		"CR":  "gcbCR",
+		"EX":  "gcbExtend",
+		"L":   "gcbL",
+		"LF":  "gcbLF",
+		"LV":  "gcbLV",
+		"LVT": "gcbLVT",
+		"PP":  "gcbPrepend",
+		"RI":  "gcbRegionalIndicator",
+		"SM":  "gcbSpacingMark",
+		"T":   "gcbT",
+		"V":   "gcbV",
+		"XX":  "",
+		"ZWJ": "gcbZWJ",
+	}
+
+	type graphemeRange struct {
+		first, last rune
+		property    string
+	}
+	ranges := make([]graphemeRange, 0, 2048)
+
+	for _, char := range repertoire {
+		property, ok := properties[char.GCB]
+		if !ok {
+			panic("Unknown Grapheme_Cluster_Break: " + char.GCB)
+		}
+
+		// Extended_Pictographic and Indic_Conjunct_Break only refine these properties
+		switch {
+		case char.ExtPict && property == "":
+			property = "gcbExtendedPictographic"
+		case char.InCB == "Consonant" && property == "":
+			property = "gcbConsonant"
+		case char.InCB == "Linker" && property == "gcbExtend":
+			property = "gcbLinker"
+		case char.InCB == "Extend" && property == "gcbExtend":
+			property = "gcbExtendInCB"
+		case char.ExtPict,
+			char.InCB == "Extend" && property != "gcbZWJ",
+			char.InCB == "Linker",
+			char.InCB == "Consonant":
+			panic(fmt.Sprintf("Unexpected combination of properties at U+%04X", char.First))
+		}
+		if property == "" {
+			continue
+		}
+
+		if n := len(ranges); n > 0 && ranges[n-1].last+1 == char.First && ranges[n-1].property == property {
+			ranges[n-1].last = char.Last
+		} else {
+			ranges = append(ranges, graphemeRange{char.First, char.Last, property})
+		}
+	}
+
+	builder := new(strings.Builder)
+	builder.WriteString("[]int32{\n")
+	for _, r := range ranges {
+		fmt.Fprintf(builder, "\t%d, %d, %s,\n", r.first, r.last, r.property)
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+// Codepoints with one of the given East_Asian_Width values like W (Wide) and F (Fullwidth)
+// or A (Ambiguous). Unassigned codepoints are included because they have a default value.
+//
+// UAX #11 see https://www.unicode.org/reports/tr11/
+func GenerateEastAsianWidthRanges(repertoire []internal.Char, widths ...string) string {
+	codepoints := make([]int32, 0, 1<<16)
+
+	for _, char := range repertoire {
+		if slices.Contains(widths, char.EA) {
+			codepoints = appendRange(codepoints, char)
+		}
+	}
+
+	return writeRanges(codepoints)
+}
+
+// Appends all codepoints of a char which can be a range of codepoints
+func appendRange(codepoints []int32, char internal.Char) []int32 {
+	for cp := char.First; cp <= char.Last; cp++ {
+		codepoints = append(codepoints, cp)
+	}
+	return codepoints
+}
+
+// Sequences of a type like RGI_Emoji_ZWJ_Sequence from emoji-sequences.txt or emoji-zwj-sequences.txt.
+// All U+FE0F (Variation Selector-16) are removed and the result is sorted for binary search.
+//
+// ED-23 see https://www.unicode.org/reports/tr51/#def_rgi_emoji_flag_sequence_set
+// ED-24 see https://www.unicode.org/reports/tr51/#def_rgi_emoji_tag_sequence_set
+// ED-25 see https://www.unicode.org/reports/tr51/#def_rgi_emoji_zwj_sequence_set
+func GenerateSequences(lines [][]string, typeField string) string {
+	sequences := make([]string, 0, 2048)
+
+	for _, fields := range lines {
+		if fields[1] != typeField {
+			continue
+		}
+
+		seq := internal.ParseCodepoints(fields[0])
+		sequences = append(sequences, strings.ReplaceAll(seq, "\uFE0F", ""))
+	}
+
+	slices.Sort(sequences)
+	return fmt.Sprintf("%#v", slices.Compact(sequences))
+}
+
+// Name, group, subgroup, version and qualification status of every emoji
+// listed in emoji-test.txt, sorted by sequence for binary search.
+// The version is written as an EmojiVersion constant like E13_1.
+func GenerateEmojiTest(tests []internal.EmojiTest, version string) string {
+	statuses := map[string]string{
+		"component":           "Component",
+		"fully-qualified":     "FullyQualified",
+		"minimally-qualified": "MinimallyQualified",
+		"unqualified":         "Unqualified",
+	}
+
+	slices.SortFunc(tests, func(a, b internal.EmojiTest) int {
+		return strings.Compare(internal.ParseCodepoints(a.Codepoints), internal.ParseCodepoints(b.Codepoints))
+	})
+
+	builder := new(strings.Builder)
+	builder.WriteString("[]emojiTest{\n")
+	for _, test := range tests {
+		status, ok := statuses[test.Status]
+		if !ok {
+			panic("Unknown status in emoji-test.txt: " + test.Status)
+		}
+
+		fmt.Fprintf(builder, "\t{%q, %q, %q, %q, %s, %s},\n",
+			internal.ParseCodepoints(test.Codepoints), test.Name, test.Group, test.Subgroup, emojiVersionConstant(test.Version, version), status)
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+// Returns the name of the EmojiVersion constant for a version like "13.1" which is E13_1.
+// Versions newer than the Unicode version of the UCD have no constant yet.
+func emojiVersionConstant(version, latestVersion string) string {
+	major, minor, ok := strings.Cut(version, ".")
+	x, err1 := strconv.Atoi(major)
+	y, err2 := strconv.Atoi(minor)
+	if !ok || err1 != nil || err2 != nil {
+		panic("Invalid version in emoji-test.txt: " + version)
+	}
+
+	latest := strings.Split(latestVersion, ".")
+	latestMajor, _ := strconv.Atoi(latest[0])
+	latestMinor, _ := strconv.Atoi(latest[1])
+	if x > latestMajor || x == latestMajor && y > latestMinor {
+		panic(fmt.Sprintf("Emoji version %s in emoji-test.txt is newer than Unicode %s", version, latestVersion))
+	}
+	return "E" + major + "_" + minor
+}
+
+// Shortcodes used by GitHub as pairs of shortcode and emoji.
+// The first shortcode of an emoji is the preferred one.
+func GenerateGemoji(gemoji []internal.Gemoji) string {
+	builder := new(strings.Builder)
+	builder.WriteString("[][2]string{\n")
+	for _, e := range gemoji {
+		for _, alias := range e.Aliases {
+			fmt.Fprintf(builder, "\t{%q, %q},\n", alias, e.Emoji)
+		}
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
 func writeRanges(codepoints []int32) string {
 	ranges := make([]int32, 0)
 	current_range := make([]int32, 0)
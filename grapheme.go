@@ -0,0 +1,169 @@
+package emojitoolkit
+
+import (
+	"iter"
+	"unicode/utf8"
+)
+
+// Properties of a codepoint used by the extended grapheme cluster rules of UAX #29.
+// These combine Grapheme_Cluster_Break, Extended_Pictographic and Indic_Conjunct_Break.
+const (
+	gcbOther = iota
+	gcbControl
+	gcbCR
+	gcbLF
+	gcbExtend
+	gcbExtendInCB // Extend with Indic_Conjunct_Break=Extend
+	gcbLinker     // Extend with Indic_Conjunct_Break=Linker
+	gcbZWJ
+	gcbPrepend
+	gcbSpacingMark
+	gcbRegionalIndicator
+	gcbL
+	gcbV
+	gcbT
+	gcbLV
+	gcbLVT
+	gcbExtendedPictographic
+	gcbConsonant // Other with Indic_Conjunct_Break=Consonant
+)
+
+// Iterates over the extended grapheme clusters of a string as defined by [UAX #29].
+// A grapheme cluster is what a user perceives as a single character like
+// "é", "🇩🇪" or "👨‍👩‍👧". Every emoji found by [All] is part of a single cluster.
+//
+// Examples:
+//
+//	"Hi!" -> "H", "i", "!"
+//	"é" -> "é"
+//	"🇩🇪🇫🇷" -> "🇩🇪", "🇫🇷"
+//	"👍🏽👨‍👩‍👧" -> "👍🏽", "👨‍👩‍👧"
+//	"\r\n" -> "\r\n"
+//
+// [UAX #29]: https://www.unicode.org/reports/tr29/#Grapheme_Cluster_Boundaries
+func Graphemes(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for len(s) > 0 {
+			n := graphemeLen(s)
+			if !yield(s[:n]) {
+				return
+			}
+			s = s[n:]
+		}
+	}
+}
+
+// Returns the length in bytes of the extended grapheme cluster at the start of s
+func graphemeLen(s string) int {
+	// ASCII fast path: there are no ASCII characters that extend a cluster
+	if len(s) == 1 || s[0] < utf8.RuneSelf && s[0] != '\r' && s[1] < utf8.RuneSelf {
+		return 1
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+	prop := graphemeProperty(r)
+
+	ri := 0          // number of consecutive regional indicators
+	pict := false    // Extended_Pictographic Extend* so far (GB11)
+	pictZWJ := false // Extended_Pictographic Extend* ZWJ so far (GB11)
+	conjunct := 0    // 1 after InCB=Consonant, 2 after InCB=Consonant and a InCB=Linker (GB9c)
+	updateState := func(p int) {
+		if p == gcbRegionalIndicator {
+			ri++
+		} else {
+			ri = 0
+		}
+
+		pictZWJ = pict && p == gcbZWJ
+		switch p {
+		case gcbExtendedPictographic:
+			pict = true
+		case gcbExtend, gcbExtendInCB, gcbLinker:
+		default:
+			pict = false
+		}
+
+		switch p {
+		case gcbConsonant:
+			conjunct = 1
+		case gcbLinker:
+			if conjunct > 0 {
+				conjunct = 2
+			}
+		case gcbExtendInCB, gcbZWJ:
+		default:
+			conjunct = 0
+		}
+	}
+	updateState(prop)
+
+	for n < len(s) {
+		next, m := utf8.DecodeRuneInString(s[n:])
+		nextProp := graphemeProperty(next)
+		if graphemeBreak(prop, nextProp, ri, pictZWJ, conjunct) {
+			break
+		}
+
+		updateState(nextProp)
+		prop = nextProp
+		n += m
+	}
+	return n
+}
+
+// Decides if there is a grapheme cluster boundary between two codepoints with the
+// properties prev and next according to the rules GB3 to GB999 of UAX #29.
+func graphemeBreak(prev, next, ri int, pictZWJ bool, conjunct int) bool {
+	switch {
+	case prev == gcbCR && next == gcbLF: // GB3
+		return false
+	case prev == gcbControl || prev == gcbCR || prev == gcbLF: // GB4
+		return true
+	case next == gcbControl || next == gcbCR || next == gcbLF: // GB5
+		return true
+	case prev == gcbL && (next == gcbL || next == gcbV || next == gcbLV || next == gcbLVT): // GB6
+		return false
+	case (prev == gcbLV || prev == gcbV) && (next == gcbV || next == gcbT): // GB7
+		return false
+	case (prev == gcbLVT || prev == gcbT) && next == gcbT: // GB8
+		return false
+	case next == gcbExtend || next == gcbExtendInCB || next == gcbLinker || next == gcbZWJ: // GB9
+		return false
+	case next == gcbSpacingMark: // GB9a
+		return false
+	case prev == gcbPrepend: // GB9b
+		return false
+	case conjunct == 2 && next == gcbConsonant: // GB9c
+		return false
+	case pictZWJ && next == gcbExtendedPictographic: // GB11
+		return false
+	case prev == gcbRegionalIndicator && next == gcbRegionalIndicator: // GB12, GB13
+		return ri%2 == 0
+	}
+	return true // GB999
+}
+
+// Returns the combined grapheme cluster property of a codepoint
+func graphemeProperty(r rune) int {
+	ranges := grapheme_ranges
+	n := len(ranges) / 3
+	if n == 0 || r < rune(ranges[0]) || r > rune(ranges[len(ranges)-2]) {
+		return gcbOther
+	}
+
+	// Find the first range that ends at or after r
+	i, j := 0, n
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if rune(ranges[3*h+1]) < r {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+
+	if rune(ranges[3*i]) <= r {
+		return int(ranges[3*i+2])
+	}
+	return gcbOther
+}
@@ -0,0 +1,97 @@
+package emojitoolkit
+
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGraphemes(t *testing.T) {
+	testCases := map[string][]string{
+		"":                  {},
+		"Hi!":               {"H", "i", "!"},
+		"e\u0301":           {"e\u0301"},
+		"\r\n":              {"\r\n"},
+		"🇩🇪🇫🇷":              {"🇩🇪", "🇫🇷"},
+		"🇩🇪🇫":               {"🇩🇪", "🇫"},
+		"👍🏽👨\u200D👩\u200D👧": {"👍🏽", "👨\u200D👩\u200D👧"},
+		"☀\uFE0F.":          {"☀\uFE0F", "."},
+		"1\uFE0F\u20E3":     {"1\uFE0F\u20E3"},
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": {"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F"},
+		"a\u200D👨":       {"a\u200D", "👨"},
+		"क\u094D\u200Dष": {"क\u094D\u200Dष"},
+	}
+
+	for input, expected := range testCases {
+		result := slices.Collect(Graphemes(input))
+		if !slices.Equal(result, expected) {
+			t.Fatalf("Graphemes(%q) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestGraphemesEmojiTest(t *testing.T) {
+	for _, e := range emoji_test {
+		if e.status != FullyQualified {
+			continue
+		}
+
+		if result := slices.Collect(Graphemes(e.sequence)); len(result) != 1 {
+			t.Fatalf("Graphemes(%q) = %q; want a single cluster", e.sequence, result)
+		}
+	}
+}
+
+// Runs the official test cases of UAX #29
+func TestGraphemeBreakTest(t *testing.T) {
+	f, err := os.Open("testdata/GraphemeBreakTest.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		// Lines look like "÷ 0020 × 0308 ÷"
+		var input strings.Builder
+		expected := []string{}
+		cluster := ""
+		for field := range strings.FieldsSeq(line) {
+			switch field {
+			case "÷":
+				if cluster != "" {
+					expected = append(expected, cluster)
+				}
+				cluster = ""
+			case "×":
+			default:
+				cp, err := strconv.ParseUint(field, 16, 32)
+				if err != nil {
+					t.Fatal(err)
+				}
+				cluster += string(rune(cp))
+				input.WriteRune(rune(cp))
+			}
+		}
+
+		result := slices.Collect(Graphemes(input.String()))
+		if !slices.Equal(result, expected) {
+			t.Fatalf("Graphemes(%q) = %q; want %q // %s", input.String(), result, expected, line)
+		}
+	}
+}
+
+func BenchmarkGraphemes(b *testing.B) {
+	for b.Loop() {
+		for range Graphemes(benchmarkChat) {
+		}
+	}
+}
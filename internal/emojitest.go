@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"os"
+	"strings"
+)
+
+// A single line of emoji-test.txt
+type EmojiTest struct {
+	Codepoints string // space separated list of hexadecimal codepoints
+	Status     string // fully-qualified, minimally-qualified, unqualified or component
+	Name       string // CLDR short name
+	Version    string // Emoji version without the leading E like "1.0"
+	Group      string
+	Subgroup   string
+}
+
+// Loads emoji-test.txt which is the only emoji data file where
+// the comments carry information: group and subgroup headers as well
+// as the version and name of each emoji.
+func LoadEmojiTest(path string) []EmojiTest {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		panic("Error reading file: " + err.Error())
+	}
+
+	ret := []EmojiTest{}
+	group, subgroup := "", ""
+	for line := range strings.Lines(string(data)) {
+		line = strings.TrimSpace(line)
+		if g, ok := strings.CutPrefix(line, "# group:"); ok {
+			group = strings.TrimSpace(g)
+			continue
+		}
+		if g, ok := strings.CutPrefix(line, "# subgroup:"); ok {
+			subgroup = strings.TrimSpace(g)
+			continue
+		}
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		values, comment, _ := strings.Cut(line, "#")
+		codepoints, status, _ := strings.Cut(values, ";")
+
+		// comment is "<emoji> E<version> <name>"
+		fields := strings.SplitN(strings.TrimSpace(comment), " ", 3)
+		if len(fields) != 3 || !strings.HasPrefix(fields[1], "E") {
+			panic("Malformed comment in emoji-test.txt: " + line)
+		}
+
+		ret = append(ret, EmojiTest{
+			Codepoints: strings.TrimSpace(codepoints),
+			Status:     strings.TrimSpace(status),
+			Name:       fields[2],
+			Version:    fields[1][1:],
+			Group:      group,
+			Subgroup:   subgroup,
+		})
+	}
+	return ret
+}
@@ -0,0 +1,27 @@
+package internal
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// A single entry of emoji.json from github.com/github/gemoji
+type Gemoji struct {
+	Emoji   string   `json:"emoji"`
+	Aliases []string `json:"aliases"`
+}
+
+// Loads db/emoji.json from github.com/github/gemoji
+func LoadGemoji(path string) []Gemoji {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		panic("Error reading file: " + err.Error())
+	}
+
+	var ret []Gemoji
+	err = json.Unmarshal(data, &ret)
+	if err != nil {
+		panic("Error unmarshaling JSON: " + err.Error())
+	}
+	return ret
+}
@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Loads a data file in the semicolon separated format used by the
+// Unicode Character Database and the emoji data files.
+// Comments and empty lines are skipped. Every other line is returned
+// as a list of its fields with surrounding whitespace removed.
+func LoadTXT(path string) [][]string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		panic("Error reading file: " + err.Error())
+	}
+
+	ret := [][]string{}
+	for line := range strings.Lines(string(data)) {
+		line, _, _ = strings.Cut(line, "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		ret = append(ret, fields)
+	}
+	return ret
+}
+
+// Returns the version like "17.0" from the "# Version:" line in the header of
+// a data file like emoji-sequences.txt. LoadTXT skips this line as a comment.
+func LoadVersion(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		panic("Error reading file: " + err.Error())
+	}
+
+	for line := range strings.Lines(string(data)) {
+		if !strings.HasPrefix(line, "#") {
+			break // end of the header
+		}
+		if version, ok := strings.CutPrefix(line, "# Version:"); ok {
+			return strings.TrimSpace(version)
+		}
+	}
+	panic(path + " has no version in its header")
+}
+
+// Parses a space separated list of hexadecimal codepoints like "1F468 200D 1F469"
+// into a string.
+func ParseCodepoints(s string) string {
+	builder := new(strings.Builder)
+	for _, cp := range strings.Fields(s) {
+		n, err := strconv.ParseUint(cp, 16, 32)
+		if err != nil {
+			panic("Error parsing codepoint: " + err.Error())
+		}
+		builder.WriteRune(rune(n))
+	}
+	return builder.String()
+}
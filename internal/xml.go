@@ -1,61 +1,223 @@
 package internal
 
 import (
+	"archive/zip"
 	"encoding/xml"
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 )
 
-type AnyXML struct {
-	XMLName  xml.Name
-	Content  string     `xml:",chardata"`
-	Attrs    []xml.Attr `xml:",any,attr"`
-	Children []AnyXML   `xml:",any"`
+// The parts of the Unicode Character Database in XML (UTS #42) used by the generator
+type UCD struct {
+	Description          string // like "Unicode 17.0.0"
+	Repertoire           []Char
+	StandardizedVariants []StandardizedVariant
 }
 
-func LoadXML(path string) AnyXML {
-	data, _ := os.ReadFile(path)
-	var anyXML AnyXML
-	err := xml.Unmarshal(data, &anyXML)
+// A <char>, <reserved>, <noncharacter> or <surrogate> element of the repertoire.
+// Elements either have a single cp or cover a range with first-cp and last-cp
+// in which case all codepoints of the range share the same properties.
+type Char struct {
+	First, Last rune
+
+	Emoji   bool // Emoji
+	EPres   bool // Emoji_Presentation
+	EMod    bool // Emoji_Modifier
+	EBase   bool // Emoji_Modifier_Base
+	EComp   bool // Emoji_Component
+	ExtPict bool // Extended_Pictographic
+
+	GCB  string // Grapheme_Cluster_Break like "EX"
+	InCB string // Indic_Conjunct_Break like "Linker"
+	EA   string // East_Asian_Width like "W"
+}
+
+// A <standardized-variant> element
+type StandardizedVariant struct {
+	Codepoints string // space separated list of hexadecimal codepoints like "231A FE0E"
+	Desc       string // like "text style" or "emoji style"
+}
+
+// Attributes every element of the repertoire has to have
+var charAttrs = []string{"Emoji", "EPres", "EMod", "EBase", "EComp", "ExtPict", "GCB", "InCB", "ea"}
+
+// Loads the Unicode Character Database in XML like ucd.nounihan.flat.xml.
+// The path can also point to the zip file published by Unicode
+// like ucd.nounihan.flat.zip in which case the XML file inside it is read.
+//
+// The file is decoded as a stream of tokens and only the needed elements and
+// attributes are kept. Every element of the repertoire has to have the attributes
+// of Char which the flat files provide and the elements have to cover every
+// codepoint once.
+func LoadUCD(path string) UCD {
+	f, err := openXML(path)
 	if err != nil {
-		panic("Error unmarshaling XML: " + err.Error())
+		panic("Error reading file: " + err.Error())
+	}
+	defer f.Close()
+
+	ucd := UCD{}
+	inRepertoire := false
+	decoder := xml.NewDecoder(f)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			panic("Error decoding XML: " + err.Error())
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch name := t.Name.Local; {
+			case name == "description":
+				if err := decoder.DecodeElement(&ucd.Description, &t); err != nil {
+					panic("Error decoding XML: " + err.Error())
+				}
+				ucd.Description = strings.TrimSpace(ucd.Description)
+			case name == "repertoire":
+				inRepertoire = true
+			case inRepertoire && (name == "char" || name == "reserved" || name == "noncharacter" || name == "surrogate"):
+				ucd.Repertoire = append(ucd.Repertoire, parseChar(t))
+			case name == "standardized-variant":
+				ucd.StandardizedVariants = append(ucd.StandardizedVariants, StandardizedVariant{
+					Codepoints: attr(t, "cps"),
+					Desc:       attr(t, "desc"),
+				})
+			}
+		case xml.EndElement:
+			if t.Name.Local == "repertoire" {
+				inRepertoire = false
+			}
+		}
+	}
+
+	if ucd.Description == "" {
+		panic(path + " has no description")
+	}
+	if len(ucd.Repertoire) == 0 {
+		panic(path + " has no repertoire")
 	}
 
-	return anyXML
+	// The flat files list every codepoint exactly once in ascending order.
+	// A gap or overlap would silently change the generated tables.
+	next := rune(0)
+	for _, c := range ucd.Repertoire {
+		if c.First != next || c.Last < c.First {
+			panic(fmt.Sprintf("%s: repertoire element U+%04X..U+%04X does not start at U+%04X", path, c.First, c.Last, next))
+		}
+		next = c.Last + 1
+	}
+	if next != 0x110000 {
+		panic(fmt.Sprintf("%s: repertoire ends at U+%04X", path, next-1))
+	}
+	return ucd
 }
 
-func (xml AnyXML) GetAttr(name string) string {
-	for _, attr := range xml.Attrs {
-		if attr.Name.Local == name {
-			return attr.Value
+func parseChar(t xml.StartElement) Char {
+	c := Char{}
+	if cp := attr(t, "cp"); cp != "" {
+		c.First = parseCodepoint(cp)
+		c.Last = c.First
+	} else {
+		c.First = parseCodepoint(attr(t, "first-cp"))
+		c.Last = parseCodepoint(attr(t, "last-cp"))
+	}
+
+	values := make(map[string]string, len(charAttrs))
+	for _, a := range t.Attr {
+		values[a.Name.Local] = a.Value
+	}
+	for _, name := range charAttrs {
+		if values[name] == "" {
+			panic(fmt.Sprintf("%s U+%04X..U+%04X has no attribute %s", t.Name.Local, c.First, c.Last, name))
 		}
 	}
-	return ""
+
+	parseBool := func(name string) bool {
+		switch values[name] {
+		case "Y":
+			return true
+		case "N":
+			return false
+		}
+		panic(fmt.Sprintf("%s U+%04X..U+%04X has %s=%q", t.Name.Local, c.First, c.Last, name, values[name]))
+	}
+	c.Emoji = parseBool("Emoji")
+	c.EPres = parseBool("EPres")
+	c.EMod = parseBool("EMod")
+	c.EBase = parseBool("EBase")
+	c.EComp = parseBool("EComp")
+	c.ExtPict = parseBool("ExtPict")
+	c.GCB = values["GCB"]
+	c.InCB = values["InCB"]
+	c.EA = values["ea"]
+	return c
 }
 
-func (xml AnyXML) GetChildren(name string) []AnyXML {
-	if name == "" {
-		return xml.Children
+func parseCodepoint(s string) rune {
+	n, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		panic("Invalid codepoint: " + err.Error())
 	}
+	return rune(n)
+}
 
-	ret := []AnyXML{}
-	for _, child := range xml.Children {
-		if child.XMLName.Local == name {
-			ret = append(ret, child)
+func attr(t xml.StartElement, name string) string {
+	for _, a := range t.Attr {
+		if a.Name.Local == name {
+			return a.Value
 		}
 	}
-	return ret
+	return ""
 }
 
-func (xml AnyXML) GetFirstChild(name string) AnyXML {
-	if name == "" && len(xml.Children) > 0 {
-		return xml.Children[0]
+// Opens an XML file or the only XML file inside a zip file
+func openXML(path string) (io.ReadCloser, error) {
+	if !strings.HasSuffix(path, ".zip") {
+		return os.Open(path)
+	}
+
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
 	}
 
-	for _, child := range xml.Children {
-		if child.XMLName.Local == name {
-			return child
+	var xmlFile *zip.File
+	for _, f := range r.File {
+		if !strings.HasSuffix(f.Name, ".xml") {
+			continue
+		}
+		if xmlFile != nil {
+			r.Close()
+			return nil, fmt.Errorf("%s contains more than one XML file", path)
 		}
+		xmlFile = f
+	}
+	if xmlFile == nil {
+		r.Close()
+		return nil, fmt.Errorf("%s contains no XML file", path)
+	}
+
+	f, err := xmlFile.Open()
+	if err != nil {
+		r.Close()
+		return nil, err
 	}
+	return zippedFile{f, r}, nil
+}
+
+// A file inside a zip file that also closes the zip file
+type zippedFile struct {
+	io.ReadCloser
+	zip *zip.ReadCloser
+}
 
-	panic(name + " not found")
+func (f zippedFile) Close() error {
+	f.ReadCloser.Close()
+	return f.zip.Close()
 }
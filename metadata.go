@@ -0,0 +1,57 @@
+package emojitoolkit
+
+import (
+	"slices"
+	"strings"
+)
+
+// Information about an emoji as listed in [emoji-test.txt].
+//
+// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+type Info struct {
+	Name     string // CLDR short name like "grinning face"
+	Group    string // like "Smileys & Emotion"
+	Subgroup string // like "face-smiling"
+	Version  string // Emoji version that introduced the emoji like "1.0"
+}
+
+// A single entry of emoji-test.txt
+type emojiTest struct {
+	sequence string
+	name     string
+	group    string
+	subgroup string
+	version  EmojiVersion
+	status   Status
+}
+
+// Looks up the name, group, subgroup and version of an emoji.
+// The string has to consist of a single emoji. Fully-qualified, minimally-qualified
+// and unqualified forms are all found. See [emoji-test.txt] for a list of emojis.
+//
+// Examples:
+//
+//	"😀" -> {"grinning face", "Smileys & Emotion", "face-smiling", "1.0"}, true
+//	"☀" -> {"sun", "Travel & Places", "sky & weather", "0.6"}, true
+//	"🇩🇪" -> {"flag: Germany", "Flags", "country-flag", "0.6"}, true
+//	"A" -> {}, false
+//
+// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+func Lookup(s string) (Info, bool) {
+	e, found := findEmojiTest(s)
+	if !found {
+		return Info{}, false
+	}
+	return Info{e.name, e.group, e.subgroup, e.version.String()}, true
+}
+
+// Finds the entry of emoji-test.txt for exactly the sequence s
+func findEmojiTest(s string) (emojiTest, bool) {
+	i, found := slices.BinarySearchFunc(emoji_test, s, func(e emojiTest, s string) int {
+		return strings.Compare(e.sequence, s)
+	})
+	if !found {
+		return emojiTest{}, false
+	}
+	return emoji_test[i], true
+}
@@ -0,0 +1,43 @@
+package emojitoolkit
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	testCases := map[string]Info{
+		"😀":    {"grinning face", "Smileys & Emotion", "face-smiling", "1.0"},
+		"☀":    {"sun", "Travel & Places", "sky & weather", "0.6"},
+		"☀️":   {"sun", "Travel & Places", "sky & weather", "0.6"},
+		"🇩🇪":   {"flag: Germany", "Flags", "country-flag", "0.6"},
+		"1️⃣":  {"keycap: 1", "Symbols", "keycap", "0.6"},
+		"👍🏻":   {"thumbs up: light skin tone", "People & Body", "hand-fingers-closed", "1.0"},
+		"🏻":    {"light skin tone", "Component", "skin-tone", "1.0"},
+		"🏳️‍🌈": {"rainbow flag", "Flags", "flag", "4.0"},
+		"🫠":    {"melting face", "Smileys & Emotion", "face-smiling", "14.0"},
+		"🇨🇶":   {"flag: Sark", "Flags", "country-flag", "16.0"},
+		"👯🏽":   {"people with bunny ears: medium skin tone", "People & Body", "person-activity", "17.0"},
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": {"flag: Scotland", "Flags", "subdivision-flag", "5.0"},
+	}
+
+	for input, expected := range testCases {
+		result, ok := Lookup(input)
+		if !ok || result != expected {
+			t.Fatalf("Lookup(%q) = %v, %v; want %v", input, result, ok, expected)
+		}
+	}
+
+	for _, input := range []string{"", "A", "1", "😀😀", "👨‍🌍"} {
+		if result, ok := Lookup(input); ok {
+			t.Fatalf("Lookup(%q) = %v, %v; want false", input, result, ok)
+		}
+	}
+}
+
+func TestEmojiTestData(t *testing.T) {
+	if !slices.IsSortedFunc(emoji_test, func(a, b emojiTest) int { return strings.Compare(a.sequence, b.sequence) }) {
+		t.Fatal("emoji_test is not sorted")
+	}
+}
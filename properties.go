@@ -0,0 +1,39 @@
+package emojitoolkit
+
+import (
+	"unicode"
+)
+
+// The binary emoji properties of [UTS #51] as tables for [unicode.Is], [unicode.In]
+// and the other functions of the unicode package. They are generated from the
+// Unicode Character Database in XML (ucd.nounihan.flat.xml) of Unicode 17.0.0
+// which lists the same properties as emoji-data.txt.
+//
+// Examples:
+//
+//	unicode.Is(EmojiTable, '#') -> true
+//	unicode.Is(EmojiPresentationTable, '#') -> false
+//	unicode.Is(EmojiPresentationTable, '😀') -> true
+//	unicode.Is(EmojiModifierTable, '🏽') -> true
+//	unicode.Is(ExtendedPictographicTable, '©') -> true
+//
+// [UTS #51]: https://www.unicode.org/reports/tr51/#Emoji_Properties
+var (
+	EmojiTable                = emoji_table                 // Emoji (ED-3)
+	EmojiPresentationTable    = emoji_presentation_table    // Emoji_Presentation (ED-6)
+	EmojiModifierTable        = emoji_modifier_table        // Emoji_Modifier (ED-4)
+	EmojiModifierBaseTable    = emoji_modifier_base_table   // Emoji_Modifier_Base (ED-5)
+	EmojiComponentTable       = emoji_component_table       // Emoji_Component (ED-5a)
+	ExtendedPictographicTable = extended_pictographic_table // Extended_Pictographic
+)
+
+// The tables above by their property name in emoji-data.txt like "Emoji_Presentation".
+// This is the counterpart of [unicode.Properties].
+var Properties = map[string]*unicode.RangeTable{
+	"Emoji":                 EmojiTable,
+	"Emoji_Presentation":    EmojiPresentationTable,
+	"Emoji_Modifier":        EmojiModifierTable,
+	"Emoji_Modifier_Base":   EmojiModifierBaseTable,
+	"Emoji_Component":       EmojiComponentTable,
+	"Extended_Pictographic": ExtendedPictographicTable,
+}
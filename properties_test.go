@@ -0,0 +1,69 @@
+package emojitoolkit
+
+import (
+	"slices"
+	"testing"
+	"unicode"
+)
+
+func TestProperties(t *testing.T) {
+	testCases := map[rune][]string{
+		'A':      {},
+		'#':      {"Emoji", "Emoji_Component"},
+		'©':      {"Emoji", "Extended_Pictographic"},
+		'☀':      {"Emoji", "Extended_Pictographic"},
+		'⏳':      {"Emoji", "Emoji_Presentation", "Extended_Pictographic"},
+		'👍':      {"Emoji", "Emoji_Presentation", "Emoji_Modifier_Base", "Extended_Pictographic"},
+		'🏽':      {"Emoji", "Emoji_Presentation", "Emoji_Modifier", "Emoji_Component"},
+		'🇩':      {"Emoji", "Emoji_Presentation", "Emoji_Component"},
+		'\u200D': {"Emoji_Component"},
+		'\uFE0F': {"Emoji_Component"},
+		0x1FFFD:  {"Extended_Pictographic"},
+	}
+
+	for r, expected := range testCases {
+		for name, table := range Properties {
+			want := slices.Contains(expected, name)
+			if result := unicode.Is(table, r); result != want {
+				t.Fatalf("unicode.Is(%s, %U) = %v; want %v", name, r, result, want)
+			}
+		}
+	}
+}
+
+// The tables have to agree with the ranges used by the rest of the package
+func TestPropertiesRanges(t *testing.T) {
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		pres := unicode.Is(EmojiPresentationTable, r)
+
+		if result := pres && !unicode.Is(EmojiComponentTable, r); result != isInRange(r, emoji_ranges1) {
+			t.Fatalf("%U does not match emoji_ranges1", r)
+		}
+		if result := unicode.Is(EmojiTable, r) && !pres; result != isInRange(r, emoji_ranges2) {
+			t.Fatalf("%U does not match emoji_ranges2", r)
+		}
+		// U+1F46A Family is the only Emoji_Modifier_Base without RGI modifier sequences
+		if result := unicode.Is(EmojiModifierBaseTable, r) && r != 0x1F46A; result != isInRange(r, emoji_ranges3) {
+			t.Fatalf("%U does not match emoji_ranges3", r)
+		}
+	}
+}
+
+func TestModifierBases(t *testing.T) {
+	// U+1F46A Family is an Emoji_Modifier_Base without RGI modifier sequences
+	testCases := map[string]bool{
+		"👍🏽": true,
+		"👯🏽": true,
+		"🤼🏽": true,
+		"👪🏽": false,
+	}
+
+	for input, expected := range testCases {
+		if result := IsEmoji(input); result != expected {
+			t.Fatalf("IsEmoji(%q) = %v; want %v", input, result, expected)
+		}
+		if result := Qualification(input) == FullyQualified; result != expected {
+			t.Fatalf("Qualification(%q) = %v; want fully-qualified %v", input, Qualification(input), expected)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package emojitoolkit
+
+import (
+	"strings"
+	"sync"
+)
+
+// Qualification status of an emoji as used in [emoji-test.txt].
+//
+// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+type Status uint8
+
+const (
+	Unlisted           Status = iota // not listed in emoji-test.txt
+	Component                        // emoji component like a skin tone or hair style
+	FullyQualified                   // [ED-18] fully-qualified emoji
+	MinimallyQualified               // [ED-18a] minimally-qualified emoji
+	Unqualified                      // [ED-19] unqualified emoji
+)
+
+// Returns the status as written in emoji-test.txt like "fully-qualified"
+func (s Status) String() string {
+	switch s {
+	case Component:
+		return "component"
+	case FullyQualified:
+		return "fully-qualified"
+	case MinimallyQualified:
+		return "minimally-qualified"
+	case Unqualified:
+		return "unqualified"
+	}
+	return "unlisted"
+}
+
+// Returns the qualification status of an emoji.
+// The string has to consist of a single emoji listed in [emoji-test.txt],
+// [Unlisted] is returned otherwise.
+//
+// Examples:
+//
+//	"☀️" -> FullyQualified // U+2600 U+FE0F
+//	"☀" -> Unqualified // U+2600
+//	"👁️‍🗨" -> MinimallyQualified // U+1F441 U+FE0F U+200D U+1F5E8
+//	"👁‍🗨" -> Unqualified // U+1F441 U+200D U+1F5E8
+//	"🏻" -> Component // U+1F3FB
+//	"A" -> Unlisted
+//
+// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+//
+// [ED-18]: https://www.unicode.org/reports/tr51/#def_fully_qualified_emoji
+// [ED-18a]: https://www.unicode.org/reports/tr51/#def_minimally_qualified_emoji
+// [ED-19]: https://www.unicode.org/reports/tr51/#def_unqualified_emoji
+func Qualification(s string) Status {
+	e, _ := findEmojiTest(s)
+	return e.status
+}
+
+// Replaces every minimally-qualified or unqualified emoji found by [All]
+// with its fully-qualified form by adding the missing U+FE0F VARIATION SELECTOR-16.
+// This is what emoji keyboards insert.
+//
+// Single characters that appear as text by default like ☀ are not an emoji
+// according to [ContainsEmoji] and are left unchanged. Use [ToEmojiPresentation] for these.
+//
+// Examples:
+//
+//	"🏳‍🌈" -> "🏳️‍🌈" // U+1F3F3 U+FE0F U+200D U+1F308
+//	"👁‍🗨" -> "👁️‍🗨️" // U+1F441 U+FE0F U+200D U+1F5E8 U+FE0F
+//	"☀" -> "☀"
+func FullyQualify(s string) string {
+	return ReplaceFunc(s, func(e Emoji) string {
+		if fq, ok := fullyQualified()[strings.ReplaceAll(string(e), string(vs16), "")]; ok {
+			return fq
+		}
+		return string(e)
+	})
+}
+
+// Maps every fully-qualified emoji with all U+FE0F removed to its fully-qualified form
+var fullyQualified = sync.OnceValue(func() map[string]string {
+	m := make(map[string]string)
+	for _, e := range emoji_test {
+		if e.status == FullyQualified {
+			m[strings.ReplaceAll(e.sequence, string(vs16), "")] = e.sequence
+		}
+	}
+	return m
+})
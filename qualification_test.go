@@ -0,0 +1,76 @@
+package emojitoolkit
+
+import (
+	"testing"
+)
+
+func TestQualification(t *testing.T) {
+	testCases := map[string]Status{
+		"":        Unlisted,
+		"A":       Unlisted,
+		"1":       Unlisted,
+		"😀😀":      Unlisted,
+		"😀":       FullyQualified,
+		"☀️":      FullyQualified,
+		"☀":       Unqualified,
+		"1️⃣":     FullyQualified,
+		"1\u20E3": Unqualified,
+		"🏳️‍🌈":    FullyQualified,
+		"🏳‍🌈":     Unqualified,
+		"👁️‍🗨️":   FullyQualified,
+		"👁️‍🗨":    MinimallyQualified,
+		"👁‍🗨":     Unqualified,
+		"👯🏽‍♀️":   FullyQualified,
+		"👯🏽‍♀":    MinimallyQualified,
+		"🏻":       Component,
+		"🦰":       Component,
+	}
+
+	for input, expected := range testCases {
+		result := Qualification(input)
+		if result != expected {
+			t.Fatalf("Qualification(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestFullyQualify(t *testing.T) {
+	testCases := map[string]string{
+		"":        "",
+		"A":       "A",
+		"☀":       "☀",
+		"☀️":      "☀️",
+		"⏳\uFE0E": "⏳\uFE0E",
+		"🏳‍🌈":     "🏳️‍🌈",
+		"a🏳‍🌈b":   "a🏳️‍🌈b",
+		"👁‍🗨":     "👁️‍🗨️",
+		"👁️‍🗨":    "👁️‍🗨️",
+		"⛹🏻‍♀":    "⛹🏻‍♀️",
+		"👨‍👩‍👧":   "👨‍👩‍👧",
+		"🏳‍🌈 🏳‍⚧": "🏳️‍🌈 🏳️‍⚧️",
+		"👨‍🌍":     "👨‍🌍",
+	}
+
+	for input, expected := range testCases {
+		result := FullyQualify(input)
+		if result != expected {
+			t.Fatalf("FullyQualify(%q) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestStatusString(t *testing.T) {
+	testCases := map[Status]string{
+		Unlisted:           "unlisted",
+		Component:          "component",
+		FullyQualified:     "fully-qualified",
+		MinimallyQualified: "minimally-qualified",
+		Unqualified:        "unqualified",
+	}
+
+	for input, expected := range testCases {
+		if result := input.String(); result != expected {
+			t.Fatalf("Status(%d).String() = %q; want %q", input, result, expected)
+		}
+	}
+}
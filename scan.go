@@ -0,0 +1,57 @@
+package emojitoolkit
+
+import (
+	"unicode/utf8"
+)
+
+// Text runs longer than this are split to not exceed the buffer of a [bufio.Scanner]
+const maxTextRun = 4096
+
+// A split function for a [bufio.Scanner] that returns emojis and the text between them
+// as alternating tokens. Every emoji found by [All] is a token on its own.
+// Use [ContainsEmoji] to tell emoji tokens from text tokens.
+//
+// Text runs longer than 4096 bytes may be split into several tokens so that text
+// without emojis does not exceed the buffer of the scanner. Emoji sequences are never split.
+// If the data ends within a sequence more data is requested. Long runs of runes that
+// continue a sequence like U+20E3 COMBINING ENCLOSING KEYCAP are split like text.
+//
+// Example:
+//
+//	"Hi 👋🏽 there" -> "Hi ", "👋🏽", " there"
+func ScanEmojiSegments(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	s := unsafeString(data)
+	end := len(s)
+	if !atEOF {
+		end = completeLen(s)
+	}
+	if end == 0 {
+		return 0, nil, nil // request more data
+	}
+
+	if n := sequenceLen(s[:end]); n > 0 {
+		return n, data[:n], nil
+	}
+
+	i := 0
+	for i < end {
+		if sequenceLen(s[i:end]) > 0 {
+			return i, data[:i], nil
+		}
+		if s[i] < utf8.RuneSelf {
+			i++
+		} else {
+			_, n := utf8.DecodeRuneInString(s[i:end])
+			i += n
+		}
+	}
+
+	if atEOF || i >= maxTextRun {
+		return i, data[:i], nil
+	}
+	return 0, nil, nil // the text run may continue
+}
@@ -0,0 +1,90 @@
+package emojitoolkit
+
+import (
+	"bufio"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func scanAll(t *testing.T, s string) []string {
+	// Every Read returns a single byte so sequences are split across reads
+	scanner := bufio.NewScanner(iotest.OneByteReader(strings.NewReader(s)))
+	scanner.Split(ScanEmojiSegments)
+
+	tokens := []string{}
+	for scanner.Scan() {
+		tokens = append(tokens, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("scanning %q failed: %v", s, err)
+	}
+	return tokens
+}
+
+func TestScanEmojiSegments(t *testing.T) {
+	testCases := map[string][]string{
+		"":                 {},
+		"A":                {"A"},
+		"⏳":                {"⏳"},
+		"Hi 👋🏽 there":      {"Hi ", "👋🏽", " there"},
+		"☀\uFE0F😀":         {"☀\uFE0F", "😀"},
+		"1\uFE0F\u20E3":    {"1\uFE0F\u20E3"},
+		"12":               {"12"},
+		"🇩🇪🇫🇷!":            {"🇩🇪", "🇫🇷", "!"},
+		"a👨\u200D👩\u200D👧": {"a", "👨\u200D👩\u200D👧"},
+		"☀\uFE0E":          {"☀\uFE0E"},
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F ": {"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", " "},
+	}
+
+	for input, expected := range testCases {
+		result := scanAll(t, input)
+		if !slices.Equal(result, expected) {
+			t.Fatalf("ScanEmojiSegments(%q) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestScanEmojiSegmentsLongText(t *testing.T) {
+	s := strings.Repeat("text without emojis ", 10000) + "😀"
+
+	tokens := scanAll(t, s)
+	if strings.Join(tokens, "") != s {
+		t.Fatal("ScanEmojiSegments does not return the whole text")
+	}
+	if tokens[len(tokens)-1] != "😀" {
+		t.Fatalf("ScanEmojiSegments returned %q as last token; want %q", tokens[len(tokens)-1], "😀")
+	}
+
+	for _, token := range tokens[:len(tokens)-1] {
+		if len(token) > bufio.MaxScanTokenSize {
+			t.Fatalf("ScanEmojiSegments returned a token of %d bytes", len(token))
+		}
+	}
+}
+
+func TestScanEmojiSegmentsLongRuns(t *testing.T) {
+	// No cut point within the buffer
+	testCases := []string{
+		"x" + strings.Repeat("\u20E3", 30000),
+		"x" + strings.Repeat("\uFE0F", 30000),
+		strings.Repeat("🇩🇪", 20000),
+	}
+
+	for _, s := range testCases {
+		scanner := bufio.NewScanner(strings.NewReader(s))
+		scanner.Split(ScanEmojiSegments)
+
+		var b strings.Builder
+		for scanner.Scan() {
+			b.Write(scanner.Bytes())
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatalf("scanning %d bytes failed: %v", len(s), err)
+		}
+		if b.String() != s {
+			t.Fatal("ScanEmojiSegments does not return the whole text")
+		}
+	}
+}
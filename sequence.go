@@ -0,0 +1,268 @@
+package emojitoolkit
+
+import (
+	"iter"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// A single emoji sequence as found by [All].
+type Emoji string
+
+// Iterates over all emojis in a string. Yields the byte offset of each emoji
+// in s together with the emoji itself.
+//
+// Every emoji matched by [ContainsEmoji] is found:
+//   - default emoji presentation character ([ED-6])
+//   - emoji presentation sequence ([ED-9a])
+//   - emoji keycap sequence ([ED-14c])
+//   - emoji flag sequence ([ED-14])
+//   - emoji modifier sequence ([ED-13])
+//   - emoji tag sequence ([ED-14a])
+//   - emoji zwj sequence ([ED-16])
+//
+// A sequence is always yielded as a whole. A modifier, variation selector or
+// keycap that belongs to an emoji is never yielded on its own.
+//
+// Examples:
+//
+//	"A⏳B" -> 1: "⏳"
+//	"☀️👍🏻" -> 0: "☀️", 6: "👍🏻"
+//	"1️⃣🇩🇪" -> 0: "1️⃣", 7: "🇩🇪"
+//	"👨‍👩‍👧!" -> 0: "👨‍👩‍👧"
+//
+// [ED-6]: https://www.unicode.org/reports/tr51/#def_emoji_presentation
+// [ED-9a]: https://www.unicode.org/reports/tr51/#def_emoji_presentation_sequence
+// [ED-13]: https://www.unicode.org/reports/tr51/#def_emoji_modifier_sequence
+// [ED-14]: https://www.unicode.org/reports/tr51/#def_emoji_flag_sequence
+// [ED-14a]: https://www.unicode.org/reports/tr51/#def_emoji_tag_sequence
+// [ED-14c]: https://www.unicode.org/reports/tr51/#def_emoji_keycap_sequence
+// [ED-16]: https://www.unicode.org/reports/tr51/#def_emoji_zwj_sequence
+func All(s string) iter.Seq2[int, Emoji] {
+	return func(yield func(int, Emoji) bool) {
+		for i := 0; i < len(s); {
+			n := sequenceLen(s[i:])
+			if n == 0 {
+				if s[i] < utf8.RuneSelf {
+					i++
+				} else {
+					_, size := utf8.DecodeRuneInString(s[i:])
+					i += size
+				}
+				continue
+			}
+
+			if !yield(i, Emoji(s[i:i+n])) {
+				return
+			}
+			i += n
+		}
+	}
+}
+
+// Returns a copy of s in which every emoji found by [All] has been replaced
+// by the return value of fn. Modelled after [regexp.Regexp.ReplaceAllStringFunc].
+// fn is called once per emoji sequence and text between emojis is copied unchanged.
+//
+// Examples:
+//
+//	ReplaceFunc("Hi 👋", func(e Emoji) string { return "<" + string(e) + ">" }) -> "Hi <👋>"
+//	ReplaceFunc("👨‍👩‍👧!", func(e Emoji) string { return "" }) -> "!"
+func ReplaceFunc(s string, fn func(e Emoji) string) string {
+	var b strings.Builder
+	last := 0 // end of the last emoji
+	for i, e := range All(s) {
+		b.WriteString(s[last:i])
+		b.WriteString(fn(e))
+		last = i + len(e)
+	}
+
+	if last == 0 {
+		return s // no emoji found
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// Returns the length in bytes of the emoji sequence at the start of s
+// or 0 if s does not start with an emoji.
+func sequenceLen(s string) int {
+	if len(s) == 0 || (s[0] < utf8.RuneSelf && !isKeycapBase(rune(s[0]))) {
+		return 0 // ASCII fast path
+	}
+
+	if n := compoundSequenceLen(s); n > 0 {
+		return n
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+	next, m := utf8.DecodeRuneInString(s[n:])
+
+	switch {
+	case isRegionalIndicator(r) && isRegionalIndicator(next):
+		return n + m
+
+	case IsSingleCharacterEmoji(r):
+		if next == vs15 || next == vs16 {
+			return n + m
+		}
+		if isInRange(r, emoji_ranges3) && isModifier(next) {
+			// ED-13 emoji modifier sequence
+			return n + m
+		}
+		return n
+
+	case isInRange(r, emoji_ranges2) && next == vs16:
+		// ED-9a emoji presentation sequence
+		if k, l := utf8.DecodeRuneInString(s[n+m:]); k == keycap && isKeycapBase(r) {
+			// ED-14c emoji keycap sequence
+			return n + m + l
+		}
+		return n + m
+	}
+	return 0
+}
+
+// Returns the length in bytes of the emoji tag sequence ([ED-14a]) at the start of s
+// or 0 if s does not start with one.
+//
+// [ED-14a]: https://www.unicode.org/reports/tr51/#def_emoji_tag_sequence
+func tagSequenceLen(s string) int {
+	// The tag base is defined just like an emoji zwj element
+	n := zwjElementLen(s)
+	if n == 0 {
+		return 0
+	}
+
+	for i := n; i < len(s); {
+		r, m := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r >= tag_space && r <= tag_tilde:
+			i += m
+		case r == cancel_tag && i > n:
+			return i + m
+		default:
+			return 0
+		}
+	}
+	return 0
+}
+
+// Returns the length in bytes of the emoji zwj sequence ([ED-16]) at the start of s
+// or 0 if s does not start with one. At least two elements have to be joined.
+//
+// [ED-16]: https://www.unicode.org/reports/tr51/#def_emoji_zwj_sequence
+func zwjSequenceLen(s string) int {
+	n := zwjElementLen(s)
+	if n == 0 {
+		return 0
+	}
+
+	joined := false
+	for {
+		r, z := utf8.DecodeRuneInString(s[n:])
+		if r != zwj {
+			break
+		}
+
+		m := zwjElementLen(s[n+z:])
+		if m == 0 {
+			break
+		}
+		n += z + m
+		joined = true
+	}
+
+	if !joined {
+		return 0
+	}
+	return n
+}
+
+// Returns the length in bytes of the [emoji zwj element] at the start of s
+// or 0 if s does not start with one.
+//
+// Text presentation characters are accepted without U+FE0F VARIATION SELECTOR-16
+// because minimally-qualified zwj sequences like 🏳‍🌈 omit it.
+//
+// [emoji zwj element]: https://www.unicode.org/reports/tr51/#def_emoji_zwj_element
+func zwjElementLen(s string) int {
+	r, n := utf8.DecodeRuneInString(s)
+	next, m := utf8.DecodeRuneInString(s[n:])
+
+	switch {
+	case isInRange(r, emoji_ranges3) && isModifier(next):
+		return n + m
+	case IsSingleCharacterEmoji(r) || isInRange(r, emoji_ranges2):
+		if next == vs16 {
+			return n + m
+		}
+		if isKeycapBase(r) {
+			return 0 // 0-9, # and * are only emoji with VS16
+		}
+		return n
+	case r >= red_hair && r <= white_hair:
+		return n
+	}
+	return 0
+}
+
+// Returns the length in bytes of the emoji tag sequence, emoji zwj sequence or
+// emoji modifier sequence at the start of s or 0 if s does not start with one.
+func compoundSequenceLen(s string) int {
+	if n := tagSequenceLen(s); n > 0 {
+		return n
+	}
+	if n := zwjSequenceLen(s); n > 0 {
+		return n
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+	if next, m := utf8.DecodeRuneInString(s[n:]); isInRange(r, emoji_ranges3) && isModifier(next) {
+		return n + m
+	}
+	return 0
+}
+
+// Matches an RGI emoji zwj sequence ([ED-25]) at the start of runes.
+// Runes following the sequence are ignored.
+//
+// Missing U+FE0F VARIATION SELECTOR-16 are tolerated which allows
+// minimally-qualified and unqualified sequences like 🏳‍🌈 to match.
+// See [emoji-zwj-sequences.txt] for a list of matching sequences.
+//
+// Examples:
+//
+//	"👨‍👩‍👧" -> true // U+1F468 U+200D U+1F469 U+200D U+1F467
+//	"🏳️‍🌈" -> true // U+1F3F3 U+FE0F U+200D U+1F308
+//	"👨‍🌍" -> false // not RGI
+//	"👨" -> false
+//
+// [ED-25]: https://www.unicode.org/reports/tr51/#def_rgi_emoji_zwj_sequence_set
+// [emoji-zwj-sequences.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-zwj-sequences.txt
+func IsZWJSequence(runes []rune) bool {
+	s := string(runes)
+	n := zwjSequenceLen(s)
+	if n == 0 {
+		return false
+	}
+
+	return isRGIZWJSequence(s[:n])
+}
+
+// Reports whether s is listed in emoji-zwj-sequences.txt. VS16 are ignored.
+func isRGIZWJSequence(s string) bool {
+	_, found := slices.BinarySearch(zwj_sequences, strings.ReplaceAll(s, string(vs16), ""))
+	return found
+}
+
+// Matches the EMOJI MODIFIER FITZPATRICK characters U+1F3FB .. U+1F3FF
+func isModifier(r rune) bool {
+	return r >= light_skin && r <= dark_skin
+}
+
+// Matches the characters that can start an emoji keycap sequence: 0-9, # and *
+func isKeycapBase(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '#' || r == '*'
+}
@@ -0,0 +1,129 @@
+package emojitoolkit
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	testCases := map[string][]Emoji{
+		"":         nil,
+		"A":        nil,
+		"1":        nil,
+		"☀":        nil,
+		"⏳":        {"⏳"},
+		"A⏳B":      {"⏳"},
+		"☀️":       {"☀️"},
+		"⏳\uFE0E":  {"⏳\uFE0E"},
+		"1️⃣":      {"1️⃣"},
+		"1️":       {"1️"},
+		"👍🏻":       {"👍🏻"},
+		"☝🏿":       {"☝🏿"},
+		"🇩🇪":       {"🇩🇪"},
+		"🇩🇪🇪":      {"🇩🇪"},
+		"☀️👍🏻":     {"☀️", "👍🏻"},
+		"1️⃣🇩🇪2️⃣": {"1️⃣", "🇩🇪", "2️⃣"},
+		"a🌍b🌍c":    {"🌍", "🌍"},
+
+		"👨‍👩‍👧":     {"👨‍👩‍👧"},
+		"👨‍👩‍👧!":    {"👨‍👩‍👧"},
+		"🏳️‍🌈":      {"🏳️‍🌈"},
+		"🏳‍🌈":       {"🏳‍🌈"},
+		"🧑🏻‍🤝‍🧑🏿":   {"🧑🏻‍🤝‍🧑🏿"},
+		"👨‍🦰":       {"👨‍🦰"},
+		"👨\u200D":   {"👨"},
+		"👨\u200DA":  {"👨"},
+		"1\u200D2":  nil,
+		"👩‍❤️‍💋‍👨👍": {"👩‍❤️‍💋‍👨", "👍"},
+
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F🏴": {"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", "🏴"},
+		"🏴\U000E0067\U000E0062": {"🏴"},
+	}
+
+	for input, expected := range testCases {
+		var result []Emoji
+		for i, e := range All(input) {
+			if input[i:i+len(e)] != string(e) {
+				t.Fatalf("All(%q) yielded %q at %d", input, e, i)
+			}
+			result = append(result, e)
+		}
+
+		if !slices.Equal(result, expected) {
+			t.Fatalf("All(%q) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestAllBreak(t *testing.T) {
+	n := 0
+	for range All("⏳⏳⏳") {
+		n++
+		break
+	}
+
+	if n != 1 {
+		t.Fatalf("All(\"⏳⏳⏳\") yielded %d emojis after break; want 1", n)
+	}
+}
+
+func TestIsZWJSequence(t *testing.T) {
+	testCases := map[string]bool{
+		"":          false,
+		"A":         false,
+		"👨":         false,
+		"👨\u200D":   false,
+		"👨‍👩‍👧":     true,
+		"👨‍👩‍👧A":    true,
+		"🏳️‍🌈":      true,
+		"🏳‍🌈":       true,
+		"❤️‍🔥":      true,
+		"🧑🏻‍🤝‍🧑🏿":   true,
+		"👨🏻‍🐰‍👨🏼":   true,
+		"🧑‍🩰":       true,
+		"👨‍🌍":       false,
+		"👨‍👨‍👨‍👨‍👨": false,
+	}
+
+	for input, expected := range testCases {
+		result := IsZWJSequence([]rune(input))
+		if result != expected {
+			t.Fatalf("IsZWJSequence(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestReplaceFunc(t *testing.T) {
+	wrap := func(e Emoji) string { return "<" + string(e) + ">" }
+
+	testCases := map[string]string{
+		"":           "",
+		"A":          "A",
+		"☀":          "☀",
+		"⏳":          "<⏳>",
+		"Hi 👋":       "Hi <👋>",
+		"Hi 👋!":      "Hi <👋>!",
+		"☀️👍🏻":       "<☀️><👍🏻>",
+		"a👨‍👩‍👧b🇩🇪c": "a<👨‍👩‍👧>b<🇩🇪>c",
+		"1️⃣1":       "<1️⃣>1",
+	}
+
+	for input, expected := range testCases {
+		result := ReplaceFunc(input, wrap)
+		if result != expected {
+			t.Fatalf("ReplaceFunc(%q) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestReplaceFuncCalls(t *testing.T) {
+	calls := 0
+	ReplaceFunc("👨‍👩‍👧🇩🇪👍🏻", func(e Emoji) string {
+		calls++
+		return ""
+	})
+
+	if calls != 3 {
+		t.Fatalf("ReplaceFunc called fn %d times; want 3", calls)
+	}
+}
@@ -0,0 +1,239 @@
+package emojitoolkit
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// A vocabulary of shortcodes like :smile: as used by chat platforms.
+// Shortcodes are passed and returned without the surrounding colons.
+//
+// Use one of the built-in dialects [GitHub], [GitHubSkinTones] and [CLDR],
+// extend one with [WithAliases] or implement a custom one.
+type Dialect interface {
+	// Returns the emoji for a shortcode like "smile"
+	Emoji(shortcode string) (string, bool)
+
+	// Returns the preferred shortcode for a single emoji
+	Shortcode(emoji string) (string, bool)
+}
+
+var (
+	// Shortcodes used by GitHub as defined by [gemoji] like :+1: or :de:.
+	// There are no shortcodes for skin tones. The table is generated from the
+	// fork [rhysd/gemoji] which adds the emojis up to Emoji 16.0.
+	//
+	// [gemoji]: https://github.com/github/gemoji
+	// [rhysd/gemoji]: https://github.com/rhysd/gemoji
+	GitHub Dialect = tableDialect{sync.OnceValue(func() shortcodeTable {
+		return newShortcodeTable(gemoji)
+	})}
+
+	// The [GitHub] shortcodes with skin tones written as a second shortcode
+	// from :skin-tone-2: to :skin-tone-6: like :+1::skin-tone-4:.
+	// Slack writes skin tones the same way but its shortcodes differ from
+	// GitHub's like :flag-de: instead of :de:.
+	GitHubSkinTones Dialect = skinToneDialect{}
+
+	// Shortcodes derived from the CLDR short names in [emoji-test.txt] like
+	// :grinning_face:, :thumbs_up_medium_skin_tone: or :flag_germany:.
+	// Every fully-qualified emoji has a shortcode.
+	//
+	// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+	CLDR Dialect = tableDialect{sync.OnceValue(func() shortcodeTable {
+		pairs := make([][2]string, 0, len(emoji_test))
+		for _, e := range emoji_test {
+			if e.status == FullyQualified || e.status == Component {
+				pairs = append(pairs, [2]string{cldrShortcode(e.name), e.sequence})
+			}
+		}
+		return newShortcodeTable(pairs)
+	})}
+)
+
+// Replaces all shortcodes like :smile: known to the dialect with their emoji.
+// Unknown shortcodes are left unchanged.
+//
+// Examples:
+//
+//	Emojize("I :heart: Go :+1:", GitHub) -> "I ❤️ Go 👍"
+//	Emojize(":+1::skin-tone-4:", GitHubSkinTones) -> "👍🏽"
+//	Emojize(":flag_germany:", CLDR) -> "🇩🇪"
+func Emojize(s string, dialect Dialect) string {
+	var b strings.Builder
+	last := 0 // end of the last replaced shortcode
+	for i := 0; i < len(s); {
+		start := strings.IndexByte(s[i:], ':')
+		if start < 0 {
+			break
+		}
+		start += i
+
+		end := strings.IndexByte(s[start+1:], ':')
+		if end < 0 {
+			break
+		}
+		end += start + 1
+
+		e, ok := dialect.Emoji(s[start+1 : end])
+		if !ok {
+			i = end // the closing colon may open the next shortcode
+			continue
+		}
+
+		b.WriteString(s[last:start])
+		b.WriteString(e)
+		last = end + 1
+		i = end + 1
+	}
+
+	if last == 0 {
+		return s // no shortcode replaced
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// Replaces all emojis found by [All] with their shortcode in the dialect.
+// Emojis without a shortcode are left unchanged. Flags, keycaps, skin tones and
+// zwj sequences are replaced as a whole so [Emojize] restores the original emoji.
+//
+// Examples:
+//
+//	Demojize("I ❤️ Go 👍", GitHub) -> "I :heart: Go :+1:"
+//	Demojize("👍🏽", GitHub) -> "👍🏽"
+//	Demojize("👍🏽", GitHubSkinTones) -> ":+1::skin-tone-4:"
+//	Demojize("🇩🇪", CLDR) -> ":flag_germany:"
+func Demojize(s string, dialect Dialect) string {
+	return ReplaceFunc(s, func(e Emoji) string {
+		if shortcode, ok := dialect.Shortcode(string(e)); ok {
+			return ":" + shortcode + ":"
+		}
+		return string(e)
+	})
+}
+
+// Extends a dialect with custom shortcodes. The aliases map shortcodes to emojis
+// and take precedence over the shortcodes of the dialect.
+// If several aliases map to the same emoji the alphabetically first one is preferred.
+//
+// Example:
+//
+//	d := WithAliases(GitHub, map[string]string{"shipit": "🐿️"})
+//	Emojize(":shipit:", d) -> "🐿️"
+func WithAliases(dialect Dialect, aliases map[string]string) Dialect {
+	pairs := make([][2]string, 0, len(aliases))
+	for _, shortcode := range slices.Sorted(maps.Keys(aliases)) {
+		pairs = append(pairs, [2]string{shortcode, aliases[shortcode]})
+	}
+
+	return aliasDialect{dialect, newShortcodeTable(pairs)}
+}
+
+type shortcodeTable struct {
+	emojis     map[string]string // shortcode -> emoji
+	shortcodes map[string]string // emoji without U+FE0F -> preferred shortcode
+}
+
+// Creates a table from pairs of shortcode and emoji.
+// The first shortcode of an emoji is the preferred one.
+func newShortcodeTable(pairs [][2]string) shortcodeTable {
+	t := shortcodeTable{
+		emojis:     make(map[string]string, len(pairs)),
+		shortcodes: make(map[string]string, len(pairs)),
+	}
+
+	for _, pair := range pairs {
+		shortcode, emoji := pair[0], pair[1]
+		if _, ok := t.emojis[shortcode]; !ok {
+			t.emojis[shortcode] = emoji
+		}
+
+		key := strings.ReplaceAll(emoji, string(vs16), "")
+		if _, ok := t.shortcodes[key]; !ok {
+			t.shortcodes[key] = shortcode
+		}
+	}
+	return t
+}
+
+func (t shortcodeTable) emoji(shortcode string) (string, bool) {
+	e, ok := t.emojis[shortcode]
+	return e, ok
+}
+
+func (t shortcodeTable) shortcode(emoji string) (string, bool) {
+	shortcode, ok := t.shortcodes[strings.ReplaceAll(emoji, string(vs16), "")]
+	return shortcode, ok
+}
+
+type tableDialect struct {
+	table func() shortcodeTable
+}
+
+func (d tableDialect) Emoji(shortcode string) (string, bool) {
+	return d.table().emoji(shortcode)
+}
+
+func (d tableDialect) Shortcode(emoji string) (string, bool) {
+	return d.table().shortcode(emoji)
+}
+
+type aliasDialect struct {
+	Dialect
+	aliases shortcodeTable
+}
+
+func (d aliasDialect) Emoji(shortcode string) (string, bool) {
+	if e, ok := d.aliases.emoji(shortcode); ok {
+		return e, true
+	}
+	return d.Dialect.Emoji(shortcode)
+}
+
+func (d aliasDialect) Shortcode(emoji string) (string, bool) {
+	if shortcode, ok := d.aliases.shortcode(emoji); ok {
+		return shortcode, true
+	}
+	return d.Dialect.Shortcode(emoji)
+}
+
+type skinToneDialect struct{}
+
+const skinToneShortcode = "skin-tone-"
+
+func (skinToneDialect) Emoji(shortcode string) (string, bool) {
+	if tone, ok := strings.CutPrefix(shortcode, skinToneShortcode); ok && len(tone) == 1 && tone[0] >= '2' && tone[0] <= '6' {
+		return string(light_skin + rune(tone[0]-'2')), true
+	}
+	return GitHub.Emoji(shortcode)
+}
+
+func (skinToneDialect) Shortcode(emoji string) (string, bool) {
+	if shortcode, ok := GitHub.Shortcode(emoji); ok {
+		return shortcode, true
+	}
+
+	// Emoji modifier sequence like 👍🏽
+	runes := []rune(emoji)
+	if len(runes) != 2 || !isModifier(runes[1]) {
+		return "", false
+	}
+
+	shortcode, ok := GitHub.Shortcode(string(runes[0]))
+	if !ok {
+		return "", false
+	}
+	return shortcode + "::" + skinToneShortcode + string('2'+runes[1]-light_skin), true
+}
+
+// Derives a shortcode from a CLDR short name like "flag: Germany" -> "flag_germany"
+func cldrShortcode(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '#' && r != '*'
+	})
+	return strings.Join(fields, "_")
+}
@@ -0,0 +1,91 @@
+package emojitoolkit
+
+import (
+	"testing"
+)
+
+func TestEmojize(t *testing.T) {
+	testCases := map[string]string{
+		"":                  "",
+		"A":                 "A",
+		":":                 ":",
+		"::":                "::",
+		":smile:":           "😄",
+		"I :heart: Go :+1:": "I ❤️ Go 👍",
+		":de::us:":          "🇩🇪🇺🇸",
+		"a:qq:+1:":          "a:qq👍",
+		":unknown:":         ":unknown:",
+		":+1::skin-tone-4:": "👍:skin-tone-4:",
+		"10:30 :smile:":     "10:30 😄",
+	}
+
+	for input, expected := range testCases {
+		result := Emojize(input, GitHub)
+		if result != expected {
+			t.Fatalf("Emojize(%q, GitHub) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestDemojize(t *testing.T) {
+	testCases := map[string]string{
+		"":          "",
+		"A":         "A",
+		"I ❤️ Go 👍": "I :heart: Go :+1:",
+		"I ❤ Go":    "I ❤ Go",
+		"🇩🇪🇺🇸":      ":de::us:",
+		"1️⃣":       ":one:",
+		"👍🏽":        "👍🏽",
+	}
+
+	for input, expected := range testCases {
+		result := Demojize(input, GitHub)
+		if result != expected {
+			t.Fatalf("Demojize(%q, GitHub) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestDialects(t *testing.T) {
+	testCases := []struct {
+		dialect   Dialect
+		emoji     string
+		shortcode string
+	}{
+		{GitHub, "👍", ":+1:"},
+		{GitHub, "🏳️‍🌈", ":rainbow_flag:"},
+		{GitHub, "🫩", ":face_with_bags_under_eyes:"},
+		{GitHub, "🇨🇶", ":flag_sark:"},
+		{GitHubSkinTones, "👍", ":+1:"},
+		{GitHubSkinTones, "👍🏽", ":+1::skin-tone-4:"},
+		{GitHubSkinTones, "👍🏻👍🏿", ":+1::skin-tone-2::+1::skin-tone-6:"},
+		{CLDR, "😀", ":grinning_face:"},
+		{CLDR, "🇩🇪", ":flag_germany:"},
+		{CLDR, "👍🏽", ":thumbs_up_medium_skin_tone:"},
+		{CLDR, "#️⃣", ":keycap_#:"},
+		{CLDR, "🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F", ":flag_scotland:"},
+		{WithAliases(GitHub, map[string]string{"shipit": "🐿️", "thumbs": "👍"}), "🐿️", ":shipit:"},
+		{WithAliases(GitHub, map[string]string{"shipit": "🐿️", "thumbs": "👍"}), "👍", ":thumbs:"},
+		{WithAliases(GitHub, map[string]string{"shipit": "🐿️"}), "😄", ":smile:"},
+	}
+
+	for _, tc := range testCases {
+		if result := Demojize(tc.emoji, tc.dialect); result != tc.shortcode {
+			t.Fatalf("Demojize(%q) = %q; want %q", tc.emoji, result, tc.shortcode)
+		}
+
+		if result := Emojize(tc.shortcode, tc.dialect); result != tc.emoji {
+			t.Fatalf("Emojize(%q) = %q; want %q", tc.shortcode, result, tc.emoji)
+		}
+	}
+}
+
+func TestShortcodeRoundTrip(t *testing.T) {
+	const s = "Hi 👋🏽 from 🇩🇪! I ❤️ 🏳️‍🌈 and 👨‍👩‍👧 1️⃣ 🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F"
+
+	for _, d := range []Dialect{GitHub, GitHubSkinTones, CLDR} {
+		if result := Emojize(Demojize(s, d), d); result != s {
+			t.Fatalf("Emojize(Demojize(%q)) = %q", s, result)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package emojitoolkit
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Skin tone of an emoji given by an emoji modifier ([ED-11]).
+//
+// [ED-11]: https://www.unicode.org/reports/tr51/#def_emoji_modifier
+type Tone uint8
+
+const (
+	NoTone      Tone = iota // no skin tone modifier
+	Light                   // U+1F3FB EMOJI MODIFIER FITZPATRICK TYPE-1-2
+	MediumLight             // U+1F3FC EMOJI MODIFIER FITZPATRICK TYPE-3
+	Medium                  // U+1F3FD EMOJI MODIFIER FITZPATRICK TYPE-4
+	MediumDark              // U+1F3FE EMOJI MODIFIER FITZPATRICK TYPE-5
+	Dark                    // U+1F3FF EMOJI MODIFIER FITZPATRICK TYPE-6
+	Mixed                   // different skin tones like in 🧑🏻‍🤝‍🧑🏿
+)
+
+// Returns the skin tone of all emojis in a string.
+// [Mixed] is returned if there are different skin tones.
+//
+// Examples:
+//
+//	"👍" -> NoTone
+//	"👍🏽" -> Medium
+//	"👨🏿‍🚀" -> Dark
+//	"🧑🏻‍🤝‍🧑🏻" -> Light
+//	"🧑🏻‍🤝‍🧑🏿" -> Mixed
+func SkinTone(s string) Tone {
+	tone := NoTone
+	for _, r := range s {
+		if !isModifier(r) {
+			continue
+		}
+
+		t := Tone(r-light_skin) + Light
+		if tone != NoTone && tone != t {
+			return Mixed
+		}
+		tone = t
+	}
+	return tone
+}
+
+// Sets the skin tone of all emojis in a string that can have one.
+// Existing skin tones are replaced and [NoTone] removes them like [StripSkinTones].
+// The string is returned unchanged for [Mixed].
+//
+// Only characters with the Emoji_Modifier_Base property get a skin tone.
+// Within emoji zwj sequences only characters that appear with a skin tone in any
+// RGI emoji zwj sequence get one. This keeps the 🤝 in 🧑‍🤝‍🧑 without a tone.
+// Emoji zwj sequences that are not RGI with a skin tone like 👨‍👩‍👧 are left unchanged.
+//
+// Examples:
+//
+//	("👍", Medium) -> "👍🏽"
+//	("☝️", Dark) -> "☝🏿"
+//	("🧑‍🤝‍🧑", Light) -> "🧑🏻‍🤝‍🧑🏻"
+//	("👨‍👩‍👧", Dark) -> "👨‍👩‍👧"
+//	("😀", Dark) -> "😀"
+func WithSkinTone(s string, tone Tone) string {
+	if tone == NoTone {
+		return StripSkinTones(s)
+	}
+	if tone > Dark {
+		return s
+	}
+
+	modifier := string(light_skin + rune(tone-Light))
+	return ReplaceFunc(s, func(e Emoji) string {
+		elements := strings.Split(string(e), string(zwj))
+		for i, element := range elements {
+			r, n := utf8.DecodeRuneInString(element)
+			if !isInRange(r, emoji_ranges3) {
+				continue
+			}
+			if len(elements) > 1 && !tonedInZWJSequence()[r] {
+				continue
+			}
+
+			// Replace an existing modifier or a VS16 which is not used in modifier sequences
+			if next, m := utf8.DecodeRuneInString(element[n:]); isModifier(next) || next == vs16 {
+				n += m
+			}
+			elements[i] = string(r) + modifier + element[n:]
+		}
+
+		toned := strings.Join(elements, string(zwj))
+		if len(elements) > 1 && !isRGIZWJSequence(toned) {
+			return string(e)
+		}
+		return toned
+	})
+}
+
+// Removes all skin tones from emojis. Characters that appear as text by default
+// get a U+FE0F VARIATION SELECTOR-16 so they still appear as emoji.
+//
+// Examples:
+//
+//	"👍🏽" -> "👍"
+//	"☝🏿" -> "☝️"
+//	"🧑🏻‍🤝‍🧑🏿" -> "🧑‍🤝‍🧑"
+func StripSkinTones(s string) string {
+	return ReplaceFunc(s, func(e Emoji) string {
+		elements := strings.Split(string(e), string(zwj))
+		for i, element := range elements {
+			r, n := utf8.DecodeRuneInString(element)
+			next, m := utf8.DecodeRuneInString(element[n:])
+			if !isModifier(next) {
+				continue
+			}
+
+			if IsSingleCharacterEmoji(r) {
+				elements[i] = string(r) + element[n+m:]
+			} else {
+				elements[i] = string(r) + string(vs16) + element[n+m:]
+			}
+		}
+		return strings.Join(elements, string(zwj))
+	})
+}
+
+// Characters that appear with a skin tone inside of any RGI emoji zwj sequence
+var tonedInZWJSequence = sync.OnceValue(func() map[rune]bool {
+	m := make(map[rune]bool)
+	for _, seq := range zwj_sequences {
+		runes := []rune(seq)
+		for i := 1; i < len(runes); i++ {
+			if isModifier(runes[i]) {
+				m[runes[i-1]] = true
+			}
+		}
+	}
+	return m
+})
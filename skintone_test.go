@@ -0,0 +1,85 @@
+package emojitoolkit
+
+import (
+	"testing"
+)
+
+func TestSkinTone(t *testing.T) {
+	testCases := map[string]Tone{
+		"":          NoTone,
+		"A":         NoTone,
+		"👍":         NoTone,
+		"👍🏻":        Light,
+		"👍🏼":        MediumLight,
+		"👍🏽":        Medium,
+		"👍🏾":        MediumDark,
+		"👍🏿":        Dark,
+		"👨🏿‍🚀":      Dark,
+		"🧑🏻‍🤝‍🧑🏻":   Light,
+		"🧑🏻‍🤝‍🧑🏿":   Mixed,
+		"👍🏽 and 👍🏽": Medium,
+		"👍🏽 and 👍🏾": Mixed,
+	}
+
+	for input, expected := range testCases {
+		result := SkinTone(input)
+		if result != expected {
+			t.Fatalf("SkinTone(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestWithSkinTone(t *testing.T) {
+	testCases := []struct {
+		input    string
+		tone     Tone
+		expected string
+	}{
+		{"", Medium, ""},
+		{"A", Medium, "A"},
+		{"👍", Medium, "👍🏽"},
+		{"👍🏻", Dark, "👍🏿"},
+		{"👍🏻", NoTone, "👍"},
+		{"👍", Mixed, "👍"},
+		{"☝️", Dark, "☝🏿"},
+		{"😀", Dark, "😀"},
+		{"🇩🇪", Dark, "🇩🇪"},
+		{"Hi 👋!", Light, "Hi 👋🏻!"},
+		{"👨‍🚀", MediumDark, "👨🏾‍🚀"},
+		{"🧑‍🤝‍🧑", Light, "🧑🏻‍🤝‍🧑🏻"},
+		{"🧑🏻‍🤝‍🧑🏿", Medium, "🧑🏽‍🤝‍🧑🏽"},
+		{"🏃‍➡️", Light, "🏃🏻‍➡️"},
+		{"⛹️‍♀️", Dark, "⛹🏿‍♀️"},
+		{"👨‍👩‍👧", Dark, "👨‍👩‍👧"},
+		{"👨‍👩‍👧 👍", Dark, "👨‍👩‍👧 👍🏿"},
+	}
+
+	for _, tc := range testCases {
+		result := WithSkinTone(tc.input, tc.tone)
+		if result != tc.expected {
+			t.Fatalf("WithSkinTone(%q, %v) = %q; want %q", tc.input, tc.tone, result, tc.expected)
+		}
+	}
+}
+
+func TestStripSkinTones(t *testing.T) {
+	testCases := map[string]string{
+		"":        "",
+		"A":       "A",
+		"👍":       "👍",
+		"👍🏽":      "👍",
+		"☝🏿":      "☝️",
+		"👨🏾‍🚀":    "👨‍🚀",
+		"🧑🏻‍🤝‍🧑🏿": "🧑‍🤝‍🧑",
+		"⛹🏿‍♀️":   "⛹️‍♀️",
+		"Hi 👋🏻!":  "Hi 👋!",
+		"🏻":       "🏻",
+	}
+
+	for input, expected := range testCases {
+		result := StripSkinTones(input)
+		if result != expected {
+			t.Fatalf("StripSkinTones(%q) = %q; want %q", input, result, expected)
+		}
+	}
+}
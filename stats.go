@@ -0,0 +1,87 @@
+package emojitoolkit
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Returns the number of emojis in a string. Every emoji found by [All] counts once
+// so a flag, keycap or zwj sequence is a single emoji.
+//
+// Examples:
+//
+//	"Hi 👋" -> 1
+//	"👍🏽👍🏽" -> 2
+//	"👨‍👩‍👧🇩🇪" -> 2
+func Count(s string) int {
+	n := 0
+	for range All(s) {
+		n++
+	}
+	return n
+}
+
+// Reports whether a string consists of nothing but emojis and whitespace
+// and returns the number of emojis. Strings without any emoji are not matched.
+// Chat apps use this to show short emoji-only messages in a large size.
+//
+// Examples:
+//
+//	"😀" -> 1, true
+//	" 👍🏽 🎉 " -> 2, true
+//	"👨‍👩‍👧🇩🇪" -> 2, true
+//	"Hi 👋" -> 0, false
+//	" " -> 0, false
+func OnlyEmoji(s string) (count int, ok bool) {
+	for i := 0; i < len(s); {
+		if n := sequenceLen(s[i:]); n > 0 {
+			count++
+			i += n
+			continue
+		}
+
+		r, n := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsSpace(r) {
+			return 0, false
+		}
+		i += n
+	}
+	return count, count > 0
+}
+
+// Counts how often each emoji occurs in a string. The emojis are normalized to their
+// fully-qualified form like [FullyQualify] does so "☀" followed by U+FE0F and a
+// plain "☀" in a zwj sequence are counted together.
+//
+// Examples:
+//
+//	"👍👍🏽❤️" -> {"👍": 1, "👍🏽": 1, "❤️": 1}
+//	"☀️ ☀️" -> {"☀️": 2}
+func Frequencies(s string) map[string]int {
+	return frequencies(s, false)
+}
+
+// Like [Frequencies] but skin tones are removed before counting like [StripSkinTones] does.
+//
+// Example:
+//
+//	"👍👍🏽👍🏿" -> {"👍": 3}
+func FrequenciesFoldSkinTones(s string) map[string]int {
+	return frequencies(s, true)
+}
+
+func frequencies(s string, fold bool) map[string]int {
+	m := make(map[string]int)
+	for _, e := range All(s) {
+		key := string(e)
+		if fold {
+			key = StripSkinTones(key)
+		}
+		if fq, ok := fullyQualified()[strings.ReplaceAll(key, string(vs16), "")]; ok {
+			key = fq
+		}
+		m[key]++
+	}
+	return m
+}
@@ -0,0 +1,83 @@
+package emojitoolkit
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	testCases := map[string]int{
+		"":                  0,
+		"Hi":                0,
+		"Hi 👋":              1,
+		"👍🏽👍🏽":              2,
+		"1\uFE0F\u20E31":    1,
+		"👨\u200D👩\u200D👧🇩🇪": 2,
+		benchmarkChat:       9,
+	}
+
+	for input, expected := range testCases {
+		result := Count(input)
+		if result != expected {
+			t.Fatalf("Count(%q) = %d; want %d", input, result, expected)
+		}
+	}
+}
+
+func TestOnlyEmoji(t *testing.T) {
+	testCases := map[string]int{
+		"":                  -1,
+		" ":                 -1,
+		"Hi":                -1,
+		"Hi 👋":              -1,
+		"👋!":                -1,
+		"😀":                 1,
+		" 👍🏽 🎉 ":            2,
+		"😀\n😀\t😀":           3,
+		"☀\uFE0F":           1,
+		"☀":                 -1,
+		"👨\u200D👩\u200D👧🇩🇪": 2,
+		"1\uFE0F\u20E3":     1,
+		"1":                 -1,
+	}
+
+	for input, expected := range testCases {
+		count, ok := OnlyEmoji(input)
+		if ok != (expected >= 0) || ok && count != expected {
+			t.Fatalf("OnlyEmoji(%q) = %d, %v; want %d", input, count, ok, expected)
+		}
+	}
+}
+
+func TestFrequencies(t *testing.T) {
+	testCases := map[string]map[string]int{
+		"":                       {},
+		"Hi":                     {},
+		"👍👍🏽❤\uFE0F":             {"👍": 1, "👍🏽": 1, "❤\uFE0F": 1},
+		"☀\uFE0F ☀\uFE0F":        {"☀\uFE0F": 2},
+		"🏳\u200D🌈🏳\uFE0F\u200D🌈": {"🏳\uFE0F\u200D🌈": 2},
+	}
+
+	for input, expected := range testCases {
+		result := Frequencies(input)
+		if !maps.Equal(result, expected) {
+			t.Fatalf("Frequencies(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
+
+func TestFrequenciesFoldSkinTones(t *testing.T) {
+	testCases := map[string]map[string]int{
+		"👍👍🏽👍🏿":     {"👍": 3},
+		"☝🏿☝\uFE0F": {"☝\uFE0F": 2},
+		"🧑🏻\u200D🤝\u200D🧑🏿🧑\u200D🤝\u200D🧑": {"🧑\u200D🤝\u200D🧑": 2},
+		"😀": {"😀": 1},
+	}
+
+	for input, expected := range testCases {
+		result := FrequenciesFoldSkinTones(input)
+		if !maps.Equal(result, expected) {
+			t.Fatalf("FrequenciesFoldSkinTones(%q) = %v; want %v", input, result, expected)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+package emojitoolkit
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Converts a stream of UTF-8 encoded bytes chunk by chunk.
+// The semantics are the same as those of the Transformer of [golang.org/x/text/transform]
+// but the errors are [ErrShortDst] and [ErrShortSrc] of this package.
+// The method is named TransformChunk so that a Transformer can not be passed
+// to that package by mistake as it does not know these errors.
+// Use [NewReader] to apply a Transformer to an [io.Reader].
+//
+// Emoji sequences that are split across two chunks are never broken apart.
+// TransformChunk only consumes src up to a point where the following bytes
+// can not change the result and reports [ErrShortSrc] for the rest.
+// A src of at least 256 bytes is always consumed in part so that pathological
+// input like thousands of U+FE0F in a row is split somewhere instead of blocking.
+//
+// [golang.org/x/text/transform]: https://pkg.go.dev/golang.org/x/text/transform
+type Transformer interface {
+	// Writes the transformed bytes of src to dst and returns the number of
+	// bytes written to dst and read from src. atEOF tells whether src is the
+	// last chunk of the stream.
+	TransformChunk(dst, src []byte, atEOF bool) (nDst, nSrc int, err error)
+
+	// Resets the state to transform a new stream
+	Reset()
+}
+
+var (
+	// Returned by [Transformer] if dst is too short to receive the next converted bytes
+	ErrShortDst = errors.New("emojitoolkit: short destination buffer")
+
+	// Returned by [Transformer] if src ends with an incomplete sequence
+	// and more bytes are required to convert it
+	ErrShortSrc = errors.New("emojitoolkit: short source buffer")
+
+	errInconsistentByteCount = errors.New("emojitoolkit: inconsistent byte count returned")
+)
+
+// Returns a [Transformer] that works like [ToTextPresentation]
+func TextPresentationTransformer() Transformer {
+	return presentationTransformer{vs15}
+}
+
+// Returns a [Transformer] that works like [ToEmojiPresentation]
+func EmojiPresentationTransformer() Transformer {
+	return presentationTransformer{vs16}
+}
+
+// Returns a [Transformer] that works like [StripEmoji]
+func StripEmojiTransformer() Transformer {
+	return &stripTransformer{stripper: newStripper(false)}
+}
+
+// Returns a [Transformer] that works like [StripEmojiCollapseSpace].
+// Whitespace is held back until it is known whether it precedes a removed emoji at the end.
+// Runs of more than 256 bytes of whitespace are written anyway so they are not trimmed then.
+func StripEmojiCollapseSpaceTransformer() Transformer {
+	return &stripTransformer{stripper: newStripper(true)}
+}
+
+type presentationTransformer struct {
+	vs rune
+}
+
+func (t presentationTransformer) Reset() {}
+
+func (t presentationTransformer) TransformChunk(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	s := unsafeString(src)
+	end := len(s)
+	if !atEOF {
+		end = completeLen(s)
+	}
+
+	for nSrc < end {
+		n, keep, add := presentationUnit(s[nSrc:end], t.vs)
+		if nDst+keep+len(add) > len(dst) {
+			return nDst, nSrc, ErrShortDst
+		}
+		nDst += copy(dst[nDst:], s[nSrc:nSrc+keep])
+		nDst += copy(dst[nDst:], add)
+		nSrc += n
+	}
+
+	if nSrc < len(s) {
+		return nDst, nSrc, ErrShortSrc
+	}
+	return nDst, nSrc, nil
+}
+
+type stripTransformer struct {
+	stripper
+}
+
+func (t *stripTransformer) Reset() {
+	t.stripper = newStripper(t.collapse)
+}
+
+func (t *stripTransformer) TransformChunk(dst, src []byte, atEOF bool) (nDst, nSrc int, err error) {
+	s := unsafeString(src)
+	end := len(s)
+	if !atEOF {
+		end = completeLen(s)
+	}
+
+	// Whitespace following the last kept non space rune is removed if an emoji is
+	// removed at the end. Such whitespace is only written in the same call as the
+	// end of the stream so the transformation stops at the last checkpoint before it.
+	// The checkpoint moves on after maxLookBack bytes so that long runs make progress.
+	checkpoint, checkpointDst, checkpointState := 0, 0, t.stripper
+	pending := false // whitespace was written since the last non space rune
+	for nSrc < end {
+		state := t.stripper
+		n, keep := t.next(s[nSrc:end])
+		if keep && nDst+n > len(dst) {
+			t.stripper = state
+			err = ErrShortDst
+			break
+		}
+		if keep {
+			nDst += copy(dst[nDst:], s[nSrc:nSrc+n])
+			pending = t.lastSpace
+		}
+		nSrc += n
+
+		if !t.collapse || !pending || nSrc-checkpoint >= maxLookBack {
+			checkpoint, checkpointDst, checkpointState = nSrc, nDst, t.stripper
+		}
+	}
+
+	if t.collapse && atEOF && err == nil {
+		if t.removed {
+			nDst = len(bytes.TrimRightFunc(dst[:nDst], unicode.IsSpace))
+		}
+		return nDst, nSrc, nil
+	}
+
+	if t.collapse && nSrc != checkpoint {
+		nSrc, nDst, t.stripper = checkpoint, checkpointDst, checkpointState
+	}
+	if err == nil && nSrc < len(s) {
+		err = ErrShortSrc
+	}
+	return nDst, nSrc, err
+}
+
+// Emoji sequences are much shorter than this. completeLen does not look further back.
+const maxLookBack = 256
+
+// Returns the length of the longest prefix of s that can be transformed without
+// knowing the following bytes. The prefix ends right before a rune that can not
+// continue an emoji sequence and that does not follow a U+200D ZERO WIDTH JOINER.
+//
+// If there is no such rune within the last maxLookBack bytes the prefix ends
+// between two sequences instead so that a full buffer always makes progress.
+func completeLen(s string) int {
+	// Exclude a rune at the end that is completed by the following bytes
+	end := len(s)
+	for i := len(s) - 1; i >= 0 && i >= len(s)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(s[i]) {
+			if !utf8.FullRuneInString(s[i:]) {
+				end = i
+			}
+			break
+		}
+	}
+
+	for i := end; i > 0 && end-i < maxLookBack; {
+		r, n := utf8.DecodeLastRuneInString(s[:i])
+		i -= n
+		if i == 0 {
+			return 0
+		}
+
+		if prev, _ := utf8.DecodeLastRuneInString(s[:i]); !isContinuation(r) && prev != zwj {
+			return i
+		}
+	}
+	if end < maxLookBack {
+		return 0
+	}
+
+	// A long run of runes like U+FE0F or regional indicators. Cut it between
+	// two sequences as they are found from the start of s.
+	i := 0
+	for {
+		n := sequenceLen(s[i:end])
+		if n == 0 {
+			_, n = utf8.DecodeRuneInString(s[i:end])
+		}
+		if i > 0 && i+n > end-maxLookBack {
+			return i
+		}
+		i += n
+	}
+}
+
+// Matches runes that can continue an emoji sequence
+func isContinuation(r rune) bool {
+	return r == vs15 || r == vs16 || r == zwj || isDanglingComponent(r)
+}
+
+// Returns a reader that applies t to the bytes read from r
+func NewReader(r io.Reader, t Transformer) io.Reader {
+	return newReader(r, t, 4096)
+}
+
+func newReader(r io.Reader, t Transformer, size int) *reader {
+	t.Reset()
+	return &reader{
+		r:   r,
+		t:   t,
+		dst: make([]byte, size),
+		src: make([]byte, size),
+	}
+}
+
+type reader struct {
+	r   io.Reader
+	t   Transformer
+	err error // error of r or t
+
+	dst        []byte
+	dst0, dst1 int // transformed bytes not read yet
+	src        []byte
+	src0, src1 int // bytes read from r not transformed yet
+
+	complete bool // t has seen the end of the stream or failed
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for {
+		// Return already transformed bytes first
+		if r.dst0 != r.dst1 {
+			n := copy(p, r.dst[r.dst0:r.dst1])
+			r.dst0 += n
+			if r.dst0 == r.dst1 && r.complete {
+				return n, r.err
+			}
+			return n, nil
+		}
+		if r.complete {
+			return 0, r.err
+		}
+
+		if r.src0 != r.src1 || r.err != nil {
+			nDst, nSrc, err := r.t.TransformChunk(r.dst, r.src[r.src0:r.src1], r.err == io.EOF)
+			r.dst0, r.dst1 = 0, nDst
+			r.src0 += nSrc
+
+			switch {
+			case err == nil:
+				if r.src0 != r.src1 {
+					r.err = errInconsistentByteCount
+				}
+				r.complete = r.err != nil
+				continue
+			case err == ErrShortDst && (nDst != 0 || nSrc != 0):
+				continue // make room in dst
+			case err == ErrShortSrc && r.src1-r.src0 != len(r.src) && r.err == nil:
+				// read more bytes below
+			default:
+				r.complete = true
+				if r.err == nil || r.err == io.EOF {
+					r.err = err
+				}
+				continue
+			}
+		}
+
+		// Move the remaining bytes to the front and fill up src
+		if r.src0 != 0 {
+			r.src0, r.src1 = 0, copy(r.src, r.src[r.src0:r.src1])
+		}
+		n, err := r.r.Read(r.src[r.src1:])
+		r.src1 += n
+		r.err = err
+	}
+}
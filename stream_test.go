@@ -0,0 +1,152 @@
+package emojitoolkit
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+var streamTestCases = []string{
+	"",
+	"A",
+	"1",
+	"1\uFE0F\u20E3",
+	"1\uFE0F\u20E3.",
+	"⏳⏳",
+	"☀\uFE0E",
+	"☀\uFE0F ♻",
+	"Hi 👋",
+	"Hi 👋 there",
+	"🇩🇪 Berlin",
+	"a  b 😀  ",
+	"👨\u200D👩\u200D👧 family",
+	"a❤\uFE0F\u200D🔥❤\uFE0F",
+	"👍🏻!",
+	"A\uFE0F",
+	"A\u200D👨",
+	"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F",
+	"a\xf0\x9f b\xff☀",
+	benchmarkChat,
+	strings.Repeat("👩\u200D❤\uFE0F\u200D💋\u200D👨🏳\uFE0F\u200D🌈 ", 100),
+	strings.Repeat("☀😀", 1000) + " x",
+	strings.Repeat(benchmarkChat+"\n", 50),
+}
+
+func TestNewReader(t *testing.T) {
+	transformers := map[string]struct {
+		t Transformer
+		f func(string) string
+	}{
+		"TextPresentationTransformer":        {TextPresentationTransformer(), ToTextPresentation},
+		"EmojiPresentationTransformer":       {EmojiPresentationTransformer(), ToEmojiPresentation},
+		"StripEmojiTransformer":              {StripEmojiTransformer(), StripEmoji},
+		"StripEmojiCollapseSpaceTransformer": {StripEmojiCollapseSpaceTransformer(), StripEmojiCollapseSpace},
+	}
+
+	for name, tr := range transformers {
+		for _, input := range streamTestCases {
+			expected := tr.f(input)
+
+			for _, size := range []int{48, 64, 4096} {
+				// Every Read returns a single byte so sequences are split across chunks
+				r := newReader(iotest.OneByteReader(strings.NewReader(input)), tr.t, size)
+				result, err := io.ReadAll(r)
+				if err != nil {
+					t.Fatalf("%s: reading %q failed: %v", name, input, err)
+				}
+				if string(result) != expected {
+					t.Fatalf("%s(%q) = %q; want %q", name, input, result, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestNewReaderLongRuns(t *testing.T) {
+	transformers := map[string]struct {
+		t Transformer
+		f func(string) string
+	}{
+		"TextPresentationTransformer":        {TextPresentationTransformer(), ToTextPresentation},
+		"StripEmojiTransformer":              {StripEmojiTransformer(), StripEmoji},
+		"StripEmojiCollapseSpaceTransformer": {StripEmojiCollapseSpaceTransformer(), StripEmojiCollapseSpace},
+	}
+
+	// No cut point within the buffer
+	testCases := []string{
+		"a" + strings.Repeat(" ", 5000) + "b",
+		"x" + strings.Repeat("\uFE0F", 3000),
+		strings.Repeat("🇩🇪", 2000),
+		"🇩" + strings.Repeat("🇩🇪", 2000) + " x",
+		"x" + strings.Repeat("\u20E3", 30000),
+	}
+
+	for name, tr := range transformers {
+		for _, input := range testCases {
+			result, err := io.ReadAll(NewReader(strings.NewReader(input), tr.t))
+			if err != nil {
+				t.Fatalf("%s: reading %d bytes failed: %v", name, len(input), err)
+			}
+			if expected := tr.f(input); string(result) != expected {
+				t.Fatalf("%s returned %d bytes; want %d", name, len(result), len(expected))
+			}
+		}
+	}
+}
+
+func TestTransformShortSrc(t *testing.T) {
+	testCases := map[string]int{
+		"":          0,
+		"A":         0,
+		"AB":        1,
+		"A☀":        1,
+		"A☀\uFE0F":  1,
+		"A👨\u200D":  1,
+		"A👨\u200D👩": 1,
+		"A👍🏻B":      9,
+		"A🇩🇪":       0,
+	}
+
+	for input, expected := range testCases {
+		nDst, nSrc, err := TextPresentationTransformer().TransformChunk(make([]byte, 64), []byte(input), false)
+		if nSrc != expected || nDst < nSrc {
+			t.Fatalf("TransformChunk(%q) = %d, %d; want %d consumed bytes", input, nDst, nSrc, expected)
+		}
+		if (nSrc < len(input)) != (err == ErrShortSrc) {
+			t.Fatalf("TransformChunk(%q) = %v", input, err)
+		}
+	}
+}
+
+func TestTransformShortDst(t *testing.T) {
+	tr := EmojiPresentationTransformer()
+	nDst, nSrc, err := tr.TransformChunk(make([]byte, 4), []byte("A☀B"), true)
+	if nDst != 1 || nSrc != 1 || err != ErrShortDst {
+		t.Fatalf("TransformChunk(\"A☀B\") = %d, %d, %v; want 1, 1, %v", nDst, nSrc, err, ErrShortDst)
+	}
+
+	// Whitespace before a removed emoji is not written before the end is known
+	tr = StripEmojiCollapseSpaceTransformer()
+	nDst, nSrc, err = tr.TransformChunk(make([]byte, 64), []byte("Hi 👋 "), false)
+	if nDst != 2 || nSrc != 2 || err != ErrShortSrc {
+		t.Fatalf("TransformChunk(\"Hi 👋 \") = %d, %d, %v; want 2, 2, %v", nDst, nSrc, err, ErrShortSrc)
+	}
+}
+
+func FuzzNewReader(f *testing.F) {
+	for _, s := range streamTestCases {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		r := NewReader(iotest.HalfReader(strings.NewReader(s)), StripEmojiCollapseSpaceTransformer())
+		result, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("reading %q failed: %v", s, err)
+		}
+		if expected := StripEmojiCollapseSpace(s); string(result) != expected {
+			t.Fatalf("StripEmojiCollapseSpaceTransformer(%q) = %q; want %q", s, result, expected)
+		}
+	})
+}
@@ -0,0 +1,119 @@
+package emojitoolkit
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Removes all emojis from a string. Every emoji matched by [All] is removed as a whole
+// including flags, keycaps, modifiers and zwj sequences.
+//
+// Emoji components that are left without an emoji are removed too:
+//   - U+FE0E VARIATION SELECTOR-15 and U+FE0F VARIATION SELECTOR-16 that do not follow a character
+//     listed in [emoji-variation-sequences.txt]
+//   - U+20E3 COMBINING ENCLOSING KEYCAP
+//   - U+200D ZERO WIDTH JOINER next to a removed emoji
+//   - skin tone modifiers, hair components, regional indicators and tag characters
+//
+// Examples:
+//
+//	"Hi 👋" -> "Hi "
+//	"🇩🇪 Berlin" -> " Berlin"
+//	"A\uFE0F" -> "A"
+//	"☀\uFE0E" -> "☀\uFE0E"
+//
+// [emoji-variation-sequences.txt]: https://www.unicode.org/Public/17.0.0/ucd/emoji/emoji-variation-sequences.txt
+func StripEmoji(s string) string {
+	return stripEmoji(s, false)
+}
+
+// Like [StripEmoji] but also collapses the whitespace around removed emojis.
+// Whitespace at the start or end of the string left behind by a removed emoji is dropped.
+// Whitespace that is not next to a removed emoji is left unchanged.
+//
+// Examples:
+//
+//	"Hi 👋" -> "Hi"
+//	"Hi 👋 there" -> "Hi there"
+//	"🇩🇪 Berlin" -> "Berlin"
+//	"a  b 😀" -> "a  b"
+func StripEmojiCollapseSpace(s string) string {
+	return stripEmoji(s, true)
+}
+
+func stripEmoji(s string, collapse bool) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	st := newStripper(collapse)
+	for i := 0; i < len(s); {
+		n, keep := st.next(s[i:])
+		if keep {
+			b.WriteString(s[i : i+n])
+		}
+		i += n
+	}
+
+	if collapse && st.removed {
+		return strings.TrimRightFunc(b.String(), unicode.IsSpace)
+	}
+	return b.String()
+}
+
+// State of [StripEmoji] and [StripEmojiCollapseSpace] between two runes
+type stripper struct {
+	collapse  bool
+	prev      rune // last kept rune or 0 if something was removed after it
+	removed   bool // an emoji was removed since the last kept non space rune
+	lastSpace bool // nothing or whitespace was kept last
+}
+
+func newStripper(collapse bool) stripper {
+	return stripper{collapse: collapse, lastSpace: true}
+}
+
+// Returns the length in bytes of the emoji sequence or rune at the start of s
+// and whether it is kept.
+func (st *stripper) next(s string) (n int, keep bool) {
+	if n := sequenceLen(s); n > 0 {
+		st.prev, st.removed = 0, true
+		return n, false
+	}
+
+	r, n := utf8.DecodeRuneInString(s)
+	dangling := false
+	switch r {
+	case vs15, vs16:
+		dangling = !isInRange(st.prev, variant_ranges)
+	case zwj:
+		dangling = st.prev == 0 || sequenceLen(s[n:]) > 0
+	default:
+		dangling = isDanglingComponent(r)
+	}
+
+	if dangling {
+		st.prev, st.removed = 0, true
+		return n, false
+	}
+
+	space := unicode.IsSpace(r)
+	if st.collapse && st.removed && space && st.lastSpace {
+		return n, false
+	}
+
+	if !space {
+		st.removed = false
+	}
+	st.prev, st.lastSpace = r, space
+	return n, true
+}
+
+// Matches emoji components that do not have a meaning on their own
+func isDanglingComponent(r rune) bool {
+	return r == keycap ||
+		isModifier(r) ||
+		isRegionalIndicator(r) ||
+		(r >= red_hair && r <= white_hair) ||
+		(r >= tag_space && r <= cancel_tag)
+}
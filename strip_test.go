@@ -0,0 +1,76 @@
+package emojitoolkit
+
+import (
+	"testing"
+)
+
+func TestStripEmoji(t *testing.T) {
+	testCases := map[string]string{
+		"":               "",
+		"A":              "A",
+		"1":              "1",
+		"☀":              "☀",
+		"☀\uFE0E":        "☀\uFE0E",
+		"⏳":              "",
+		"Hi 👋":           "Hi ",
+		"☀️":             "",
+		"1\uFE0F\u20E32": "2",
+		"1\u20E3":        "1",
+		"👍🏻!":            "!",
+		"🏻":              "",
+		"🇩🇪 Berlin":      " Berlin",
+		"🇩":              "",
+		"A\uFE0F":        "A",
+		"A\uFE0E":        "A",
+		"👨‍👩‍👧 family":   " family",
+		"👨\u200DA":       "A",
+		"A\u200D👨":       "A",
+		"क\u200Dष":       "क\u200Dष",
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": "",
+		"\U000E0067\U000E007F": "",
+	}
+
+	for input, expected := range testCases {
+		result := StripEmoji(input)
+		if result != expected {
+			t.Fatalf("StripEmoji(%q) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestStripEmojiCollapseSpace(t *testing.T) {
+	testCases := map[string]string{
+		"":              "",
+		"A":             "A",
+		"a  b":          "a  b",
+		" a ":           " a ",
+		"Hi 👋":          "Hi",
+		"Hi 👋 there":    "Hi there",
+		"Hi 👋  👋 there": "Hi there",
+		"Hi👋there":      "Hithere",
+		"🇩🇪 Berlin":     "Berlin",
+		"a  b 😀":        "a  b",
+		"😀 a  b":        "a  b",
+		"a\n😀 b":        "a\nb",
+		"👍🏻 👍🏻 👍🏻":      "",
+	}
+
+	for input, expected := range testCases {
+		result := StripEmojiCollapseSpace(input)
+		if result != expected {
+			t.Fatalf("StripEmojiCollapseSpace(%q) = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func FuzzStripEmoji(f *testing.F) {
+	f.Add("Hi 👋 there")
+	f.Add("👨‍👩‍👧")
+	f.Add("1️\u20E3🇩🇪")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if ContainsEmoji(StripEmoji(s)) {
+			t.Fatalf("StripEmoji(%q) still contains an emoji", s)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package emojitoolkit
+
+import (
+	"fmt"
+	"strings"
+)
+
+// An Emoji version like E13_1 for Emoji 13.1 as used in [emoji-test.txt].
+// Versions are ordered so they can be compared with < and >.
+// Platforms usually support all emojis up to a certain version.
+//
+// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+type EmojiVersion uint16
+
+// Every Emoji version. Versions 6.0 to 10.0 do not exist because the numbering
+// was aligned with the Unicode version after Emoji 5.0.
+const (
+	E0_6  EmojiVersion = 6
+	E0_7  EmojiVersion = 7
+	E1_0  EmojiVersion = 100
+	E2_0  EmojiVersion = 200
+	E3_0  EmojiVersion = 300
+	E4_0  EmojiVersion = 400
+	E5_0  EmojiVersion = 500
+	E11_0 EmojiVersion = 1100
+	E12_0 EmojiVersion = 1200
+	E12_1 EmojiVersion = 1201
+	E13_0 EmojiVersion = 1300
+	E13_1 EmojiVersion = 1301
+	E14_0 EmojiVersion = 1400
+	E15_0 EmojiVersion = 1500
+	E15_1 EmojiVersion = 1501
+	E16_0 EmojiVersion = 1600
+	E17_0 EmojiVersion = 1700
+)
+
+// Returns the version as written in emoji-test.txt without the leading E like "13.1"
+func (v EmojiVersion) String() string {
+	return fmt.Sprintf("%d.%d", v/100, v%100)
+}
+
+// Reports whether a string is a single emoji that is part of the given Emoji version.
+// These are the emojis listed in [emoji-test.txt] that were introduced in v or earlier.
+// Fully-qualified, minimally-qualified and unqualified forms are all found
+// like any other use of U+FE0F VARIATION SELECTOR-16 such as 👍️ (U+1F44D U+FE0F).
+// Use this to tell if an emoji renders on a platform that only supports v.
+//
+// Examples:
+//
+//	Supported("😀", E13_0) -> true // Emoji 1.0
+//	Supported("🫠", E13_0) -> false // Emoji 14.0
+//	Supported("🫠", E14_0) -> true
+//	Supported("🇩🇪", E13_0) -> true
+//	Supported("🇨🇶", E15_1) -> false // Emoji 16.0
+//	Supported("🫩", E16_0) -> true // Emoji 16.0
+//	Supported("👯🏽", E16_0) -> false // Emoji 17.0
+//	Supported("😀😀", E13_0) -> false
+//	Supported("A", E13_0) -> false
+//
+// [emoji-test.txt]: https://www.unicode.org/Public/17.0.0/emoji/emoji-test.txt
+func Supported(s string, v EmojiVersion) bool {
+	if fq, ok := fullyQualified()[strings.ReplaceAll(s, string(vs16), "")]; ok {
+		s = fq
+	}
+	e, found := findEmojiTest(s)
+	return found && e.version <= v
+}
+
+// Like [ContainsEmoji] but only matches emojis that are part of the given Emoji version.
+// See [Supported].
+//
+// Examples:
+//
+//	ContainsEmojiAt("Hi 😀", E13_0) -> true
+//	ContainsEmojiAt("Hi 🫠", E13_0) -> false // Emoji 14.0
+//	ContainsEmojiAt("Hi 🫠😀", E13_0) -> true
+//	ContainsEmojiAt("Hi 🫠", E14_0) -> true
+func ContainsEmojiAt(s string, v EmojiVersion) bool {
+	for _, e := range All(s) {
+		if Supported(string(e), v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package emojitoolkit
+
+import (
+	"testing"
+)
+
+func TestEmojiVersionString(t *testing.T) {
+	testCases := map[EmojiVersion]string{
+		E0_6:  "0.6",
+		E1_0:  "1.0",
+		E12_1: "12.1",
+		E17_0: "17.0",
+	}
+
+	for input, expected := range testCases {
+		if result := input.String(); result != expected {
+			t.Fatalf("%d.String() = %q; want %q", input, result, expected)
+		}
+	}
+}
+
+func TestSupported(t *testing.T) {
+	testCases := []struct {
+		s        string
+		v        EmojiVersion
+		expected bool
+	}{
+		{"😀", E1_0, true},
+		{"😀", E13_0, true},
+		{"😀", E0_7, false},
+		{"☀", E0_6, true},
+		{"☀\uFE0F", E0_6, true},
+		{"👍\uFE0F", E0_6, true},
+		{"😀\uFE0F", E0_7, false},
+		{"🫠", E13_1, false},
+		{"🫠", E14_0, true},
+		{"🫠", E17_0, true},
+		{"🫩", E15_1, false},
+		{"🫩", E16_0, true},
+		{"🇨🇶", E15_1, false},
+		{"🇨🇶", E16_0, true},
+		{"👯🏽", E16_0, false},
+		{"👯🏽", E17_0, true},
+		{"👯🏽\u200D♀", E17_0, true},
+		{"👯🏽\u200D♀\uFE0F\uFE0F", E17_0, true},
+		{"🏽", E1_0, true},
+		{"🇩🇪", E13_0, true},
+		{"👍🏽", E13_0, true},
+		{"🧑\u200D🎄", E13_0, true},
+		{"🧑\u200D🎄", E12_1, false},
+		{"❤\uFE0F\u200D🔥", E13_0, false},
+		{"❤\uFE0F\u200D🔥", E13_1, true},
+		{"", E17_0, false},
+		{"A", E17_0, false},
+		{"😀😀", E17_0, false},
+		{"👪🏽", E17_0, false},
+	}
+
+	for _, c := range testCases {
+		if result := Supported(c.s, c.v); result != c.expected {
+			t.Fatalf("Supported(%q, %v) = %v; want %v", c.s, c.v, result, c.expected)
+		}
+	}
+}
+
+func TestContainsEmojiAt(t *testing.T) {
+	testCases := []struct {
+		s        string
+		v        EmojiVersion
+		expected bool
+	}{
+		{"", E17_0, false},
+		{"Hi", E17_0, false},
+		{"Hi 😀", E13_0, true},
+		{"Hi 🫠", E13_0, false},
+		{"Hi 🫠😀", E13_0, true},
+		{"Hi 🫠", E14_0, true},
+		{"❤\uFE0F\u200D🔥!", E13_0, false},
+	}
+
+	for _, c := range testCases {
+		if result := ContainsEmojiAt(c.s, c.v); result != c.expected {
+			t.Fatalf("ContainsEmojiAt(%q, %v) = %v; want %v", c.s, c.v, result, c.expected)
+		}
+	}
+}
+
+func TestEmojiTestVersions(t *testing.T) {
+	for _, e := range emoji_test {
+		if e.version > E17_0 || e.version%100 > 9 {
+			t.Fatalf("%q has invalid version %d", e.sequence, e.version)
+		}
+	}
+}
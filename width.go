@@ -0,0 +1,126 @@
+package emojitoolkit
+
+import (
+	"unicode/utf8"
+)
+
+// Policy for measuring the width of a string in a monospace terminal.
+// The zero value is the default policy that matches most modern terminals.
+//
+// See [UAX #11] for the East_Asian_Width property.
+//
+// [UAX #11]: https://www.unicode.org/reports/tr11/
+type WidthPolicy struct {
+	// Measure characters that appear as text by default with a following U+FE0F VARIATION SELECTOR-16
+	// like ☀️ as the character alone. Some terminals ignore VS16 and draw ☀️ in a single cell.
+	NarrowVS16 bool
+
+	// Measure characters with East_Asian_Width=Ambiguous like ± or Greek letters as 2 instead of 1.
+	// This matches terminals that are configured for East Asian legacy encodings.
+	AmbiguousWide bool
+}
+
+// Returns the number of cells a string occupies in a monospace terminal
+// using the default [WidthPolicy].
+//
+// Every emoji found by [All] has a width of 2 including flags, keycaps and zwj sequences.
+// Other grapheme clusters have the width of their first character which is 2 for
+// East_Asian_Width Wide and Fullwidth and 1 otherwise. Control characters and
+// combining marks have no width.
+//
+// Examples:
+//
+//	"Go" -> 2
+//	"☀" -> 1
+//	"☀️" -> 2
+//	"👨‍👩‍👧" -> 2
+//	"日本" -> 4
+//	"é" -> 1
+func DisplayWidth(s string) int {
+	return WidthPolicy{}.DisplayWidth(s)
+}
+
+// Shortens a string to at most width cells using the default [WidthPolicy].
+// If the string is too wide it is cut between two grapheme clusters and tail is appended.
+// The tail is included in the width. Strings that fit are returned unchanged.
+//
+// Examples:
+//
+//	Truncate("Hello 👋", 10, "…") -> "Hello 👋"
+//	Truncate("Hello 👋", 7, "…") -> "Hello …"
+//	Truncate("👨‍👩‍👧👨‍👩‍👧", 3, "") -> "👨‍👩‍👧"
+func Truncate(s string, width int, tail string) string {
+	return WidthPolicy{}.Truncate(s, width, tail)
+}
+
+// Like [DisplayWidth] but measures with the policy p
+func (p WidthPolicy) DisplayWidth(s string) int {
+	width := 0
+	for i := 0; i < len(s); {
+		n := graphemeLen(s[i:])
+		width += p.graphemeWidth(s[i : i+n])
+		i += n
+	}
+	return width
+}
+
+// Like [Truncate] but measures with the policy p
+func (p WidthPolicy) Truncate(s string, width int, tail string) string {
+	if p.DisplayWidth(s) <= width {
+		return s
+	}
+
+	tailWidth := p.DisplayWidth(tail)
+	if tailWidth > width {
+		tail = p.Truncate(tail, width, "")
+		tailWidth = p.DisplayWidth(tail)
+	}
+
+	w := 0
+	for i := 0; i < len(s); {
+		n := graphemeLen(s[i:])
+		gw := p.graphemeWidth(s[i : i+n])
+		if w+gw+tailWidth > width {
+			return s[:i] + tail
+		}
+		w += gw
+		i += n
+	}
+	return s + tail
+}
+
+// Returns the width of a single grapheme cluster
+func (p WidthPolicy) graphemeWidth(g string) int {
+	if len(g) == 1 && g[0] < utf8.RuneSelf {
+		if g[0] < ' ' || g[0] == 0x7F {
+			return 0 // ASCII control character
+		}
+		return 1
+	}
+
+	r, n := utf8.DecodeRuneInString(g)
+	switch graphemeProperty(r) {
+	case gcbControl, gcbCR, gcbLF:
+		return 0
+	case gcbExtend, gcbExtendInCB, gcbLinker, gcbZWJ:
+		if !isInRange(r, wide_ranges) {
+			return 0 // combining mark without a base character
+		}
+	}
+
+	if sequenceLen(g) > 0 {
+		if next, _ := utf8.DecodeRuneInString(g[n:]); p.NarrowVS16 && next == vs16 && isInRange(r, emoji_ranges2) {
+			return p.runeWidth(r)
+		}
+		return 2
+	}
+	return p.runeWidth(r)
+}
+
+// Returns the width of a single character according to its East_Asian_Width
+func (p WidthPolicy) runeWidth(r rune) int {
+	if isInRange(r, wide_ranges) || p.AmbiguousWide && isInRange(r, ambiguous_ranges) {
+		return 2
+	}
+	return 1
+}
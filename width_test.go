@@ -0,0 +1,108 @@
+package emojitoolkit
+
+import (
+	"testing"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	testCases := map[string]int{
+		"":                0,
+		"Go":              2,
+		"\t":              0,
+		"\r\n":            0,
+		"☀":               1,
+		"☀\uFE0F":         2,
+		"☀\uFE0E":         1,
+		"⏳":               2,
+		"1":               1,
+		"1\uFE0F\u20E3":   2,
+		"🇩🇪":              2,
+		"🇩":               1,
+		"👍🏽":              2,
+		"🏽":               2,
+		"👨\u200D👩\u200D👧": 2,
+		"🏴\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F": 2,
+		"日本":         4,
+		"\U00018D80": 2,
+		"ｶ":          1,
+		"ａ":          2,
+		"e\u0301":    1,
+		"\u0301":     0,
+		"±":          1,
+		"Hi 👋 there": 11,
+	}
+
+	for input, expected := range testCases {
+		result := DisplayWidth(input)
+		if result != expected {
+			t.Fatalf("DisplayWidth(%q) = %d; want %d", input, result, expected)
+		}
+	}
+}
+
+func TestDisplayWidthEmoji(t *testing.T) {
+	for _, e := range emoji_test {
+		if e.status != FullyQualified {
+			continue
+		}
+
+		if result := DisplayWidth(e.sequence); result != 2 {
+			t.Fatalf("DisplayWidth(%q) = %d; want 2", e.sequence, result)
+		}
+	}
+}
+
+func TestWidthPolicy(t *testing.T) {
+	testCases := map[string][2]int{
+		"☀\uFE0F":       {1, 2},
+		"☝\uFE0F":       {1, 2},
+		"☝🏽":            {2, 2},
+		"⏳":             {2, 2},
+		"1\uFE0F\u20E3": {1, 2},
+		"±":             {1, 2},
+		"α":             {1, 2},
+		"a":             {1, 1},
+	}
+
+	narrow := WidthPolicy{NarrowVS16: true}
+	ambiguous := WidthPolicy{AmbiguousWide: true}
+	for input, expected := range testCases {
+		if result := narrow.DisplayWidth(input); result != expected[0] {
+			t.Fatalf("WidthPolicy{NarrowVS16: true}.DisplayWidth(%q) = %d; want %d", input, result, expected[0])
+		}
+		if result := ambiguous.DisplayWidth(input); result != expected[1] {
+			t.Fatalf("WidthPolicy{AmbiguousWide: true}.DisplayWidth(%q) = %d; want %d", input, result, expected[1])
+		}
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		s        string
+		width    int
+		tail     string
+		expected string
+	}{
+		{"", 0, "…", ""},
+		{"Hello 👋", 10, "…", "Hello 👋"},
+		{"Hello 👋", 8, "…", "Hello 👋"},
+		{"Hello 👋", 7, "…", "Hello …"},
+		{"Hello 👋", 6, "", "Hello "},
+		{"👨\u200D👩\u200D👧👨\u200D👩\u200D👧", 3, "", "👨\u200D👩\u200D👧"},
+		{"🇩🇪🇫🇷", 3, "", "🇩🇪"},
+		{"日本語", 5, ".", "日本."},
+		{"e\u0301e\u0301", 1, "", "e\u0301"},
+		{"Hello", 2, "...", ".."},
+		{"Hello", 0, "...", ""},
+	}
+
+	for _, c := range testCases {
+		result := Truncate(c.s, c.width, c.tail)
+		if result != c.expected {
+			t.Fatalf("Truncate(%q, %d, %q) = %q; want %q", c.s, c.width, c.tail, result, c.expected)
+		}
+		if w := DisplayWidth(result); w > c.width && c.width >= 0 {
+			t.Fatalf("Truncate(%q, %d, %q) is %d wide", c.s, c.width, c.tail, w)
+		}
+	}
+}